@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// callIDKey is the context key of a tool call's correlation ID
+type callIDKey struct{}
+
+// callID returns the correlation ID of the tool call handling ctx, or ""
+func callID(ctx context.Context) string {
+	id, _ := ctx.Value(callIDKey{}).(string)
+	return id
+}
+
+// callTracer gives every tool call a correlation ID, logs the call with it,
+// and optionally reports the ID in the result and records the call in an
+// audit log, so multi-agent setups can trace which client asked for what.
+type callTracer struct {
+	prefix  string        // Random per process, so IDs differ across restarts
+	calls   atomic.Uint64 // Calls so far
+	metaIDs bool          // Report each call's ID under _meta.callId
+	slow    time.Duration // Warn about calls taking at least this long; 0 to never warn
+	audit   io.Writer     // JSON lines audit log; nil when disabled
+	mu      sync.Mutex    // Serializes audit log writes
+}
+
+// auditRecord is one line of the audit log
+type auditRecord struct {
+	Time      time.Time      `json:"time"`
+	CallID    string         `json:"callId"`
+	Session   string         `json:"session,omitempty"`
+	Tool      string         `json:"tool"`
+	Arguments map[string]any `json:"arguments,omitempty"`
+	Duration  float64        `json:"durationMs"`
+	Outcome   string         `json:"outcome"` // ok, tool error or error
+	Error     string         `json:"error,omitempty"`
+}
+
+// defaultSlowCall is how long a tool call may take before it is logged as slow
+const defaultSlowCall = time.Second
+
+// newCallTracer configures call tracing from SCRIPTURES_CALL_ID_META (true
+// to report call IDs under _meta.callId), SCRIPTURES_SLOW_CALL_MS (the
+// duration in milliseconds from which calls are logged as slow; 0 to turn
+// it off) and SCRIPTURES_AUDIT_LOG (a file to append the audit log to). The
+// returned function closes the audit log.
+func newCallTracer(getenv func(string) string) (*callTracer, func(), error) {
+	var prefix [4]byte
+	if _, err := rand.Read(prefix[:]); err != nil {
+		return nil, nil, fmt.Errorf("generate call ID prefix: %w", err)
+	}
+	tracer := &callTracer{prefix: hex.EncodeToString(prefix[:]), slow: defaultSlowCall}
+
+	if value := strings.TrimSpace(getenv("SCRIPTURES_CALL_ID_META")); value != "" {
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid SCRIPTURES_CALL_ID_META '%s': use true or false", value)
+		}
+		tracer.metaIDs = enabled
+	}
+
+	if value := strings.TrimSpace(getenv("SCRIPTURES_SLOW_CALL_MS")); value != "" {
+		ms, err := strconv.Atoi(value)
+		if err != nil || ms < 0 {
+			return nil, nil, fmt.Errorf("invalid SCRIPTURES_SLOW_CALL_MS '%s': use a number of milliseconds, or 0 to turn it off", value)
+		}
+		tracer.slow = time.Duration(ms) * time.Millisecond
+	}
+
+	closeAudit := func() {}
+	if path := strings.TrimSpace(getenv("SCRIPTURES_AUDIT_LOG")); path != "" {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, nil, fmt.Errorf("open audit log: %w", err)
+		}
+		tracer.audit = file
+		closeAudit = func() { file.Close() }
+	}
+	return tracer, closeAudit, nil
+}
+
+// newID returns the next correlation ID, such as "3f9a2c1b-42"
+func (t *callTracer) newID() string {
+	return fmt.Sprintf("%s-%d", t.prefix, t.calls.Add(1))
+}
+
+// middleware is tool handler middleware that traces each call. It must be
+// the outermost middleware so the ID is in the context of the others.
+func (t *callTracer) middleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		id := t.newID()
+		ctx = context.WithValue(ctx, callIDKey{}, id)
+		session := ""
+		if clientSession := server.ClientSessionFromContext(ctx); clientSession != nil {
+			session = clientSession.SessionID()
+		}
+
+		start := time.Now()
+		result, err := next(ctx, request)
+		elapsed := time.Since(start)
+
+		record := auditRecord{
+			Time:      start.UTC(),
+			CallID:    id,
+			Session:   session,
+			Tool:      request.Params.Name,
+			Arguments: request.GetArguments(),
+			Duration:  float64(elapsed.Microseconds()) / 1000,
+			Outcome:   "ok",
+		}
+		if err != nil {
+			record.Outcome, record.Error = "error", err.Error()
+			slog.Warn("Tool call failed", "call_id", id, "tool", record.Tool, "session", session, "duration", elapsed, "error", err)
+		} else {
+			if result != nil && result.IsError {
+				record.Outcome = "tool error"
+			}
+			slog.Debug("Tool call", "call_id", id, "tool", record.Tool, "session", session, "duration", elapsed, "outcome", record.Outcome)
+		}
+		if t.slow > 0 && elapsed >= t.slow {
+			slog.Warn("Slow tool call", "call_id", id, "tool", record.Tool, "session", session, "duration", elapsed)
+		}
+		t.record(record)
+
+		if t.metaIDs && result != nil {
+			if result.Meta == nil {
+				result.Meta = &mcp.Meta{}
+			}
+			if result.Meta.AdditionalFields == nil {
+				result.Meta.AdditionalFields = make(map[string]any)
+			}
+			result.Meta.AdditionalFields["callId"] = id
+		}
+		return result, err
+	}
+}
+
+// record appends a call to the audit log, if enabled
+func (t *callTracer) record(record auditRecord) {
+	if t.audit == nil {
+		return
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		slog.Warn("Could not encode audit record", "call_id", record.CallID, "error", err)
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, err := t.audit.Write(append(line, '\n')); err != nil {
+		slog.Warn("Could not write audit log", "call_id", record.CallID, "error", err)
+	}
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func TestNewCallTracer(t *testing.T) {
+	tests := []struct {
+		name        string
+		env         map[string]string
+		metaIDs     bool
+		slow        time.Duration
+		expectError string
+	}{
+		{name: "Defaults", env: nil, slow: defaultSlowCall},
+		{name: "IDs in meta", env: map[string]string{"SCRIPTURES_CALL_ID_META": "true"}, metaIDs: true, slow: defaultSlowCall},
+		{name: "IDs not in meta", env: map[string]string{"SCRIPTURES_CALL_ID_META": "0"}, slow: defaultSlowCall},
+		{name: "Slow calls from 250ms", env: map[string]string{"SCRIPTURES_SLOW_CALL_MS": "250"}, slow: 250 * time.Millisecond},
+		{name: "Slow calls not logged", env: map[string]string{"SCRIPTURES_SLOW_CALL_MS": "0"}},
+		{name: "Invalid meta flag", env: map[string]string{"SCRIPTURES_CALL_ID_META": "sometimes"}, expectError: "SCRIPTURES_CALL_ID_META"},
+		{name: "Invalid slow call duration", env: map[string]string{"SCRIPTURES_SLOW_CALL_MS": "1s"}, expectError: "SCRIPTURES_SLOW_CALL_MS"},
+		{name: "Unwritable audit log", env: map[string]string{"SCRIPTURES_AUDIT_LOG": "/nonexistent/audit.log"}, expectError: "open audit log"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracer, closeAudit, err := newCallTracer(func(key string) string { return tt.env[key] })
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			defer closeAudit()
+			if tracer.metaIDs != tt.metaIDs || tracer.slow != tt.slow {
+				t.Errorf("Expected metaIDs %v and slow %v, got %v and %v", tt.metaIDs, tt.slow, tracer.metaIDs, tracer.slow)
+			}
+			if first, second := tracer.newID(), tracer.newID(); first == second || !strings.HasPrefix(second, tracer.prefix+"-") {
+				t.Errorf("Expected distinct IDs with the process prefix, got %s and %s", first, second)
+			}
+		})
+	}
+}
+
+func TestCallTracer_Middleware(t *testing.T) {
+	var audit bytes.Buffer
+	tracer := &callTracer{prefix: "test", metaIDs: true, audit: &audit}
+
+	mcpServer := server.NewMCPServer("test", "1.0.0",
+		server.WithToolCapabilities(true),
+		server.WithToolHandlerMiddleware(tracer.middleware),
+	)
+	mcpServer.AddTool(mcp.NewTool("echo", mcp.WithString("text")), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Handlers see the call's ID
+		return mcp.NewToolResultText(callID(ctx)), nil
+	})
+	mcpServer.AddTool(mcp.NewTool("fail"), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return nil, errors.New("data unavailable")
+	})
+
+	// One call per session, since concurrent tool calls may start in any order
+	var out bytes.Buffer
+	for _, input := range []string{
+		`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"echo","arguments":{"text":"faith"}}}` + "\n",
+		`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"fail"}}` + "\n",
+	} {
+		if err := serveStdio(context.Background(), mcpServer, strings.NewReader(input), &out); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	expected := `{"jsonrpc":"2.0","id":1,"result":{"_meta":{"callId":"test-1"},"content":[{"type":"text","text":"test-1"}]}}`
+	if lines[0] != expected {
+		t.Errorf("Expected\n%s\ngot\n%s", expected, lines[0])
+	}
+
+	var records []auditRecord
+	for _, line := range strings.Split(strings.TrimSpace(audit.String()), "\n") {
+		var record auditRecord
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("Expected a JSON audit record, got %q: %v", line, err)
+		}
+		records = append(records, record)
+	}
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 audit records, got %d:\n%s", len(records), audit.String())
+	}
+	if r := records[0]; r.CallID != "test-1" || r.Tool != "echo" || r.Session != "stdio" || r.Arguments["text"] != "faith" || r.Outcome != "ok" {
+		t.Errorf("Unexpected audit record for echo: %+v", r)
+	}
+	if r := records[1]; r.CallID != "test-2" || r.Tool != "fail" || r.Outcome != "error" || r.Error != "data unavailable" {
+		t.Errorf("Unexpected audit record for fail: %+v", r)
+	}
+}
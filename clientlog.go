@@ -0,0 +1,200 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// clientLogger is the logger name of log notifications sent to clients
+const clientLogger = "scriptures-mcp"
+
+// maxLogBacklog caps the records kept while no client is connected
+const maxLogBacklog = 100
+
+// clientLogs sends operational logs to MCP clients as notifications/message,
+// so a client sees data-load warnings, slow calls and failed calls without
+// access to the server's stderr. Each client gets the records at or above
+// the level it chose with logging/setLevel (error until it does). Records
+// logged while no client is connected, such as warnings while the data
+// loads at startup, are kept and sent to each client when it first sets
+// its level.
+type clientLogs struct {
+	mu       sync.Mutex
+	server   *server.MCPServer
+	sessions map[string]server.ClientSession
+	replayed map[string]bool // Sessions already sent the backlog
+	backlog  []mcp.LoggingMessageNotification
+}
+
+// newClientLogs returns client logs not yet attached to a server
+func newClientLogs() *clientLogs {
+	return &clientLogs{sessions: make(map[string]server.ClientSession), replayed: make(map[string]bool)}
+}
+
+// handler returns a slog handler that writes to next and sends to clients
+func (c *clientLogs) handler(next slog.Handler) slog.Handler {
+	return &clientLogHandler{next: next, logs: c}
+}
+
+// hooks tracks the server's sessions and replays the backlog on setLevel
+func (c *clientLogs) hooks() *server.Hooks {
+	hooks := &server.Hooks{}
+	hooks.AddOnRegisterSession(func(ctx context.Context, session server.ClientSession) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.sessions[session.SessionID()] = session
+	})
+	hooks.AddOnUnregisterSession(func(ctx context.Context, session server.ClientSession) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		delete(c.sessions, session.SessionID())
+		delete(c.replayed, session.SessionID())
+	})
+	hooks.AddAfterSetLevel(func(ctx context.Context, id any, message *mcp.SetLevelRequest, result *mcp.EmptyResult) {
+		session := server.ClientSessionFromContext(ctx)
+		if session == nil {
+			return
+		}
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.server == nil || c.replayed[session.SessionID()] {
+			return
+		}
+		c.replayed[session.SessionID()] = true
+		for _, notification := range c.backlog {
+			c.server.SendLogMessageToSpecificClient(session.SessionID(), notification)
+		}
+	})
+	return hooks
+}
+
+// attach starts sending to the clients of mcpServer, which must have been
+// created with the options from hooks and server.WithLogging
+func (c *clientLogs) attach(mcpServer *server.MCPServer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.server = mcpServer
+}
+
+// wants reports whether any initialized client wants records at level, or
+// whether they are kept for later
+func (c *clientLogs) wants(level mcp.LoggingLevel) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	initialized := false
+	for _, session := range c.sessions {
+		if !session.Initialized() {
+			continue
+		}
+		initialized = true
+		if logging, ok := session.(server.SessionWithLogging); ok && level.ShouldSendTo(logging.GetLogLevel()) {
+			return true
+		}
+	}
+	// Only warnings and errors are kept for later
+	return (c.server == nil || !initialized) && level.ShouldSendTo(mcp.LoggingLevelWarning)
+}
+
+// send sends a record to every initialized client that wants its level, or
+// keeps it for later when there are none
+func (c *clientLogs) send(notification mcp.LoggingMessageNotification) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sent := false
+	for id, session := range c.sessions {
+		if c.server != nil && session.Initialized() {
+			c.server.SendLogMessageToSpecificClient(id, notification)
+			sent = true
+		}
+	}
+	if sent {
+		return
+	}
+	if len(c.backlog) == maxLogBacklog {
+		c.backlog = c.backlog[1:]
+	}
+	c.backlog = append(c.backlog, notification)
+}
+
+// clientLogHandler is the slog handler of clientLogs
+type clientLogHandler struct {
+	next   slog.Handler
+	logs   *clientLogs
+	attrs  []slog.Attr // From WithAttrs, with group prefixes applied
+	prefix string      // Groups from WithGroup, as "group."
+}
+
+func (h *clientLogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level) || h.logs.wants(loggingLevel(level))
+}
+
+func (h *clientLogHandler) Handle(ctx context.Context, record slog.Record) error {
+	var err error
+	if h.next.Enabled(ctx, record.Level) {
+		err = h.next.Handle(ctx, record)
+	}
+	level := loggingLevel(record.Level)
+	if !h.logs.wants(level) {
+		return err
+	}
+
+	data := map[string]any{"message": record.Message}
+	for _, attr := range h.attrs {
+		data[attr.Key] = attr.Value.Resolve().Any()
+	}
+	record.Attrs(func(attr slog.Attr) bool {
+		data[h.prefix+attr.Key] = attr.Value.Resolve().Any()
+		return true
+	})
+	// Errors and durations are not JSON objects
+	for key, value := range data {
+		switch value := value.(type) {
+		case error:
+			data[key] = value.Error()
+		case interface{ String() string }:
+			data[key] = value.String()
+		}
+	}
+	h.logs.send(mcp.NewLoggingMessageNotification(level, clientLogger, data))
+	return err
+}
+
+func (h *clientLogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handler := *h
+	handler.next = h.next.WithAttrs(attrs)
+	handler.attrs = append([]slog.Attr{}, h.attrs...)
+	for _, attr := range attrs {
+		handler.attrs = append(handler.attrs, slog.Attr{Key: h.prefix + attr.Key, Value: attr.Value})
+	}
+	return &handler
+}
+
+func (h *clientLogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	handler := *h
+	handler.next = h.next.WithGroup(name)
+	handler.prefix = h.prefix + name + "."
+	return &handler
+}
+
+// loggingLevel returns the MCP logging level of a slog level
+func loggingLevel(level slog.Level) mcp.LoggingLevel {
+	switch {
+	case level < slog.LevelInfo:
+		return mcp.LoggingLevelDebug
+	case level < slog.LevelWarn:
+		return mcp.LoggingLevelInfo
+	case level < slog.LevelError:
+		return mcp.LoggingLevelWarning
+	case level == slog.LevelError:
+		return mcp.LoggingLevelError
+	default:
+		return mcp.LoggingLevelCritical
+	}
+}
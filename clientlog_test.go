@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func TestClientLogs(t *testing.T) {
+	logs := newClientLogs()
+	logger := slog.New(logs.handler(slog.NewTextHandler(io.Discard, nil)))
+
+	// Startup warnings are kept until a client sets its level
+	logger.Warn("Could not parse stories dataset", "file", "stories.json")
+	logger.Info("Not kept")
+
+	mcpServer := server.NewMCPServer("test", "1.0.0", server.WithLogging(), server.WithHooks(logs.hooks()))
+	logs.attach(mcpServer)
+	session := &stdioSession{notifications: make(chan mcp.JSONRPCNotification, 10)}
+	if err := mcpServer.RegisterSession(context.Background(), session); err != nil {
+		t.Fatalf("Failed to register session: %v", err)
+	}
+	ctx := mcpServer.WithContext(context.Background(), session)
+	handle := func(message string) {
+		t.Helper()
+		if response := mcpServer.HandleMessage(ctx, []byte(message)); response == nil {
+			t.Fatalf("Expected a response to %s", message)
+		}
+	}
+	received := func() []map[string]any {
+		t.Helper()
+		var messages []map[string]any
+		for {
+			select {
+			case notification := <-session.notifications:
+				if notification.Method != "notifications/message" {
+					t.Fatalf("Unexpected notification %+v", notification)
+				}
+				data := notification.Params.AdditionalFields["data"].(map[string]any)
+				data["level"] = notification.Params.AdditionalFields["level"]
+				messages = append(messages, data)
+			default:
+				return messages
+			}
+		}
+	}
+
+	handle(`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-03-26","clientInfo":{"name":"test","version":"1.0"},"capabilities":{}}}`)
+	// Until the client sets a level, only errors are sent
+	logger.Warn("Slow tool call", "tool", "search_scriptures")
+	logger.Error("Tool call failed", "tool", "get_scripture", "error", io.ErrUnexpectedEOF)
+	messages := received()
+	if len(messages) != 1 || messages[0]["message"] != "Tool call failed" || messages[0]["error"] != "unexpected EOF" || messages[0]["level"] != mcp.LoggingLevelError {
+		t.Fatalf("Expected only the error, got %+v", messages)
+	}
+
+	handle(`{"jsonrpc":"2.0","id":2,"method":"logging/setLevel","params":{"level":"info"}}`)
+	messages = received()
+	if len(messages) != 1 || messages[0]["message"] != "Could not parse stories dataset" || messages[0]["file"] != "stories.json" || messages[0]["level"] != mcp.LoggingLevelWarning {
+		t.Fatalf("Expected the startup warning, got %+v", messages)
+	}
+
+	logger.With("call_id", "test-1").Info("Tool list changed")
+	logger.Debug("Tool call")
+	handle(`{"jsonrpc":"2.0","id":3,"method":"logging/setLevel","params":{"level":"warning"}}`)
+	messages = received()
+	if len(messages) != 1 || messages[0]["message"] != "Tool list changed" || messages[0]["call_id"] != "test-1" {
+		t.Errorf("Expected the info record once, got %+v", messages)
+	}
+}
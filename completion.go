@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/cpuchip/scriptures-mcp/internal/scripture"
+)
+
+// completionMethod is the MCP method clients call to complete an argument
+const completionMethod = "completion/complete"
+
+// maxCompletions is the most values one completion response may hold
+const maxCompletions = 100
+
+// completionRequest is a completion/complete request. The library's
+// CompleteParams has no context of already resolved arguments.
+type completionRequest struct {
+	ID     mcp.RequestId `json:"id"`
+	Params struct {
+		Ref struct {
+			Type string `json:"type"` // ref/prompt or ref/resource
+		} `json:"ref"`
+		Argument struct {
+			Name  string `json:"name"`
+			Value string `json:"value"`
+		} `json:"argument"`
+		Context struct {
+			Arguments map[string]string `json:"arguments"`
+		} `json:"context"`
+	} `json:"params"`
+}
+
+// completionCapabilities are the server capabilities with completions
+type completionCapabilities struct {
+	mcp.ServerCapabilities
+	Completions *struct{} `json:"completions,omitempty"`
+}
+
+// initializeResultWithCompletions is an initialize result that also
+// advertises completions
+type initializeResultWithCompletions struct {
+	mcp.InitializeResult
+	Capabilities completionCapabilities `json:"capabilities"`
+}
+
+// completingServer is an MCP server that also answers completion/complete
+// requests, which the MCP library does not handle, suggesting book and
+// collection names as a client types a prompt argument or a resource URI.
+type completingServer struct {
+	*server.MCPServer
+	complete func(scripture.ArgumentCompletion) []string
+}
+
+// HandleMessage answers completion requests and advertises completions in
+// initialize responses, passing every other message to the MCP server
+func (s *completingServer) HandleMessage(ctx context.Context, message json.RawMessage) mcp.JSONRPCMessage {
+	switch messageMethod(message) {
+	case completionMethod:
+		return s.answer(message)
+	case string(mcp.MethodInitialize):
+		return withCompletions(s.MCPServer.HandleMessage(ctx, message))
+	}
+	return s.MCPServer.HandleMessage(ctx, message)
+}
+
+// httpHandler answers completion requests POSTed to the Streamable HTTP
+// endpoint and advertises completions in initialize responses, passing
+// every other request to next, which serves the MCP server
+func (s *completingServer) httpHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			next.ServeHTTP(w, r)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "could not read request body", http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		switch messageMethod(body) {
+		case completionMethod:
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(s.answer(body))
+		case string(mcp.MethodInitialize):
+			response := &bufferedResponse{header: make(http.Header), status: http.StatusOK}
+			next.ServeHTTP(response, r)
+			for key, values := range response.header {
+				w.Header()[key] = values
+			}
+			patched := addCompletionsCapability(response.body.Bytes())
+			w.Header().Del("Content-Length")
+			w.WriteHeader(response.status)
+			w.Write(patched)
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+// bufferedResponse holds a response so it can be changed before it is sent
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *bufferedResponse) Header() http.Header { return r.header }
+
+func (r *bufferedResponse) Write(data []byte) (int, error) { return r.body.Write(data) }
+
+func (r *bufferedResponse) WriteHeader(status int) { r.status = status }
+
+// addCompletionsCapability adds the completions capability to an encoded
+// initialize response, returning any other body unchanged
+func addCompletionsCapability(body []byte) []byte {
+	var response map[string]any
+	if err := json.Unmarshal(body, &response); err != nil {
+		return body
+	}
+	result, _ := response["result"].(map[string]any)
+	capabilities, ok := result["capabilities"].(map[string]any)
+	if !ok {
+		return body
+	}
+	capabilities["completions"] = map[string]any{}
+	patched, err := json.Marshal(response)
+	if err != nil {
+		return body
+	}
+	return patched
+}
+
+// answer returns the response to a completion request
+func (s *completingServer) answer(message json.RawMessage) mcp.JSONRPCMessage {
+	var request completionRequest
+	if err := json.Unmarshal(message, &request); err != nil || request.Params.Argument.Name == "" {
+		return mcp.NewJSONRPCError(request.ID, mcp.INVALID_PARAMS, "completion needs an argument name", nil)
+	}
+	values := s.complete(scripture.ArgumentCompletion{
+		Argument: request.Params.Argument.Name,
+		Value:    request.Params.Argument.Value,
+		Resource: request.Params.Ref.Type == "ref/resource",
+		Resolved: request.Params.Context.Arguments,
+	})
+
+	result := mcp.CompleteResult{}
+	result.Completion.Values = append([]string{}, values[:min(len(values), maxCompletions)]...)
+	result.Completion.Total = len(values)
+	result.Completion.HasMore = len(values) > maxCompletions
+	return mcp.JSONRPCResponse{JSONRPC: mcp.JSONRPC_VERSION, ID: request.ID, Result: result}
+}
+
+// withCompletions adds the completions capability to an initialize response
+func withCompletions(response mcp.JSONRPCMessage) mcp.JSONRPCMessage {
+	initialized, ok := response.(mcp.JSONRPCResponse)
+	if !ok {
+		return response
+	}
+	result, ok := initialized.Result.(mcp.InitializeResult)
+	if !ok {
+		return response
+	}
+	initialized.Result = initializeResultWithCompletions{
+		InitializeResult: result,
+		Capabilities:     completionCapabilities{ServerCapabilities: result.Capabilities, Completions: &struct{}{}},
+	}
+	return initialized
+}
+
+// messageMethod returns the method of a JSON-RPC message, or "" if it has
+// none or is not an object
+func messageMethod(message []byte) string {
+	var base struct {
+		Method string `json:"method"`
+	}
+	if err := json.Unmarshal(message, &base); err != nil {
+		return ""
+	}
+	return base.Method
+}
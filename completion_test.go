@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/cpuchip/scriptures-mcp/internal/scripture"
+)
+
+func TestCompletingServer(t *testing.T) {
+	var received []scripture.ArgumentCompletion
+	completions := &completingServer{
+		MCPServer: server.NewMCPServer("test", "1.0.0", server.WithToolCapabilities(true)),
+		complete: func(completion scripture.ArgumentCompletion) []string {
+			received = append(received, completion)
+			values := make([]string, 150)
+			for i := range values {
+				values[i] = completion.Value
+			}
+			return values[:min(len(values), len(completion.Value)*50)]
+		},
+	}
+
+	input := `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-03-26","capabilities":{},"clientInfo":{"name":"test","version":"1.0.0"}}}` + "\n" +
+		`{"jsonrpc":"2.0","id":2,"method":"completion/complete","params":{"ref":{"type":"ref/prompt","name":"chapter_study_guide"},"argument":{"name":"book","value":"Al"}}}` + "\n" +
+		`{"jsonrpc":"2.0","id":3,"method":"completion/complete","params":{"ref":{"type":"ref/resource","uri":"scripture://{collection}/{book}/{chapter}"},"argument":{"name":"book","value":"alm"},"context":{"arguments":{"collection":"book-of-mormon"}}}}` + "\n" +
+		`{"jsonrpc":"2.0","id":4,"method":"completion/complete","params":{"ref":{"type":"ref/prompt","name":"chapter_study_guide"}}}` + "\n"
+	var out bytes.Buffer
+	if err := serveStdio(context.Background(), completions, strings.NewReader(input), &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("Expected 4 responses, got:\n%s", out.String())
+	}
+
+	var initialized struct {
+		Result struct {
+			Capabilities map[string]any `json:"capabilities"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal([]byte(lines[0]), &initialized); err != nil || initialized.Result.Capabilities["completions"] == nil || initialized.Result.Capabilities["tools"] == nil {
+		t.Errorf("Expected the completions capability with the others, got %s", lines[0])
+	}
+
+	var completed struct {
+		Result struct {
+			Completion struct {
+				Values  []string `json:"values"`
+				Total   int      `json:"total"`
+				HasMore bool     `json:"hasMore"`
+			} `json:"completion"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal([]byte(lines[1]), &completed); err != nil || len(completed.Result.Completion.Values) != 100 || completed.Result.Completion.Total != 100 || completed.Result.Completion.HasMore {
+		t.Errorf("Expected 100 values, got %s", lines[1])
+	}
+	if err := json.Unmarshal([]byte(lines[2]), &completed); err != nil || len(completed.Result.Completion.Values) != 100 || completed.Result.Completion.Total != 150 || !completed.Result.Completion.HasMore {
+		t.Errorf("Expected the first 100 of 150 values, got %s", lines[2])
+	}
+	if !strings.Contains(lines[3], `"code":-32602`) {
+		t.Errorf("Expected an invalid params error without an argument, got %s", lines[3])
+	}
+
+	if len(received) != 2 || received[0].Resource || !received[1].Resource || received[1].Resolved["collection"] != "book-of-mormon" {
+		t.Errorf("Unexpected completions asked for: %+v", received)
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// mcpEndpoint is the path the Streamable HTTP transport serves MCP on
+const mcpEndpoint = "/mcp"
+
+// Health probe paths for container deployments
+const (
+	livenessEndpoint  = "/healthz" // The process is up and serving HTTP
+	readinessEndpoint = "/readyz"  // Scripture data is loaded and the search index is ready
+)
+
+// shutdownTimeout bounds how long in-flight requests may finish after the
+// HTTP server is asked to stop
+const shutdownTimeout = 10 * time.Second
+
+// httpFrontend serves MCP over Streamable HTTP together with liveness and
+// readiness probes. It starts listening before the scripture data is loaded,
+// so a container is live while it warms up; /readyz and /mcp answer 503 until
+// the MCP server is attached and reports ready.
+type httpFrontend struct {
+	mu    sync.RWMutex
+	mcp   http.Handler // Nil until attached
+	ready func() error // Nil until attached
+}
+
+// attach starts serving MCP requests with mcpServer. Clients POST JSON-RPC
+// messages to /mcp and may open a GET stream on it for server notifications;
+// each client gets its own session, so one server can be shared by many.
+func (f *httpFrontend) attach(mcpServer *completingServer, ready func() error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.mcp = mcpServer.httpHandler(server.NewStreamableHTTPServer(mcpServer.MCPServer, server.WithEndpointPath(mcpEndpoint)))
+	f.ready = ready
+}
+
+// handler routes the MCP endpoint and the health probes
+func (f *httpFrontend) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(livenessEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "ok")
+	})
+	mux.HandleFunc(readinessEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		if err := f.readiness(); err != nil {
+			http.Error(w, "not ready: "+err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, "ready")
+	})
+	mux.HandleFunc(mcpEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		f.mu.RLock()
+		mcp := f.mcp
+		f.mu.RUnlock()
+		if mcp == nil {
+			http.Error(w, "scripture data is still loading", http.StatusServiceUnavailable)
+			return
+		}
+		mcp.ServeHTTP(w, r)
+	})
+	return mux
+}
+
+// readiness returns why the frontend cannot serve MCP yet, or nil
+func (f *httpFrontend) readiness() error {
+	f.mu.RLock()
+	ready := f.ready
+	f.mu.RUnlock()
+	if ready == nil {
+		return errors.New("scripture data is still loading")
+	}
+	return ready()
+}
+
+// startHTTP listens on addr and serves handler in the background until the
+// process is interrupted, then shuts down gracefully. The returned channel
+// receives the outcome once the server has stopped.
+func startHTTP(addr string, handler http.Handler) (<-chan error, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info("Serving MCP over HTTP", "addr", listener.Addr().String(), "endpoint", mcpEndpoint)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	httpServer := &http.Server{Handler: handler}
+	failed := make(chan error, 1)
+	go func() {
+		if err := httpServer.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+			failed <- err
+		}
+	}()
+
+	done := make(chan error, 1)
+	go func() {
+		defer stop()
+		select {
+		case err := <-failed:
+			done <- err
+			return
+		case <-ctx.Done():
+		}
+		shutdown, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		done <- httpServer.Shutdown(shutdown)
+	}()
+	return done, nil
+}
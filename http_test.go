@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/cpuchip/scriptures-mcp/internal/scripture"
+)
+
+func TestHTTPHandler(t *testing.T) {
+	mcpServer := server.NewMCPServer("test", "1.0.0", server.WithToolCapabilities(true))
+	mcpServer.AddTool(mcp.NewTool("echo", mcp.WithString("text")), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		text, _ := request.GetArguments()["text"].(string)
+		return mcp.NewToolResultText(text), nil
+	})
+	complete := func(completion scripture.ArgumentCompletion) []string { return []string{"1 Nephi"} }
+	frontend := &httpFrontend{}
+	frontend.attach(&completingServer{MCPServer: mcpServer, complete: complete}, func() error { return nil })
+	httpServer := httptest.NewServer(frontend.handler())
+	defer httpServer.Close()
+
+	post := func(sessionID, body string) (*http.Response, map[string]any) {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodPost, httpServer.URL+mcpEndpoint, strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if sessionID != "" {
+			req.Header.Set(server.HeaderKeySessionID, sessionID)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		defer resp.Body.Close()
+		var message map[string]any
+		if err := json.NewDecoder(resp.Body).Decode(&message); err != nil {
+			t.Fatalf("Expected a JSON response, got error: %v", err)
+		}
+		return resp, message
+	}
+
+	// Initialize a session, then call a tool within it
+	resp, message := post("", `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-03-26","capabilities":{},"clientInfo":{"name":"test","version":"1.0.0"}}}`)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+	sessionID := resp.Header.Get(server.HeaderKeySessionID)
+	if sessionID == "" {
+		t.Fatal("Expected a session ID from initialize")
+	}
+	if _, ok := message["result"]; !ok {
+		t.Fatalf("Expected an initialize result, got %v", message)
+	}
+	if capabilities, _ := message["result"].(map[string]any)["capabilities"].(map[string]any); capabilities["completions"] == nil || capabilities["tools"] == nil {
+		t.Errorf("Expected the completions capability with the others, got %v", capabilities)
+	}
+
+	_, message = post(sessionID, `{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"echo","arguments":{"text":"over http"}}}`)
+	encoded, _ := json.Marshal(message["result"])
+	if !strings.Contains(string(encoded), `"text":"over http"`) {
+		t.Errorf("Expected the echoed text, got %s", encoded)
+	}
+
+	_, message = post(sessionID, `{"jsonrpc":"2.0","id":3,"method":"completion/complete","params":{"ref":{"type":"ref/prompt","name":"study"},"argument":{"name":"book","value":"1 Ne"}}}`)
+	encoded, _ = json.Marshal(message["result"])
+	if string(encoded) != `{"completion":{"total":1,"values":["1 Nephi"]}}` {
+		t.Errorf("Expected book completions, got %s", encoded)
+	}
+
+	// Other paths are not served
+	resp, err := http.Get(httpServer.URL + "/other")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status 404 for another path, got %d", resp.StatusCode)
+	}
+}
+
+func TestHTTPFrontend_Probes(t *testing.T) {
+	frontend := &httpFrontend{}
+	httpServer := httptest.NewServer(frontend.handler())
+	defer httpServer.Close()
+
+	get := func(path string) (int, string) {
+		t.Helper()
+		resp, err := http.Get(httpServer.URL + path)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(body)
+	}
+
+	mcpServer := server.NewMCPServer("test", "1.0.0")
+	notReady := errors.New("search index not built")
+	tests := []struct {
+		name     string
+		attach   func() error // Readiness check to attach, or nil to stay loading
+		path     string
+		status   int
+		contains string
+	}{
+		{name: "Live while loading", path: livenessEndpoint, status: http.StatusOK, contains: "ok"},
+		{name: "Not ready while loading", path: readinessEndpoint, status: http.StatusServiceUnavailable, contains: "still loading"},
+		{name: "MCP unavailable while loading", path: mcpEndpoint, status: http.StatusServiceUnavailable, contains: "still loading"},
+		{name: "Not ready when the service is not", attach: func() error { return notReady }, path: readinessEndpoint, status: http.StatusServiceUnavailable, contains: "search index not built"},
+		{name: "Ready once attached", attach: func() error { return nil }, path: readinessEndpoint, status: http.StatusOK, contains: "ready"},
+		{name: "Live once attached", attach: func() error { return nil }, path: livenessEndpoint, status: http.StatusOK, contains: "ok"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.attach != nil {
+				frontend.attach(&completingServer{MCPServer: mcpServer}, tt.attach)
+			}
+			status, body := get(tt.path)
+			if status != tt.status {
+				t.Errorf("Expected status %d, got %d", tt.status, status)
+			}
+			if !strings.Contains(body, tt.contains) {
+				t.Errorf("Expected body containing %q, got %q", tt.contains, body)
+			}
+		})
+	}
+}
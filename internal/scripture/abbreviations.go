@@ -0,0 +1,125 @@
+package scripture
+
+import (
+	"fmt"
+	"strings"
+)
+
+// footnoteAbbreviations are the print edition's footnote abbreviations and
+// their full labels
+var footnoteAbbreviations = map[string]string{
+	"TG":  "Topical Guide",
+	"BD":  "Bible Dictionary",
+	"JST": "Joseph Smith Translation",
+	"HEB": "Hebrew",
+	"GR":  "Greek",
+	"IE":  "That is",
+}
+
+// Kinds of footnote link
+const (
+	linkPassage         = "passage"
+	linkTopicalGuide    = "topical_guide"
+	linkBibleDictionary = "bible_dictionary"
+	linkJST             = "jst"
+)
+
+// LinkTarget is the tool call that follows a link
+type LinkTarget struct {
+	Tool      string         `json:"tool"`
+	Arguments map[string]any `json:"arguments"`
+}
+
+// resolveLink expands a footnote link's abbreviation into its kind, full
+// label and the tool call that follows it. Topical Guide entries are not
+// part of the data, so they are followed by the curated theme of the same
+// name or else a relevance search for the topic. A Joseph Smith Translation
+// link is followed to the KJV chapter it revises, since its verses may have
+// no KJV counterpart. Links that are not understood are left without a target.
+func (s *Service) resolveLink(passage *LinkedPassage) {
+	abbreviation, rest, _ := strings.Cut(passage.Reference, " ")
+	rest = strings.TrimSpace(rest)
+	switch abbreviation = strings.ToUpper(abbreviation); abbreviation {
+	case "TG":
+		passage.Kind = linkTopicalGuide
+		passage.Label = footnoteAbbreviations[abbreviation] + ": " + rest
+		passage.Target = &LinkTarget{Tool: "search_scriptures", Arguments: map[string]any{"query": rest, "sort": sortRelevance}}
+		if tag, ok := findTheme(rest); ok {
+			passage.Target = &LinkTarget{Tool: "search_by_theme", Arguments: map[string]any{"theme": tag.Theme}}
+		}
+		return
+	case "BD":
+		passage.Kind = linkBibleDictionary
+		passage.Label = footnoteAbbreviations[abbreviation] + ": " + rest
+		passage.Target = &LinkTarget{Tool: "bible_dictionary", Arguments: map[string]any{"entry": rest}}
+		return
+	case "JST":
+		// e.g. "JST Gen. 14:25-40 (Appendix)"
+		reference, _, _ := strings.Cut(rest, " (")
+		ref, err := s.parseReference(reference)
+		if err != nil || len(s.getChapter(ref.Book, ref.Chapter)) == 0 {
+			return
+		}
+		chapter := chapterKey{book: ref.Book, chapter: ref.Chapter}.String()
+		passage.Kind = linkJST
+		passage.Label = fmt.Sprintf("%s, %s:%d", footnoteAbbreviations[abbreviation], chapter, ref.Verse)
+		if ref.EndVerse != ref.Verse {
+			passage.Label += fmt.Sprintf("-%d", ref.EndVerse)
+		}
+		passage.Target = &LinkTarget{Tool: "get_chapter", Arguments: map[string]any{"query": chapter}}
+		return
+	}
+
+	if canonical, tool, ok := s.canonicalLink(passage.Reference); ok {
+		passage.Kind = linkPassage
+		passage.Label = canonical
+		passage.Target = &LinkTarget{Tool: tool, Arguments: map[string]any{"query": canonical}}
+	}
+}
+
+// canonicalLink returns the full form of a verse or chapter reference, such
+// as "Genesis 12:1-4" for "Gen. 12:1-4", with the tool that retrieves it
+func (s *Service) canonicalLink(reference string) (string, string, bool) {
+	if ref, err := s.parseReference(reference); err == nil {
+		if verses := s.getScripturesByReference(ref); len(verses) > 0 {
+			return spanReference(verses, verseSpan{Start: 0, End: len(verses) - 1}), "get_scripture", true
+		}
+		return "", "", false
+	}
+	if ref, err := s.parseChapterReference(reference); err == nil {
+		if verses := s.getChapter(ref.Book, ref.Chapter); len(verses) > 0 {
+			return chapterKey{book: ref.Book, chapter: ref.Chapter}.String(), "get_chapter", true
+		}
+	}
+	return "", "", false
+}
+
+// expandNote spells out the language and explanation abbreviations that
+// begin the parts of a footnote's note, e.g. "HEB lifted up; IE exalted"
+// becomes "Hebrew: lifted up; That is, exalted". It returns "" when the note
+// has none.
+func expandNote(note string) string {
+	parts := strings.Split(note, ";")
+	expanded := false
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		abbreviation, rest, ok := strings.Cut(part, " ")
+		switch strings.ToUpper(abbreviation) {
+		case "HEB", "GR":
+			if ok {
+				part = footnoteAbbreviations[strings.ToUpper(abbreviation)] + ": " + strings.TrimSpace(rest)
+				expanded = true
+			}
+		case "IE":
+			if ok {
+				part = footnoteAbbreviations["IE"] + ", " + strings.TrimSpace(rest)
+				expanded = true
+			}
+		}
+		parts[i] = part
+	}
+	if !expanded {
+		return ""
+	}
+	return strings.Join(parts, "; ")
+}
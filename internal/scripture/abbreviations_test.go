@@ -0,0 +1,63 @@
+package scripture
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestExpandNote(t *testing.T) {
+	tests := []struct {
+		note     string
+		expected string
+	}{
+		{note: "HEB lifted up", expected: "Hebrew: lifted up"},
+		{note: "GR gospel; IE good news", expected: "Greek: gospel; That is, good news"},
+		{note: "ie provide", expected: "That is, provide"},
+		{note: "OR surely", expected: ""},
+		{note: "HEB", expected: ""},
+		{note: "", expected: ""},
+	}
+	for _, tt := range tests {
+		if expanded := expandNote(tt.note); expanded != tt.expected {
+			t.Errorf("expandNote(%q): expected %q, got %q", tt.note, tt.expected, expanded)
+		}
+	}
+}
+
+func TestService_ResolveLink(t *testing.T) {
+	service := NewService()
+
+	tests := []struct {
+		link   string
+		kind   string
+		label  string
+		target string
+	}{
+		{link: "TG Obedience", kind: linkTopicalGuide, label: "Topical Guide: Obedience", target: "search_by_theme map[theme:obedience]"},
+		{link: "TG Jesus Christ, Atonement through", kind: linkTopicalGuide, label: "Topical Guide: Jesus Christ, Atonement through", target: "search_scriptures map[query:Jesus Christ, Atonement through sort:relevance]"},
+		{link: "BD Faith", kind: linkBibleDictionary, label: "Bible Dictionary: Faith", target: "bible_dictionary map[entry:Faith]"},
+		{link: "JST Gen. 14:25-40 (Appendix)", kind: linkJST, label: "Joseph Smith Translation, Genesis 14:25-40", target: "get_chapter map[query:Genesis 14]"},
+		{link: "Gen. 12:1-4", kind: linkPassage, label: "Genesis 12:1-4", target: "get_scripture map[query:Genesis 12:1-4]"},
+		{link: "Alma 32", kind: linkPassage, label: "Alma 32", target: "get_chapter map[query:Alma 32]"},
+		{link: "JST Hezekiah 1:1"},
+		{link: "Hezekiah 1:1"},
+		{link: "See the preface"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.link, func(t *testing.T) {
+			passage := service.linkedPassage(tt.link)
+			target := ""
+			if passage.Target != nil {
+				target = fmt.Sprint(passage.Target.Tool, " ", passage.Target.Arguments)
+			}
+			if passage.Kind != tt.kind || passage.Label != tt.label || target != tt.target {
+				t.Errorf("Expected %s %q → %q, got %s %q → %q", tt.kind, tt.label, tt.target, passage.Kind, passage.Label, target)
+			}
+		})
+	}
+
+	// A JST link is not the KJV text it revises
+	if passage := service.linkedPassage("JST Gen. 14:25"); len(passage.Verses) != 0 {
+		t.Errorf("Expected no verses for a JST link, got %+v", passage.Verses)
+	}
+}
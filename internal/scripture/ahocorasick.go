@@ -0,0 +1,90 @@
+package scripture
+
+// matcher finds every occurrence of a set of patterns in one pass over a
+// text, using the Aho-Corasick automaton. Patterns and texts are compared
+// byte for byte, so callers lowercase both for case-insensitive matching.
+//
+// The automaton is stored as a dense transition table over the byte classes
+// that occur in the patterns; every other byte sends the scan back to the
+// root. Following a failure link is resolved while building, so scanning
+// costs one table lookup per byte of text.
+type matcher struct {
+	patterns []string
+	classes  [256]uint8 // Byte to class; 0 for bytes in no pattern
+	width    int        // Number of classes, including 0
+	next     []int32    // Transition table: next[state*width+class]
+	outputs  [][]int32  // Patterns ending at each state, including via failure links
+}
+
+// newMatcher builds a matcher for the patterns. Empty patterns never match.
+func newMatcher(patterns []string) *matcher {
+	m := &matcher{patterns: patterns, width: 1}
+	for _, pattern := range patterns {
+		for i := 0; i < len(pattern); i++ {
+			if m.classes[pattern[i]] == 0 {
+				m.classes[pattern[i]] = uint8(m.width)
+				m.width++
+			}
+		}
+	}
+
+	// Build the trie of patterns; state 0 is the root
+	m.next = make([]int32, m.width)
+	m.outputs = [][]int32{nil}
+	for id, pattern := range patterns {
+		if pattern == "" {
+			continue
+		}
+		state := int32(0)
+		for i := 0; i < len(pattern); i++ {
+			edge := int(state)*m.width + int(m.classes[pattern[i]])
+			if m.next[edge] == 0 {
+				m.next[edge] = int32(len(m.outputs))
+				m.next = append(m.next, make([]int32, m.width)...)
+				m.outputs = append(m.outputs, nil)
+			}
+			state = m.next[edge]
+		}
+		m.outputs[state] = append(m.outputs[state], int32(id))
+	}
+
+	// Breadth-first, fill in missing transitions from each state's failure
+	// state and inherit its outputs. Depth-one states fail to the root,
+	// whose missing transitions already lead back to itself.
+	fail := make([]int32, len(m.outputs))
+	var queue []int32
+	for class := 1; class < m.width; class++ {
+		if child := m.next[class]; child != 0 {
+			queue = append(queue, child)
+		}
+	}
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+		for class := 1; class < m.width; class++ {
+			edge := int(state)*m.width + class
+			fallback := m.next[int(fail[state])*m.width+class]
+			child := m.next[edge]
+			if child == 0 {
+				m.next[edge] = fallback
+				continue
+			}
+			fail[child] = fallback
+			m.outputs[child] = append(m.outputs[child], m.outputs[fallback]...)
+			queue = append(queue, child)
+		}
+	}
+	return m
+}
+
+// scan calls found with the pattern ID and end offset of every occurrence of
+// every pattern in text, including overlapping ones, in order of end offset
+func (m *matcher) scan(text string, found func(id, end int)) {
+	state := int32(0)
+	for i := 0; i < len(text); i++ {
+		state = m.next[int(state)*m.width+int(m.classes[text[i]])]
+		for _, id := range m.outputs[state] {
+			found(int(id), i+1)
+		}
+	}
+}
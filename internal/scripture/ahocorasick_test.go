@@ -0,0 +1,65 @@
+package scripture
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestMatcher_Scan(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		text     string
+		expected []string // pattern@end for each occurrence, in scan order
+	}{
+		{
+			name:     "Overlapping and nested patterns",
+			patterns: []string{"he", "she", "his", "hers"},
+			text:     "ushers",
+			expected: []string{"she@4", "he@4", "hers@6"},
+		},
+		{
+			name:     "Repeated pattern overlaps itself",
+			patterns: []string{"aa"},
+			text:     "aaaa",
+			expected: []string{"aa@2", "aa@3", "aa@4"},
+		},
+		{
+			name:     "Failure past an unrelated byte",
+			patterns: []string{"faith", "ait"},
+			text:     "fa faith, faithful",
+			expected: []string{"ait@7", "faith@8", "ait@14", "faith@15"},
+		},
+		{
+			name:     "Phrases and multibyte text",
+			patterns: []string{"still small", "œ", "small voice"},
+			text:     "a still small voice; cœur",
+			expected: []string{"still small@13", "small voice@19", "œ@24"},
+		},
+		{
+			name:     "Empty pattern never matches",
+			patterns: []string{"", "a"},
+			text:     "aba",
+			expected: []string{"a@1", "a@3"},
+		},
+		{
+			name:     "No patterns",
+			text:     "anything",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newMatcher(tt.patterns)
+			var found []string
+			m.scan(tt.text, func(id, end int) {
+				found = append(found, fmt.Sprintf("%s@%d", tt.patterns[id], end))
+			})
+			if !reflect.DeepEqual(found, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, found)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package scripture
+
+import (
+	"strings"
+	"unicode"
+)
+
+// bookAliases maps abbreviations and alternate names to canonical book
+// names. Keys are in the form produced by normalizeBookName. The table
+// covers the standard abbreviations of the Latter-day Saint scriptures
+// ("1 Ne.", "D&C", "JS—H", "A of F") and common Bible abbreviations.
+var bookAliases = map[string]string{
+	// Old Testament
+	"gen": "Genesis", "gn": "Genesis",
+	"ex": "Exodus", "exod": "Exodus",
+	"lev": "Leviticus", "lv": "Leviticus",
+	"num": "Numbers", "nm": "Numbers",
+	"deut": "Deuteronomy", "dt": "Deuteronomy",
+	"josh": "Joshua",
+	"judg": "Judges", "jdg": "Judges",
+	"1 sam": "1 Samuel", "2 sam": "2 Samuel",
+	"1 kgs": "1 Kings", "2 kgs": "2 Kings",
+	"1 chr": "1 Chronicles", "2 chr": "2 Chronicles",
+	"neh": "Nehemiah",
+	"esth": "Esther", "est": "Esther",
+	"ps": "Psalms", "psa": "Psalms", "psalm": "Psalms", "pss": "Psalms",
+	"prov": "Proverbs", "prv": "Proverbs",
+	"eccl": "Ecclesiastes", "eccles": "Ecclesiastes", "qoh": "Ecclesiastes",
+	"song": "Solomon's Song", "song of solomon": "Solomon's Song", "song of songs": "Solomon's Song", "canticles": "Solomon's Song",
+	"isa": "Isaiah", "is": "Isaiah",
+	"jer": "Jeremiah",
+	"lam": "Lamentations",
+	"ezek": "Ezekiel", "ezk": "Ezekiel",
+	"dan": "Daniel", "dn": "Daniel",
+	"hos": "Hosea",
+	"obad": "Obadiah", "ob": "Obadiah",
+	"jon": "Jonah",
+	"mic": "Micah",
+	"nah": "Nahum",
+	"hab": "Habakkuk",
+	"zeph": "Zephaniah",
+	"hag": "Haggai",
+	"zech": "Zechariah",
+	"mal": "Malachi",
+
+	// New Testament
+	"matt": "Matthew", "mt": "Matthew",
+	"mk": "Mark", "mrk": "Mark",
+	"lk": "Luke",
+	"jn": "John",
+	"rom": "Romans",
+	"1 cor": "1 Corinthians", "2 cor": "2 Corinthians",
+	"gal": "Galatians",
+	"eph": "Ephesians",
+	"philip": "Philippians", "phil": "Philippians", "php": "Philippians",
+	"col": "Colossians",
+	"1 thes": "1 Thessalonians", "2 thes": "2 Thessalonians",
+	"1 thess": "1 Thessalonians", "2 thess": "2 Thessalonians",
+	"1 tim": "1 Timothy", "2 tim": "2 Timothy",
+	"tit": "Titus",
+	"philem": "Philemon", "phlm": "Philemon", "phm": "Philemon",
+	"heb": "Hebrews",
+	"jas": "James", "jm": "James",
+	"1 pet": "1 Peter", "2 pet": "2 Peter", "1 pt": "1 Peter", "2 pt": "2 Peter",
+	"1 jn": "1 John", "2 jn": "2 John", "3 jn": "3 John",
+	"rev": "Revelation", "revelations": "Revelation", "rv": "Revelation",
+
+	// Book of Mormon
+	"1 ne": "1 Nephi", "2 ne": "2 Nephi", "3 ne": "3 Nephi", "4 ne": "4 Nephi",
+	"w of m": "Words of Mormon", "wom": "Words of Mormon",
+	"hel": "Helaman",
+	"morm": "Mormon",
+	"moro": "Moroni",
+
+	// Doctrine and Covenants and Pearl of Great Price
+	"d and c": "Doctrine and Covenants", "dc": "Doctrine and Covenants",
+	"abr": "Abraham",
+	"js m": "Joseph Smith—Matthew", "jsm": "Joseph Smith—Matthew",
+	"js h": "Joseph Smith—History", "jsh": "Joseph Smith—History",
+	"a of f": "Articles of Faith", "aof": "Articles of Faith",
+}
+
+// bookNumbers are the spelled-out and Roman forms of the numbers that start
+// book names such as "1 Nephi" and "3 John"
+var bookNumbers = map[string]string{
+	"first": "1", "second": "2", "third": "3", "fourth": "4",
+	"1st": "1", "2nd": "2", "3rd": "3", "4th": "4",
+	"i": "1", "ii": "2", "iii": "3", "iv": "4",
+}
+
+// minBookPrefix is the shortest unambiguous prefix, in letters, accepted in
+// place of a book name that has no alias
+const minBookPrefix = 3
+
+// resolveBook returns the loaded book a name refers to: the name itself,
+// the same name in any case or punctuation ("john", "Joseph Smith-History"),
+// an alias from bookAliases ("1 Ne.", "D&C", "Gen"), or otherwise a prefix
+// of at least minBookPrefix letters that starts exactly one book name
+// ("Zephan"). It reports false if the name matches no loaded book.
+func (s *Service) resolveBook(name string) (string, bool) {
+	if _, exists := s.scriptures[name]; exists {
+		return name, true
+	}
+	key := normalizeBookName(name)
+	if key == "" {
+		return "", false
+	}
+	for _, book := range s.bookOrder {
+		if normalizeBookName(book) == key {
+			return book, true
+		}
+	}
+	if book, ok := bookAliases[key]; ok {
+		if _, exists := s.scriptures[book]; exists {
+			return book, true
+		}
+	}
+
+	letters := strings.IndexFunc(key, unicode.IsLetter)
+	if letters < 0 || len(key)-letters < minBookPrefix {
+		return "", false
+	}
+	match := ""
+	for _, book := range s.bookOrder {
+		if strings.HasPrefix(normalizeBookName(book), key) {
+			if match != "" {
+				return "", false // Ambiguous
+			}
+			match = book
+		}
+	}
+	return match, match != ""
+}
+
+// normalizeBookName reduces a book name or abbreviation to the form used as
+// a bookAliases key: lowercase, without periods or apostrophes, "&" spelled
+// "and", dashes as spaces, a leading number as a digit separated from the
+// name, and single spaces between words. "1Ne." becomes "1 ne", "D&C"
+// becomes "d and c" and "JS—H" becomes "js h".
+func normalizeBookName(name string) string {
+	name = strings.ToLower(name)
+	name = strings.NewReplacer(".", "", "'", "", "’", "", "&", " and ", "—", " ", "–", " ", "-", " ").Replace(name)
+	words := strings.Fields(name)
+	if len(words) == 0 {
+		return ""
+	}
+	if number, ok := bookNumbers[words[0]]; ok && len(words) > 1 {
+		words[0] = number
+	}
+	// "1ne" is "1 ne"
+	if first := words[0]; len(first) > 1 && first[0] >= '1' && first[0] <= '4' && unicode.IsLetter(rune(first[1])) {
+		words = append([]string{first[:1], first[1:]}, words[1:]...)
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,97 @@
+package scripture
+
+import (
+	"testing"
+)
+
+func TestService_ResolveBook(t *testing.T) {
+	service := NewService()
+
+	tests := []struct {
+		name     string
+		expected string // Empty if the name matches no book
+	}{
+		{name: "1 Nephi", expected: "1 Nephi"},
+		{name: "1 Ne.", expected: "1 Nephi"},
+		{name: "1Ne", expected: "1 Nephi"},
+		{name: "First Nephi", expected: "1 Nephi"},
+		{name: "I Nephi", expected: "1 Nephi"},
+		{name: "D&C", expected: "Doctrine and Covenants"},
+		{name: "D & C", expected: "Doctrine and Covenants"},
+		{name: "Doctrine & Covenants", expected: "Doctrine and Covenants"},
+		{name: "Gen.", expected: "Genesis"},
+		{name: "Matt", expected: "Matthew"},
+		{name: "john", expected: "John"},
+		{name: "1 Jn", expected: "1 John"},
+		{name: "Song of Solomon", expected: "Solomon's Song"},
+		{name: "Solomons Song", expected: "Solomon's Song"},
+		{name: "JS—H", expected: "Joseph Smith—History"},
+		{name: "JS-M", expected: "Joseph Smith—Matthew"},
+		{name: "Joseph Smith-History", expected: "Joseph Smith—History"},
+		{name: "W of M", expected: "Words of Mormon"},
+		{name: "A of F", expected: "Articles of Faith"},
+		{name: "Zephan", expected: "Zephaniah"},
+		{name: "Mor", expected: ""},
+		{name: "Jo", expected: ""},
+		{name: "Hezekiah", expected: ""},
+		{name: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			book, ok := service.resolveBook(tt.name)
+			if ok != (tt.expected != "") || book != tt.expected {
+				t.Errorf("Expected %q, got %q (ok=%v)", tt.expected, book, ok)
+			}
+		})
+	}
+
+	// Every alias names a loaded book and is already normalized
+	for alias, book := range bookAliases {
+		if _, exists := service.scriptures[book]; !exists {
+			t.Errorf("Alias %q names unknown book %q", alias, book)
+		}
+		if normalizeBookName(alias) != alias {
+			t.Errorf("Alias %q is not normalized; it would never match", alias)
+		}
+	}
+}
+
+func TestService_AbbreviatedReferences(t *testing.T) {
+	service := NewService()
+
+	tests := []struct {
+		query    string
+		expected string // Reference of the first verse found
+	}{
+		{query: "1 Ne 3:7", expected: "1 Nephi 3:7"},
+		{query: "D&C 4:2", expected: "D&C 4:2"},
+		{query: "Gen. 1:1", expected: "Genesis 1:1"},
+		{query: "JS—H 1:17", expected: "Joseph Smith—History 1:17"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			ref, err := service.parseReference(tt.query)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			verses := service.getScripturesByReference(ref)
+			if len(verses) == 0 || verses[0].Reference != tt.expected {
+				t.Errorf("Expected %s, got %v", tt.expected, verses)
+			}
+		})
+	}
+
+	ref, err := service.parseChapterReference("Matt 5")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if verses := service.getChapter(ref.Book, ref.Chapter); len(verses) != 48 {
+		t.Errorf("Expected the 48 verses of Matthew 5, got %d", len(verses))
+	}
+
+	scope, err := service.parseSelector("Moro.")
+	if err != nil || scope.Book != "Moroni" {
+		t.Errorf("Expected the Moroni scope, got %v, %v", scope, err)
+	}
+}
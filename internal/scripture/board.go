@@ -0,0 +1,240 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Board sizes activity_board accepts, in squares per side
+const (
+	minBoardSize     = 3
+	maxBoardSize     = 5
+	defaultBoardSize = 5
+)
+
+// maxBoards caps the boards per call, enough for a large class
+const maxBoards = 40
+
+// Kinds of squares on an activity board
+const (
+	boardPhrases    = "phrases"
+	boardReferences = "references"
+)
+
+// boardPoolFactor is how many times a board's squares the pool of phrases
+// holds, so the boards of one call differ while keeping the most used phrases
+const boardPoolFactor = 2
+
+// freeSquare is the text of a board's free center square
+const freeSquare = "FREE"
+
+// BoardSquare is one square of an activity board, or one item the leader
+// calls out
+type BoardSquare struct {
+	Text      string `json:"text"`                // Phrase or reference printed in the square
+	Reference string `json:"reference,omitempty"` // A verse to read: for a phrase, the first in the block that uses it
+	Verses    int    `json:"verses,omitempty"`    // For a phrase, the number of verses in the block that use it
+	Clue      string `json:"clue,omitempty"`      // For a reference, the verse text the leader reads out
+	Free      bool   `json:"free,omitempty"`      // The free center square
+}
+
+// ActivityBoard is one printable board, row by row
+type ActivityBoard struct {
+	Rows [][]BoardSquare `json:"rows"`
+}
+
+// boardPhrase is a phrase of a block with how many verses use it
+type boardPhrase struct {
+	text      string
+	reference string
+	verses    int
+}
+
+// ActivityBoard generates bingo-style activity boards for a block of
+// scripture: grids of the phrases its verses use most, or of its verse
+// references, with each board drawn differently from the same pool, and the
+// list of items for the leader to call in random order. Without a size, a
+// small block gets the largest board it fills. The same seed and arguments
+// always produce the same boards.
+func (s *Service) ActivityBoard(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	scopeText, _ := arguments["scope"].(string)
+	scopeText = strings.TrimSpace(scopeText)
+	if scopeText == "" {
+		return mcp.NewToolResultError("scope cannot be empty; name a collection, book, chapter or verse range"), nil
+	}
+	keep, err := s.scopeFilter(scopeText)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	kind := boardPhrases
+	if value, ok := arguments["kind"].(string); ok && value != "" {
+		kind = strings.ToLower(strings.TrimSpace(value))
+		if kind != boardPhrases && kind != boardReferences {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid kind '%s'; use %s or %s", value, boardPhrases, boardReferences)), nil
+		}
+	}
+
+	size := defaultBoardSize
+	sizeVal, sized := arguments["size"].(float64)
+	if sized {
+		size = min(max(int(sizeVal), minBoardSize), maxBoardSize)
+	}
+	count := 1
+	if countVal, ok := arguments["boards"].(float64); ok {
+		count = min(max(int(countVal), 1), maxBoards)
+	}
+	freeSpace := true
+	if freeVal, ok := arguments["free_space"].(bool); ok {
+		freeSpace = freeVal
+	}
+
+	seed, err := parseSeed(arguments)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	rng := rand.New(rand.NewPCG(uint64(seed), 0))
+
+	var pool []BoardSquare
+	if kind == boardPhrases {
+		for _, phrase := range s.boardPhrases(keep) {
+			pool = append(pool, BoardSquare{Text: phrase.text, Reference: phrase.reference, Verses: phrase.verses})
+		}
+	} else {
+		for _, verse := range s.flatVerses() {
+			if keep(verse) {
+				pool = append(pool, BoardSquare{Text: verse.Reference, Reference: verse.Reference, Clue: verse.Text})
+			}
+		}
+	}
+
+	// Without a size, a block too small for the default board gets the
+	// largest board it fills
+	for !sized && size > minBoardSize && len(pool) < boardSquares(size, freeSpace) {
+		size--
+	}
+	free := freeSpace && size%2 == 1
+	squares := boardSquares(size, freeSpace)
+	if kind == boardPhrases {
+		pool = pool[:min(len(pool), squares*boardPoolFactor)]
+	}
+	if len(pool) < squares {
+		return mcp.NewToolResultError(fmt.Sprintf("%s has %d %s for a board of %d squares; choose a larger block or a smaller size", scopeText, len(pool), kind, squares)), nil
+	}
+
+	structured := ActivityBoardResult{Scope: scopeText, Kind: kind, Size: size, Seed: seed}
+	called := make(map[string]bool)
+	for range count {
+		picks := rng.Perm(len(pool))[:squares]
+		board := ActivityBoard{}
+		for row := range size {
+			var squaresInRow []BoardSquare
+			for column := range size {
+				if free && row == size/2 && column == size/2 {
+					squaresInRow = append(squaresInRow, BoardSquare{Text: freeSquare, Free: true})
+					continue
+				}
+				square := pool[picks[0]]
+				picks = picks[1:]
+				squaresInRow = append(squaresInRow, BoardSquare{Text: square.Text, Reference: square.Reference, Verses: square.Verses})
+				if !called[square.Text] {
+					called[square.Text] = true
+					structured.Calls = append(structured.Calls, square)
+				}
+			}
+			board.Rows = append(board.Rows, squaresInRow)
+		}
+		structured.Boards = append(structured.Boards, board)
+	}
+	rng.Shuffle(len(structured.Calls), func(i, j int) {
+		structured.Calls[i], structured.Calls[j] = structured.Calls[j], structured.Calls[i]
+	})
+
+	response := fmt.Sprintf("Activity boards of %s from %s (%dx%d, seed %d):\n", kind, scopeText, size, size, seed)
+	for i, board := range structured.Boards {
+		response += fmt.Sprintf("\nBoard %d\n\n", i+1)
+		response += "|" + strings.Repeat("   |", size) + "\n|" + strings.Repeat("---|", size) + "\n"
+		for _, row := range board.Rows {
+			response += "|"
+			for _, square := range row {
+				response += " " + square.Text + " |"
+			}
+			response += "\n"
+		}
+	}
+	response += "\nCalls, in order:\n"
+	for i, square := range structured.Calls {
+		switch {
+		case square.Clue != "":
+			response += fmt.Sprintf("%d. %s (%s)\n", i+1, square.Clue, square.Reference)
+		default:
+			response += fmt.Sprintf("%d. %s (%s)\n", i+1, square.Text, square.Reference)
+		}
+	}
+	return mcp.NewToolResultStructured(structured, response), nil
+}
+
+// boardSquares returns how many squares of a board of a size hold an item
+func boardSquares(size int, freeSpace bool) int {
+	if freeSpace && size%2 == 1 {
+		return size*size - 1
+	}
+	return size * size
+}
+
+// boardPhrases returns the phrases of the verses kept that more than one
+// verse uses, most used first. Phrases are shingles, the same runs of words
+// find_duplicate_verses compares, that start and end with a word that is
+// not a common word, so "came to pass" is one but "it came to" is not.
+func (s *Service) boardPhrases(keep func(Scripture) bool) []boardPhrase {
+	common := make(map[string]bool)
+	for _, word := range commonWords[s.languageCode()] {
+		common[word] = true
+	}
+
+	byText := make(map[string]*boardPhrase)
+	var phrases []*boardPhrase
+	for _, verse := range s.flatVerses() {
+		if !keep(verse) {
+			continue
+		}
+		words := s.tokenizer().Words(verse.Text)
+		seen := make(map[string]bool)
+		for i := 0; i+shingleSize <= len(words); i++ {
+			run := words[i : i+shingleSize]
+			if common[run[0]] || common[run[len(run)-1]] {
+				continue
+			}
+			text := strings.Join(run, " ")
+			if seen[text] {
+				continue
+			}
+			seen[text] = true
+			phrase, ok := byText[text]
+			if !ok {
+				phrase = &boardPhrase{text: text, reference: verse.Reference}
+				byText[text] = phrase
+				phrases = append(phrases, phrase)
+			}
+			phrase.verses++
+		}
+	}
+
+	var used []boardPhrase
+	for _, phrase := range phrases {
+		if phrase.verses > 1 {
+			used = append(used, *phrase)
+		}
+	}
+	sort.SliceStable(used, func(i, j int) bool {
+		return used[i].verses > used[j].verses
+	})
+	return used
+}
@@ -0,0 +1,113 @@
+package scripture
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestService_ActivityBoard(t *testing.T) {
+	service := NewService()
+	call := func(arguments map[string]any) *mcp.CallToolResult {
+		t.Helper()
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = arguments
+		result, err := service.ActivityBoard(context.Background(), request)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	result := call(map[string]any{"scope": "1 Nephi", "boards": float64(3), "seed": float64(7)})
+	if result.IsError {
+		t.Fatalf("Unexpected error: %+v", result)
+	}
+	structured := result.StructuredContent.(ActivityBoardResult)
+	if structured.Kind != boardPhrases || structured.Size != 5 || structured.Seed != 7 || len(structured.Boards) != 3 {
+		t.Fatalf("Unexpected result: %+v", structured)
+	}
+	called := make(map[string]bool)
+	for _, square := range structured.Calls {
+		called[square.Text] = true
+	}
+	for i, board := range structured.Boards {
+		seen := make(map[string]bool)
+		for row, squares := range board.Rows {
+			if len(squares) != 5 {
+				t.Fatalf("Expected 5 squares a row, got %d", len(squares))
+			}
+			for column, square := range squares {
+				if center := row == 2 && column == 2; center != square.Free {
+					t.Errorf("Expected only the center square free, got %+v at %d,%d", square, row, column)
+				}
+				if square.Free {
+					continue
+				}
+				if seen[square.Text] || !called[square.Text] || square.Verses < 2 || !strings.HasPrefix(square.Reference, "1 Nephi ") {
+					t.Errorf("Unexpected square %+v on board %d", square, i+1)
+				}
+				seen[square.Text] = true
+			}
+		}
+	}
+	if !called["came to pass"] {
+		t.Errorf("Expected the block's most used phrase called, got %+v", structured.Calls)
+	}
+
+	// The same seed gives the same boards, another seed others
+	again := call(map[string]any{"scope": "1 Nephi", "boards": float64(3), "seed": float64(7)}).StructuredContent.(ActivityBoardResult)
+	if !reflect.DeepEqual(again, structured) {
+		t.Error("Expected the same boards for the same seed")
+	}
+	other := call(map[string]any{"scope": "1 Nephi", "boards": float64(3), "seed": float64(8)}).StructuredContent.(ActivityBoardResult)
+	if reflect.DeepEqual(other.Boards, structured.Boards) {
+		t.Error("Expected other boards for another seed")
+	}
+
+	// The documented examples work with the default arguments; a chapter
+	// with too few phrases for the default board gets a smaller one
+	for _, arguments := range []map[string]any{
+		{"scope": "Alma 32"},
+		{"scope": "Moroni"},
+		{"scope": "Book of Mormon"},
+		{"scope": "Moroni", "boards": float64(30), "seed": float64(12)},
+	} {
+		if result := call(arguments); result.IsError {
+			t.Errorf("Expected boards for %v, got %+v", arguments, result)
+		}
+	}
+	structured = call(map[string]any{"scope": "Alma 32"}).StructuredContent.(ActivityBoardResult)
+	if structured.Size != 4 || len(structured.Boards[0].Rows) != 4 || len(structured.Calls) != 16 {
+		t.Errorf("Expected a 4x4 board of Alma 32, got %+v", structured)
+	}
+	if result := call(map[string]any{"scope": "Alma 32", "size": float64(5)}); !result.IsError {
+		t.Errorf("Expected an error for a 5x5 board of Alma 32 when asked for, got %+v", result)
+	}
+
+	result = call(map[string]any{"scope": "Alma 32", "kind": "references", "size": float64(4)})
+	structured = result.StructuredContent.(ActivityBoardResult)
+	if len(structured.Boards[0].Rows) != 4 || len(structured.Calls) != 16 {
+		t.Fatalf("Expected a 4x4 board without a free square, got %+v", structured)
+	}
+	for _, square := range structured.Calls {
+		if !strings.HasPrefix(square.Text, "Alma 32:") || square.Clue == "" {
+			t.Errorf("Expected an Alma 32 reference with its verse, got %+v", square)
+		}
+	}
+
+	for _, arguments := range []map[string]any{
+		{"scope": " "},
+		{"scope": "Hezekiah"},
+		{"scope": "Alma 32", "kind": "pictures"},
+		{"scope": "John 3:16-17", "kind": "references"},
+		{"scope": "Alma 32", "seed": float64(-1)},
+	} {
+		if result := call(arguments); !result.IsError {
+			t.Errorf("Expected an error for %v, got %+v", arguments, result)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// defaultBookChapters is how many chapters get_book returns per page by
+// default, enough for every one-chapter book and most short epistles
+const defaultBookChapters = 5
+
+// maxBookChapters caps the max_chapters argument of get_book, so one call
+// cannot return a book like Alma or Psalms whole
+const maxBookChapters = 10
+
+// BookChapter is one chapter of a get_book page
+type BookChapter struct {
+	Chapter int         `json:"chapter"`
+	Heading string      `json:"heading,omitempty"` // The chapter heading (summary), when the data has one
+	Verses  []Scripture `json:"verses"`
+}
+
+// GetBook retrieves a whole book, or a page of its chapters when it has more
+// than max_chapters, so short books like Enos, Jarom or Jude take one call
+// instead of one per chapter. start_chapter pages through longer books. Each
+// chapter is its own text content block, the first led by the book's title
+// and the last followed by the paging hint.
+func (s *Service) GetBook(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	name, _ := arguments["book"].(string)
+	if strings.TrimSpace(name) == "" {
+		return mcp.NewToolResultError("book cannot be empty"), nil
+	}
+	book, ok := s.resolveBook(strings.TrimSpace(name))
+	if !ok {
+		return mcp.NewToolResultError(fmt.Sprintf("unknown book '%s'", name)), nil
+	}
+
+	format, err := parseFormat(arguments)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	maxChapters := defaultBookChapters
+	if maxVal, ok := arguments["max_chapters"].(float64); ok {
+		maxChapters = min(max(int(maxVal), 1), maxBookChapters)
+	}
+
+	chapters := s.chapterNumbers(book)
+	start := 0
+	if startVal, ok := arguments["start_chapter"].(float64); ok {
+		start = -1
+		for i, chapter := range chapters {
+			if chapter == int(startVal) {
+				start = i
+			}
+		}
+		if start < 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("%s has no chapter %d; its chapters are %d to %d", book, int(startVal), chapters[0], chapters[len(chapters)-1])), nil
+		}
+	}
+	page := chapters[start:min(start+maxChapters, len(chapters))]
+
+	title := s.bookTitles[book]
+	structured := BookResult{
+		Book:         book,
+		Collection:   s.bookCollections[book],
+		BookTitle:    title.FullTitle,
+		BookHeading:  title.Heading,
+		ChapterCount: len(chapters),
+	}
+	for _, chapter := range page {
+		structured.Chapters = append(structured.Chapters, BookChapter{
+			Chapter: chapter,
+			Heading: s.chapterHeadings[chapterKey{book: book, chapter: chapter}],
+			Verses:  append([]Scripture{}, s.getChapter(book, chapter)...),
+		})
+	}
+	if start+len(page) < len(chapters) {
+		structured.NextChapter = chapters[start+len(page)]
+	}
+
+	header := book + "\n\n"
+	if start == 0 {
+		for _, part := range []string{title.FullTitle, title.Heading} {
+			if part != "" {
+				header += part + "\n\n"
+			}
+		}
+	}
+	blocks := make([]string, len(structured.Chapters))
+	for i, chapter := range structured.Chapters {
+		blocks[i] = bookChapterText(book, chapter, format)
+	}
+	blocks[0] = header + blocks[0]
+	if len(page) < len(chapters) {
+		footer := fmt.Sprintf("Chapters %d-%d of %d.", page[0], page[len(page)-1], len(chapters))
+		if structured.NextChapter > 0 {
+			footer += fmt.Sprintf(" Next: call get_book with start_chapter %d.", structured.NextChapter)
+		}
+		blocks[len(blocks)-1] += "\n\n" + footer
+	}
+
+	result := mcp.NewToolResultStructured(structured, blocks[0])
+	result.Content = make([]mcp.Content, len(blocks))
+	for i, block := range blocks {
+		result.Content[i] = mcp.NewTextContent(block)
+	}
+	return result, nil
+}
+
+// bookChapterText renders one chapter of a get_book page in a format
+func bookChapterText(book string, chapter BookChapter, format string) string {
+	switch format {
+	case formatSpeech:
+		return strings.TrimRight(speechPassage(spokenChapter(book, chapter.Chapter), chapter.Verses), "\n")
+	case formatAccessible:
+		return strings.TrimRight(accessiblePassage(fmt.Sprintf("%s chapter %d", book, chapter.Chapter), chapter.Verses, nil), "\n")
+	}
+	text := fmt.Sprintf("Chapter %d\n\n", chapter.Chapter)
+	if chapter.Heading != "" {
+		text += chapter.Heading + "\n\n"
+	}
+	for _, verse := range chapter.Verses {
+		text += fmt.Sprintf("%d. %s\n\n", verse.Verse, formatVerseText(verse, format, "   "))
+	}
+	return strings.TrimRight(text, "\n")
+}
@@ -0,0 +1,89 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestService_GetBook(t *testing.T) {
+	service := NewService()
+	call := func(arguments map[string]any) *mcp.CallToolResult {
+		t.Helper()
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = arguments
+		result, err := service.GetBook(context.Background(), request)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	// A one-chapter book comes whole, by abbreviation too
+	result := call(map[string]any{"book": "Enos"})
+	if result.IsError {
+		t.Fatalf("Unexpected error: %+v", result)
+	}
+	enos := result.StructuredContent.(BookResult)
+	if enos.Book != "Enos" || enos.ChapterCount != 1 || len(enos.Chapters) != 1 || len(enos.Chapters[0].Verses) != 27 || enos.NextChapter != 0 {
+		t.Errorf("Expected all 27 verses of Enos, got %+v", enos)
+	}
+	text := result.Content[0].(mcp.TextContent).Text
+	if !strings.HasPrefix(text, "Enos\n\n") || !strings.Contains(text, "27. ") || strings.Contains(text, "Next:") {
+		t.Errorf("Unexpected text for Enos:\n%s", text)
+	}
+	if book := call(map[string]any{"book": "3 Jn"}).StructuredContent.(BookResult); book.Book != "3 John" {
+		t.Errorf("Expected 3 John for '3 Jn', got %s", book.Book)
+	}
+
+	// Longer books are paged by chapter
+	first := call(map[string]any{"book": "Jarom", "max_chapters": float64(1)}).StructuredContent.(BookResult)
+	if first.ChapterCount != 1 || first.NextChapter != 0 {
+		t.Errorf("Expected Jarom on one page, got %+v", first)
+	}
+	page := call(map[string]any{"book": "Helaman", "start_chapter": float64(14), "max_chapters": float64(2)})
+	helaman := page.StructuredContent.(BookResult)
+	if helaman.ChapterCount != 16 || len(helaman.Chapters) != 2 || helaman.Chapters[0].Chapter != 14 || helaman.NextChapter != 16 {
+		t.Errorf("Expected Helaman 14-15 with 16 next, got chapters %d of %d, next %d", len(helaman.Chapters), helaman.ChapterCount, helaman.NextChapter)
+	}
+	// One content block per chapter, the paging hint after the last
+	if len(page.Content) != 2 {
+		t.Fatalf("Expected a content block per chapter, got %d", len(page.Content))
+	}
+	if text := page.Content[0].(mcp.TextContent).Text; !strings.HasPrefix(text, "Helaman\n\nChapter 14\n\n") || strings.Contains(text, "Chapter 15") {
+		t.Errorf("Expected the first block to hold chapter 14, got:\n%s", text)
+	}
+	if text := page.Content[1].(mcp.TextContent).Text; !strings.HasPrefix(text, "Chapter 15\n\n") || !strings.HasSuffix(text, "Chapters 14-15 of 16. Next: call get_book with start_chapter 16.") {
+		t.Errorf("Expected chapter 15 and a next page hint, got:\n%s", text)
+	}
+	last := call(map[string]any{"book": "Helaman", "start_chapter": float64(16)}).StructuredContent.(BookResult)
+	if len(last.Chapters) != 1 || last.NextChapter != 0 {
+		t.Errorf("Expected the last page to end the book, got %+v", last.NextChapter)
+	}
+	if guarded := call(map[string]any{"book": "Psalms", "max_chapters": float64(500)}).StructuredContent.(BookResult); len(guarded.Chapters) != maxBookChapters || guarded.NextChapter != 11 {
+		t.Errorf("Expected max_chapters capped at %d, got %d chapters", maxBookChapters, len(guarded.Chapters))
+	}
+
+	// The formats of the other reading tools work here too
+	spoken := call(map[string]any{"book": "Jarom", "format": "speech"})
+	if text := spoken.Content[0].(mcp.TextContent).Text; spoken.IsError || !strings.Contains(text, "Jarom, chapter 1.") || strings.Contains(text, "1. ") {
+		t.Errorf("Expected Jarom read aloud, got:\n%s", text)
+	}
+	accessible := call(map[string]any{"book": "Jarom", "format": "accessible"})
+	if text := accessible.Content[0].(mcp.TextContent).Text; accessible.IsError || !strings.Contains(text, "Verse 1:\n") {
+		t.Errorf("Expected Jarom with labeled verses, got:\n%s", text)
+	}
+
+	for _, arguments := range []map[string]any{
+		{"book": ""},
+		{"book": "Hezekiah"},
+		{"book": "Enos", "start_chapter": float64(2)},
+		{"book": "Enos", "format": "braille"},
+	} {
+		if result := call(arguments); !result.IsError {
+			t.Errorf("Expected an error for %v, got %+v", arguments, result)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ChapterInfo is the size of one chapter
+type ChapterInfo struct {
+	Chapter int `json:"chapter"`
+	Verses  int `json:"verses"`
+	Words   int `json:"words"`
+}
+
+// GetBookInfo returns a book's metadata: its collection, where it sits in
+// canonical order, and how many chapters, verses and words it has, with the
+// verse count of every chapter, so a reference can be checked before
+// fetching it. With a chapter, only that chapter's counts are listed.
+func (s *Service) GetBookInfo(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	name, _ := arguments["book"].(string)
+	if strings.TrimSpace(name) == "" {
+		return mcp.NewToolResultError("book cannot be empty"), nil
+	}
+	book, ok := s.resolveBook(strings.TrimSpace(name))
+	if !ok {
+		return mcp.NewToolResultError(fmt.Sprintf("unknown book '%s'", name)), nil
+	}
+
+	structured := BookInfoResult{Book: book, Collection: s.bookCollections[book]}
+	for i, other := range s.orderedBooks() {
+		if s.bookCollections[other] == structured.Collection {
+			structured.CollectionBooks++
+			if other == book {
+				structured.CollectionPosition = structured.CollectionBooks
+			}
+		}
+		if other == book {
+			structured.CanonicalPosition = i + 1
+		}
+		structured.CanonicalBooks++
+	}
+
+	for _, chapter := range s.chapterNumbers(book) {
+		info := ChapterInfo{Chapter: chapter}
+		for _, verse := range s.getChapter(book, chapter) {
+			info.Verses++
+			info.Words += len(s.tokenizer().Words(verse.Text))
+		}
+		structured.ChapterCount++
+		structured.VerseCount += info.Verses
+		structured.WordCount += info.Words
+		structured.Chapters = append(structured.Chapters, info)
+	}
+	if chapterVal, ok := arguments["chapter"].(float64); ok {
+		var only []ChapterInfo
+		for _, info := range structured.Chapters {
+			if info.Chapter == int(chapterVal) {
+				only = append(only, info)
+			}
+		}
+		if len(only) == 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("%s has no chapter %d; it has %d chapters", book, int(chapterVal), structured.ChapterCount)), nil
+		}
+		structured.Chapters = only
+	}
+
+	response := fmt.Sprintf("%s\n\n", book)
+	if structured.Collection != "" {
+		response += fmt.Sprintf("Collection: %s (book %d of %d)\n", structured.Collection, structured.CollectionPosition, structured.CollectionBooks)
+	}
+	response += fmt.Sprintf("Canonical order: book %d of %d\n", structured.CanonicalPosition, structured.CanonicalBooks)
+	response += fmt.Sprintf("Chapters: %d\nVerses: %d\nWords: %d\n\n", structured.ChapterCount, structured.VerseCount, structured.WordCount)
+	for _, info := range structured.Chapters {
+		response += fmt.Sprintf("Chapter %d: %d verses, %d words\n", info.Chapter, info.Verses, info.Words)
+	}
+	return mcp.NewToolResultStructured(structured, strings.TrimRight(response, "\n")), nil
+}
@@ -0,0 +1,62 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestService_GetBookInfo(t *testing.T) {
+	service := NewService()
+	call := func(arguments map[string]any) *mcp.CallToolResult {
+		t.Helper()
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = arguments
+		result, err := service.GetBookInfo(context.Background(), request)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	result := call(map[string]any{"book": "Gen"})
+	if result.IsError {
+		t.Fatalf("Unexpected error: %+v", result)
+	}
+	structured := result.StructuredContent.(BookInfoResult)
+	if structured.Book != "Genesis" || structured.Collection != "Old Testament" || structured.ChapterCount != 50 || len(structured.Chapters) != 50 {
+		t.Fatalf("Unexpected result: %+v", structured)
+	}
+	if structured.CanonicalPosition != 1 || structured.CollectionPosition != 1 || structured.CollectionBooks != 39 {
+		t.Errorf("Expected Genesis first of 39, got %+v", structured)
+	}
+	verses, words := 0, 0
+	for _, info := range structured.Chapters {
+		verses += info.Verses
+		words += info.Words
+	}
+	if verses != structured.VerseCount || words != structured.WordCount || structured.Chapters[0].Verses != 31 {
+		t.Errorf("Expected chapter counts adding up to %d verses and %d words, got %d and %d", structured.VerseCount, structured.WordCount, verses, words)
+	}
+
+	result = call(map[string]any{"book": "Moroni", "chapter": float64(10)})
+	structured = result.StructuredContent.(BookInfoResult)
+	if len(structured.Chapters) != 1 || structured.Chapters[0].Verses != 34 || structured.CollectionPosition != structured.CollectionBooks {
+		t.Errorf("Expected Moroni 10 of the last Book of Mormon book, got %+v", structured)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "Chapter 10: 34 verses") {
+		t.Errorf("Expected the chapter's counts, got:\n%s", text)
+	}
+
+	for _, arguments := range []map[string]any{
+		{"book": " "},
+		{"book": "Hezekiah"},
+		{"book": "Enos", "chapter": float64(2)},
+	} {
+		if result := call(arguments); !result.IsError {
+			t.Errorf("Expected an error for %v, got %+v", arguments, result)
+		}
+	}
+}
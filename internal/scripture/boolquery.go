@@ -0,0 +1,315 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Boolean search queries combine terms with AND, OR, NOT and parentheses,
+// e.g. `faith AND NOT works` or `(faith OR hope) AND charity`. Operators must
+// be uppercase words; NOT binds tightest, then AND, then OR, and terms next
+// to each other without an operator are ANDed. A term is a run of plain
+// words or a "quoted phrase" and matches like an ordinary query: as a
+// case-insensitive substring of the verse text or book name. Queries without
+// an operator keep their plain substring meaning, parentheses and quotes included.
+
+// queryTokenKind identifies a token of a boolean query
+type queryTokenKind int
+
+const (
+	tokenWord queryTokenKind = iota
+	tokenPhrase
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenOpen
+	tokenClose
+)
+
+// queryToken is one token of a boolean query
+type queryToken struct {
+	kind queryTokenKind
+	text string
+}
+
+// queryNode is a parsed boolean query. Terms are tested with match, which
+// receives the lowercased term.
+type queryNode interface {
+	matches(match func(term string) bool) bool
+	terms() []string
+}
+
+type termNode struct{ term string }
+type andNode struct{ left, right queryNode }
+type orNode struct{ left, right queryNode }
+type notNode struct{ operand queryNode }
+
+func (n termNode) matches(match func(string) bool) bool { return match(n.term) }
+func (n andNode) matches(match func(string) bool) bool {
+	return n.left.matches(match) && n.right.matches(match)
+}
+func (n orNode) matches(match func(string) bool) bool {
+	return n.left.matches(match) || n.right.matches(match)
+}
+func (n notNode) matches(match func(string) bool) bool { return !n.operand.matches(match) }
+
+func (n termNode) terms() []string { return []string{n.term} }
+func (n andNode) terms() []string  { return append(n.left.terms(), n.right.terms()...) }
+func (n orNode) terms() []string   { return append(n.left.terms(), n.right.terms()...) }
+func (n notNode) terms() []string  { return n.operand.terms() }
+
+// parseBooleanQuery parses a query with boolean operators. It returns nil
+// without an error for a query that has no operators, even one with a stray
+// quote, since such a query is matched literally.
+func parseBooleanQuery(query string) (queryNode, error) {
+	tokens, err := tokenizeQuery(query)
+	hasOperator := false
+	for _, token := range tokens {
+		if token.kind == tokenAnd || token.kind == tokenOr || token.kind == tokenNot {
+			hasOperator = true
+			break
+		}
+	}
+	if !hasOperator {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &queryParser{tokens: tokens}
+	node, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if token, ok := parser.peek(); ok {
+		return nil, fmt.Errorf("unexpected '%s' in query", token.text)
+	}
+	return node, nil
+}
+
+// tokenizeQuery splits a query into words, quoted phrases, operators and
+// parentheses. An unterminated quote is an error, but the text after it is
+// still split into tokens, so the caller can tell whether the query has
+// operators at all.
+func tokenizeQuery(query string) ([]queryToken, error) {
+	var tokens []queryToken
+	var err error
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		switch r := runes[i]; {
+		case r == ' ' || r == '\t' || r == '\n':
+			i++
+		case r == '(':
+			tokens = append(tokens, queryToken{kind: tokenOpen, text: "("})
+			i++
+		case r == ')':
+			tokens = append(tokens, queryToken{kind: tokenClose, text: ")"})
+			i++
+		case r == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			if end == len(runes) {
+				err = fmt.Errorf("unterminated quote in query")
+				i++
+				continue
+			}
+			tokens = append(tokens, queryToken{kind: tokenPhrase, text: string(runes[i+1 : end])})
+			i = end + 1
+		default:
+			end := i
+			for end < len(runes) && !strings.ContainsRune(" \t\n()\"", runes[end]) {
+				end++
+			}
+			word := string(runes[i:end])
+			switch word {
+			case "AND":
+				tokens = append(tokens, queryToken{kind: tokenAnd, text: word})
+			case "OR":
+				tokens = append(tokens, queryToken{kind: tokenOr, text: word})
+			case "NOT":
+				tokens = append(tokens, queryToken{kind: tokenNot, text: word})
+			default:
+				tokens = append(tokens, queryToken{kind: tokenWord, text: word})
+			}
+			i = end
+		}
+	}
+	return tokens, err
+}
+
+// queryParser is a recursive descent parser over query tokens
+type queryParser struct {
+	tokens []queryToken
+	pos    int
+}
+
+func (p *queryParser) peek() (queryToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return queryToken{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+// parseOr parses terms joined by OR
+func (p *queryParser) parseOr() (queryNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		token, ok := p.peek()
+		if !ok || token.kind != tokenOr {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left, right}
+	}
+}
+
+// parseAnd parses terms joined by AND or simply written next to each other
+func (p *queryParser) parseAnd() (queryNode, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		token, ok := p.peek()
+		if !ok || token.kind == tokenOr || token.kind == tokenClose {
+			return left, nil
+		}
+		if token.kind == tokenAnd {
+			p.pos++
+		}
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left, right}
+	}
+}
+
+// parseNot parses an optionally negated term
+func (p *queryParser) parseNot() (queryNode, error) {
+	if token, ok := p.peek(); ok && token.kind == tokenNot {
+		p.pos++
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+// parsePrimary parses a run of words, a quoted phrase or a parenthesized query
+func (p *queryParser) parsePrimary() (queryNode, error) {
+	token, ok := p.peek()
+	if !ok {
+		return nil, fmt.Errorf("query ends where a search term was expected")
+	}
+
+	switch token.kind {
+	case tokenOpen:
+		p.pos++
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing, ok := p.peek(); !ok || closing.kind != tokenClose {
+			return nil, fmt.Errorf("missing ')' in query")
+		}
+		p.pos++
+		return node, nil
+	case tokenPhrase:
+		p.pos++
+		if strings.TrimSpace(token.text) == "" {
+			return nil, fmt.Errorf("empty quoted phrase in query")
+		}
+		return termNode{strings.ToLower(token.text)}, nil
+	case tokenWord:
+		words := []string{token.text}
+		for p.pos++; p.pos < len(p.tokens) && p.tokens[p.pos].kind == tokenWord; p.pos++ {
+			words = append(words, p.tokens[p.pos].text)
+		}
+		return termNode{strings.ToLower(strings.Join(words, " "))}, nil
+	default:
+		return nil, fmt.Errorf("unexpected '%s' in query", token.text)
+	}
+}
+
+// verseKey identifies a verse independently of its text
+type verseKey struct {
+	book           string
+	chapter, verse int
+}
+
+// booleanMatches returns every verse matching a boolean query in canonical
+// order, looking each distinct term up once through searchMatches
+func (s *Service) booleanMatches(ctx context.Context, node queryNode) []Scripture {
+	terms := slices.Compact(slices.Sorted(slices.Values(node.terms())))
+	termMatches := make(map[string]map[verseKey]bool)
+	for _, term := range terms {
+		matched := make(map[verseKey]bool)
+		for _, verse := range s.searchMatches(ctx, term) {
+			matched[verseKey{verse.Book, verse.Chapter, verse.Verse}] = true
+		}
+		termMatches[term] = matched
+	}
+
+	var results []Scripture
+	for _, book := range s.orderedBooks() {
+		if ctx.Err() != nil {
+			break
+		}
+		for _, verse := range s.scriptures[book] {
+			key := verseKey{verse.Book, verse.Chapter, verse.Verse}
+			if node.matches(func(term string) bool { return termMatches[term][key] }) {
+				results = append(results, verse)
+			}
+		}
+	}
+	return results
+}
+
+// scanBooleanMatches evaluates a boolean query over its distinct, sorted
+// terms by scanning each book name and verse text once for all of them
+func (s *Service) scanBooleanMatches(ctx context.Context, node queryNode, terms []string) []Scripture {
+	index := s.index
+	if index == nil {
+		index = s.newSearchIndex()
+	}
+
+	m := newMatcher(terms)
+	inBook := make([]bool, len(terms))
+	inVerse := make([]bool, len(terms))
+	matches := func(term string) bool {
+		i, _ := slices.BinarySearch(terms, term)
+		return inVerse[i]
+	}
+
+	var results []Scripture
+	for _, book := range index.books {
+		if ctx.Err() != nil {
+			break
+		}
+		clear(inBook)
+		m.scan(book.nameLower, func(i, end int) { inBook[i] = true })
+		for id := book.start; id < book.end; id++ {
+			copy(inVerse, inBook)
+			m.scan(index.texts[id], func(i, end int) { inVerse[i] = true })
+			if node.matches(matches) {
+				results = append(results, index.verses[id])
+			}
+		}
+	}
+	return results
+}
@@ -0,0 +1,180 @@
+package scripture
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestParseBooleanQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		text     string
+		expected bool
+	}{
+		{name: "AND both", query: "faith AND works", text: "faith without works is dead", expected: true},
+		{name: "AND one", query: "faith AND works", text: "faith is things hoped for", expected: false},
+		{name: "OR", query: "faith OR works", text: "good works", expected: true},
+		{name: "AND NOT", query: "faith AND NOT works", text: "faith without works", expected: false},
+		{name: "NOT alone", query: "NOT works", text: "faith", expected: true},
+		{name: "Implicit AND", query: `"faith" NOT works`, text: "faith", expected: true},
+		{name: "Words form a phrase", query: "small voice OR thunder", text: "a voice that was small", expected: false},
+		{name: "Quoted phrase keeps operators literal", query: `"faith AND works" OR hope`, text: "faith and works", expected: true},
+		{name: "Lowercase operators are words", query: "faith and works OR hope", text: "faith and works", expected: true},
+		// a OR b AND c is a OR (b AND c)
+		{name: "AND binds tighter than OR", query: "faith OR hope AND charity", text: "faith", expected: true},
+		{name: "Parentheses override precedence", query: "(faith OR hope) AND charity", text: "faith", expected: false},
+		// NOT a AND b is (NOT a) AND b
+		{name: "NOT binds tighter than AND", query: "NOT faith AND charity", text: "charity", expected: true},
+		{name: "NOT of a group", query: "NOT (faith OR hope) AND charity", text: "hope and charity", expected: false},
+		{name: "Double negation", query: "NOT NOT faith", text: "faith", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := parseBooleanQuery(tt.query)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if node == nil {
+				t.Fatal("Expected a boolean query")
+			}
+			got := node.matches(func(term string) bool { return strings.Contains(tt.text, term) })
+			if got != tt.expected {
+				t.Errorf("Expected %q on %q to be %v", tt.query, tt.text, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseBooleanQuery_Plain(t *testing.T) {
+	for _, query := range []string{"faith", "still small voice", "(thus saith the Lord)", "and the Lord said", `he said "come"`, `he said "behold`} {
+		node, err := parseBooleanQuery(query)
+		if err != nil || node != nil {
+			t.Errorf("Expected %q to be a plain query, got %v, %v", query, node, err)
+		}
+	}
+}
+
+func TestParseBooleanQuery_Errors(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{query: "faith AND", expected: "query ends where a search term was expected"},
+		{query: "OR faith", expected: "unexpected 'OR' in query"},
+		{query: "(faith OR hope", expected: "missing ')' in query"},
+		{query: "faith OR hope)", expected: "unexpected ')' in query"},
+		{query: `"faith AND works`, expected: "unterminated quote in query"},
+		{query: `faith AND ""`, expected: "empty quoted phrase in query"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			_, err := parseBooleanQuery(tt.query)
+			if err == nil || err.Error() != tt.expected {
+				t.Errorf("Expected error %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestService_SearchScriptures_Boolean(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	service.recordBook("James", "New Testament")
+	service.scriptures["James"] = []Scripture{
+		{Book: "James", Chapter: 2, Verse: 17, Text: "Even so faith, if it hath not works, is dead, being alone."},
+		{Book: "James", Chapter: 2, Verse: 18, Text: "shew me thy faith without thy works"},
+		{Book: "James", Chapter: 2, Verse: 19, Text: "Thou believest that there is one God; thou doest well"},
+		{Book: "James", Chapter: 2, Verse: 23, Text: "Abraham believed God"},
+		{Book: "James", Chapter: 5, Verse: 9, Text: `Grudge not one against another, brethren, lest ye be condemned: "behold, the judge standeth before the door.`},
+	}
+
+	tests := []struct {
+		name          string
+		query         string
+		expectError   bool
+		shouldContain []string
+	}{
+		{name: "AND NOT", query: "God AND NOT Abraham", shouldContain: []string{"1. James 2:19"}},
+		{name: "OR across verses", query: "works OR believed", shouldContain: []string{"1. James 2:17", "3. James 2:23"}},
+		{name: "Parse error", query: "faith AND (works", expectError: true},
+		{name: "Stray quote without operators is literal", query: `condemned: "behold`, shouldContain: []string{"1. James 5:9"}},
+		{name: "Stray quote with operators", query: `faith AND "works`, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: map[string]interface{}{"query": tt.query},
+				},
+			}
+			result, err := service.SearchScriptures(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.IsError != tt.expectError {
+				t.Fatalf("Expected IsError = %v, got %v: %v", tt.expectError, result.IsError, result.Content)
+			}
+			if tt.expectError {
+				return
+			}
+			text := result.Content[0].(mcp.TextContent).Text
+			for _, expected := range tt.shouldContain {
+				if !strings.Contains(text, expected) {
+					t.Errorf("Expected output to contain %q, got:\n%s", expected, text)
+				}
+			}
+		})
+	}
+}
+
+func TestService_booleanMatches_Paths(t *testing.T) {
+	service := NewService()
+
+	queries := []string{
+		"faith AND works",
+		"charity OR \"pure love\"",
+		"faith AND NOT works",
+		"nephi AND NOT ne", // Book names
+		"(faith OR hope) AND (charity OR love) AND NOT christ",
+		"a OR b OR c OR d OR e",
+	}
+	for _, query := range queries {
+		t.Run(query, func(t *testing.T) {
+			node, err := parseBooleanQuery(query)
+			if err != nil || node == nil {
+				t.Fatalf("Failed to parse %q: %v", query, err)
+			}
+
+			// Evaluate every term against every verse directly
+			var expected []string
+			for _, verse := range service.flatVerses() {
+				if node.matches(func(term string) bool { return matchesQuery(verse, term) }) {
+					expected = append(expected, verse.Reference)
+				}
+			}
+
+			terms := slices.Compact(slices.Sorted(slices.Values(node.terms())))
+			for name, matches := range map[string][]Scripture{
+				"booleanMatches":     service.booleanMatches(context.Background(), node),
+				"scanBooleanMatches": service.scanBooleanMatches(context.Background(), node, terms),
+			} {
+				references := make([]string, len(matches))
+				for i, verse := range matches {
+					references[i] = verse.Reference
+				}
+				if !slices.Equal(references, expected) {
+					t.Errorf("%s found %d matches, expected %d", name, len(references), len(expected))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// defaultSearchBudget is how long one search may run before it returns the
+// matches found so far, unless SCRIPTURES_SEARCH_BUDGET says otherwise
+const defaultSearchBudget = 2 * time.Second
+
+// parseSearchBudget parses SCRIPTURES_SEARCH_BUDGET: a Go duration such as
+// "1500ms", or "0" or "off" for no limit. Empty means the default.
+func parseSearchBudget(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	switch strings.ToLower(value) {
+	case "":
+		return defaultSearchBudget, nil
+	case "0", "off":
+		return 0, nil
+	}
+	budget, err := time.ParseDuration(value)
+	if err != nil || budget < 0 {
+		return 0, fmt.Errorf("invalid search budget '%s': use a duration like 1500ms, or off", value)
+	}
+	return budget, nil
+}
+
+// withBudget bounds a search by the service's time budget. Searches stop at
+// the next book once the context is done and return what they have found.
+func (s *Service) withBudget(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.budget <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, s.budget)
+}
+
+// partialNotice explains results cut short by the time budget
+func (s *Service) partialNotice(found int) string {
+	return fmt.Sprintf("Partial results: the search stopped at its %s time budget after finding %d %s; narrow the query for complete results.",
+		s.budget, found, pluralize(found, "match", "matches"))
+}
@@ -0,0 +1,105 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestParseSearchBudget(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expected    time.Duration
+		expectError bool
+	}{
+		{name: "Default", value: "", expected: defaultSearchBudget},
+		{name: "Milliseconds", value: "1500ms", expected: 1500 * time.Millisecond},
+		{name: "Seconds", value: " 1s ", expected: time.Second},
+		{name: "Zero", value: "0", expected: 0},
+		{name: "Off", value: "OFF", expected: 0},
+		{name: "No unit", value: "100", expectError: true},
+		{name: "Negative", value: "-1s", expectError: true},
+		{name: "Garbage", value: "soon", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			budget, err := parseSearchBudget(tt.value)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("Expected an error for %q, got %s", tt.value, budget)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if budget != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, budget)
+			}
+		})
+	}
+}
+
+func TestService_SearchBudget(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	service.recordBook("Alma", "Book of Mormon")
+	service.recordBook("Ether", "Book of Mormon")
+	service.scriptures["Alma"] = []Scripture{
+		{Book: "Alma", Chapter: 32, Verse: 21, Text: "faith is not to have a perfect knowledge", Reference: "Alma 32:21"},
+	}
+	service.scriptures["Ether"] = []Scripture{
+		{Book: "Ether", Chapter: 12, Verse: 6, Text: "faith is things which are hoped for", Reference: "Ether 12:6"},
+	}
+
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: map[string]interface{}{"query": "faith"},
+		},
+	}
+	tests := []struct {
+		name    string
+		budget  time.Duration
+		search  func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		partial bool
+	}{
+		{name: "search_scriptures without a budget", budget: 0, search: service.SearchScriptures},
+		{name: "search_scriptures out of time", budget: time.Nanosecond, search: service.SearchScriptures, partial: true},
+		{name: "search_all without a budget", budget: 0, search: service.SearchAll},
+		{name: "search_all out of time", budget: time.Nanosecond, search: service.SearchAll, partial: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service.budget = tt.budget
+			result, err := tt.search(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.IsError {
+				t.Fatalf("Unexpected tool error: %v", result.Content)
+			}
+			text := result.Content[0].(mcp.TextContent).Text
+
+			partial := false
+			if result.Meta != nil {
+				partial, _ = result.Meta.AdditionalFields["partial"].(bool)
+			}
+			if partial != tt.partial {
+				t.Errorf("Expected partial %v, got %v", tt.partial, partial)
+			}
+			if strings.Contains(text, "Partial results:") != tt.partial {
+				t.Errorf("Expected the partial notice only when out of time, got %q", text)
+			}
+			if !tt.partial && (!strings.Contains(text, "Alma 32:21") || !strings.Contains(text, "Ether 12:6")) {
+				t.Errorf("Expected both verses without a budget, got %q", text)
+			}
+		})
+	}
+}
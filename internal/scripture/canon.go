@@ -0,0 +1,88 @@
+package scripture
+
+import "sort"
+
+// Canonical book order of the standard works. Listings and canonical search
+// results follow it rather than the order of the data files, so a data file
+// with its books out of order, or an edition that adds one, cannot reorder
+// them. Books a list does not name, such as those of a translation that
+// names them differently, follow the named ones in load order.
+var (
+	oldTestamentBooks = []string{
+		"Genesis", "Exodus", "Leviticus", "Numbers", "Deuteronomy", "Joshua", "Judges", "Ruth",
+		"1 Samuel", "2 Samuel", "1 Kings", "2 Kings", "1 Chronicles", "2 Chronicles", "Ezra", "Nehemiah", "Esther",
+		"Job", "Psalms", "Proverbs", "Ecclesiastes", "Solomon's Song",
+		"Isaiah", "Jeremiah", "Lamentations", "Ezekiel", "Daniel",
+		"Hosea", "Joel", "Amos", "Obadiah", "Jonah", "Micah", "Nahum", "Habakkuk", "Zephaniah", "Haggai", "Zechariah", "Malachi",
+	}
+	newTestamentBooks = []string{
+		"Matthew", "Mark", "Luke", "John", "Acts",
+		"Romans", "1 Corinthians", "2 Corinthians", "Galatians", "Ephesians", "Philippians", "Colossians",
+		"1 Thessalonians", "2 Thessalonians", "1 Timothy", "2 Timothy", "Titus", "Philemon", "Hebrews",
+		"James", "1 Peter", "2 Peter", "1 John", "2 John", "3 John", "Jude", "Revelation",
+	}
+	bookOfMormonBooks = []string{
+		"1 Nephi", "2 Nephi", "Jacob", "Enos", "Jarom", "Omni", "Words of Mormon", "Mosiah",
+		"Alma", "Helaman", "3 Nephi", "4 Nephi", "Mormon", "Ether", "Moroni",
+	}
+	doctrineAndCovenantsBooks = []string{"Doctrine and Covenants"}
+	pearlOfGreatPriceBooks    = []string{"Moses", "Abraham", "Joseph Smith—Matthew", "Joseph Smith—History", "Articles of Faith"}
+)
+
+// loadedBooks returns book names in load order, followed alphabetically by
+// any books that were added without going through the loader
+func (s *Service) loadedBooks() []string {
+	books := make([]string, 0, len(s.scriptures))
+	seen := make(map[string]bool, len(s.scriptures))
+	for _, book := range s.bookOrder {
+		if _, exists := s.scriptures[book]; exists && !seen[book] {
+			books = append(books, book)
+			seen[book] = true
+		}
+	}
+	var extra []string
+	for book := range s.scriptures {
+		if !seen[book] {
+			extra = append(extra, book)
+		}
+	}
+	sort.Strings(extra)
+	return append(books, extra...)
+}
+
+// orderedBooks returns book names in canonical order: collection by
+// collection as collections() lists them, each collection's books in the
+// order of its canonical book list and then in load order, followed
+// alphabetically by any books outside a known collection, such as those
+// added without going through the loader
+func (s *Service) orderedBooks() []string {
+	books := s.loadedBooks()
+
+	// Rank by collection, then by place in the collection's book list; the
+	// stable sort keeps load and alphabetical order among equal ranks
+	collections := s.collections()
+	type rank struct{ collection, book int }
+	ranks := make(map[string]rank, len(books))
+	for _, book := range books {
+		r := rank{collection: len(collections)}
+		for i, collection := range collections {
+			if collection.Name == s.bookCollections[book] {
+				r = rank{collection: i, book: len(collection.Books)}
+				for j, name := range collection.Books {
+					if name == book {
+						r.book = j
+					}
+				}
+			}
+		}
+		ranks[book] = r
+	}
+	sort.SliceStable(books, func(i, j int) bool {
+		a, b := ranks[books[i]], ranks[books[j]]
+		if a.collection != b.collection {
+			return a.collection < b.collection
+		}
+		return a.book < b.book
+	})
+	return books
+}
@@ -0,0 +1,35 @@
+package scripture
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestService_orderedBooks(t *testing.T) {
+	service := NewService()
+	books := service.orderedBooks()
+	if len(books) != len(service.loadedBooks()) {
+		t.Fatalf("Expected every book once, got %d of %d", len(books), len(service.loadedBooks()))
+	}
+	for i, want := range map[int]string{0: "Genesis", 38: "Malachi", 39: "Matthew", 66: "1 Nephi", 80: "Moroni", 81: "Doctrine and Covenants", 86: "Articles of Faith"} {
+		if books[i] != want {
+			t.Errorf("Expected %s at %d, got %s", want, i, books[i])
+		}
+	}
+
+	// Load order does not matter, and books of no canonical list follow
+	// those of their collection
+	shuffled := &Service{scriptures: map[string][]Scripture{}, bookCollections: map[string]string{}}
+	for _, book := range []string{"Moroni", "Jude", "1 Nephi", "Tobit", "Genesis", "Hezekiah"} {
+		collection := service.bookCollections[book]
+		if book == "Hezekiah" {
+			collection = "Book of Mormon"
+		}
+		shuffled.recordBook(book, collection)
+		shuffled.scriptures[book] = []Scripture{{Book: book, Chapter: 1, Verse: 1}}
+	}
+	expected := []string{"Genesis", "Jude", "1 Nephi", "Moroni", "Hezekiah", "Tobit"}
+	if got := shuffled.orderedBooks(); !slices.Equal(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,141 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Citation is the canonical citation of one passage a tool returned: a run
+// of consecutive verses of one chapter
+type Citation struct {
+	Reference  string `json:"reference"` // e.g. "John 3:16-17"
+	Collection string `json:"collection"`
+	Source     string `json:"source,omitempty"` // Where the text comes from, when known
+}
+
+// String renders the citation for the text output
+func (c Citation) String() string {
+	if c.Source != "" {
+		return fmt.Sprintf("%s (%s; %s)", c.Reference, c.Collection, c.Source)
+	}
+	return fmt.Sprintf("%s (%s)", c.Reference, c.Collection)
+}
+
+// RequireCitations wraps a tool handler that returns passages so that, when
+// SCRIPTURES_REQUIRE_CITATIONS is enabled, every passage carries its
+// citation: the text output ends with a "Cite as:" line per passage and the
+// citations are listed under _meta.citations. A passage whose reference is
+// missing or does not name the verse it is attached to is refused with an
+// error rather than returned unattributed. When the option is off, the
+// handler's results pass through unchanged.
+func (s *Service) RequireCitations(handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		result, err := handler(ctx, request)
+		if !s.requireCitations || err != nil || result == nil || result.IsError {
+			return result, err
+		}
+		verses := passageVerses(result.StructuredContent)
+		if len(verses) == 0 {
+			return result, nil
+		}
+
+		for _, verse := range verses {
+			if !s.citationMatches(verse) {
+				return mcp.NewToolResultError(fmt.Sprintf("refusing to return %s %d:%d without its reference: the verse is cited as '%s'",
+					verse.Book, verse.Chapter, verse.Verse, verse.Reference)), nil
+			}
+		}
+
+		citations := s.citations(verses)
+		footer := "\n"
+		for _, citation := range citations {
+			footer += fmt.Sprintf("Cite as: %s\n", citation)
+		}
+		// The citations follow the last block, as with get_book's one block per chapter
+		if last := len(result.Content) - 1; last >= 0 {
+			if text, ok := result.Content[last].(mcp.TextContent); ok {
+				text.Text = strings.TrimRight(text.Text, "\n") + "\n" + footer
+				result.Content[last] = text
+			}
+		}
+		if result.Meta == nil {
+			result.Meta = &mcp.Meta{}
+		}
+		if result.Meta.AdditionalFields == nil {
+			result.Meta.AdditionalFields = make(map[string]any)
+		}
+		result.Meta.AdditionalFields["citations"] = citations
+		return result, nil
+	}
+}
+
+// passageVerses returns the verses in a tool's structured result, in the
+// order returned
+func passageVerses(structured any) []Scripture {
+	var verses []Scripture
+	switch result := structured.(type) {
+	case SearchResult:
+		for _, hit := range result.Verses {
+			verses = append(verses, hit.Scripture)
+		}
+	case SearchAllResult:
+		for _, collection := range result.Collections {
+			verses = append(verses, collection.Verses...)
+		}
+	case PassageResult:
+		if len(result.Passages) == 0 {
+			verses = append(append(append(verses, result.Before...), result.Verses...), result.After...)
+		}
+		for _, passage := range result.Passages {
+			verses = append(append(append(verses, passage.Before...), passage.Verses...), passage.After...)
+		}
+	case ChapterResult:
+		verses = result.Verses
+	case VerseOfTheDayResult:
+		if result.VerseOfTheDay != nil {
+			verses = result.VerseOfTheDay.Verses
+		}
+	case BookResult:
+		for _, chapter := range result.Chapters {
+			verses = append(verses, chapter.Verses...)
+		}
+	}
+	return verses
+}
+
+// citationMatches reports whether a verse's reference names the verse itself
+func (s *Service) citationMatches(verse Scripture) bool {
+	if strings.TrimSpace(verse.Reference) == "" {
+		return false
+	}
+	ref, err := s.parseReference(verse.Reference)
+	return err == nil && ref.Book == verse.Book && ref.Chapter == verse.Chapter && ref.Verse == verse.Verse && ref.EndVerse == verse.Verse
+}
+
+// citations groups verses into passages of consecutive verses of one chapter
+// and cites each
+func (s *Service) citations(verses []Scripture) []Citation {
+	var citations []Citation
+	for start := 0; start < len(verses); {
+		end := start + 1
+		for end < len(verses) && verses[end].Book == verses[start].Book &&
+			verses[end].Chapter == verses[start].Chapter && verses[end].Verse == verses[end-1].Verse+1 {
+			end++
+		}
+
+		citation := Citation{Reference: verses[start].Reference, Collection: s.bookCollections[verses[start].Book]}
+		if end-start > 1 {
+			citation.Reference += fmt.Sprintf("-%d", verses[end-1].Verse)
+		}
+		if license := s.licenses[citation.Collection]; license != nil {
+			citation.Source = license.Source
+		}
+		citations = append(citations, citation)
+		start = end
+	}
+	return citations
+}
@@ -0,0 +1,93 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestService_Citations(t *testing.T) {
+	service := NewService()
+
+	tests := []struct {
+		name     string
+		verses   []Scripture
+		expected []string
+	}{
+		{name: "Single verse", verses: service.getChapter("John", 3)[15:16], expected: []string{"John 3:16"}},
+		{name: "Consecutive verses", verses: service.getChapter("John", 3)[15:17], expected: []string{"John 3:16-17"}},
+		{name: "Gap", verses: append(service.getChapter("John", 3)[15:17:17], service.getChapter("John", 3)[19]), expected: []string{"John 3:16-17", "John 3:20"}},
+		{name: "Across chapters", verses: append(service.getChapter("Moroni", 9)[25:26:26], service.getChapter("Moroni", 10)[0]), expected: []string{"Moroni 9:26", "Moroni 10:1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var references []string
+			for _, citation := range service.citations(tt.verses) {
+				if citation.Collection == "" {
+					t.Errorf("Expected a collection for %s", citation.Reference)
+				}
+				references = append(references, citation.Reference)
+			}
+			if strings.Join(references, "; ") != strings.Join(tt.expected, "; ") {
+				t.Errorf("Expected %v, got %v", tt.expected, references)
+			}
+		})
+	}
+
+	// Every loaded verse is cited as itself
+	for _, book := range service.bookOrder {
+		for _, verse := range service.scriptures[book] {
+			if !service.citationMatches(verse) {
+				t.Errorf("Verse %s %d:%d has reference %q", verse.Book, verse.Chapter, verse.Verse, verse.Reference)
+			}
+		}
+	}
+}
+
+func TestService_RequireCitations(t *testing.T) {
+	service := NewService()
+	passage := func(verses ...Scripture) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultStructured(PassageResult{Reference: "test", Verses: verses}, "passage text"), nil
+		}
+	}
+	john := service.getChapter("John", 3)[15:17]
+
+	// Results pass through unchanged when the option is off
+	result, _ := service.RequireCitations(passage(john...))(context.Background(), mcp.CallToolRequest{})
+	if result.Meta != nil || result.Content[0].(mcp.TextContent).Text != "passage text" {
+		t.Errorf("Expected the result unchanged, got %+v", result)
+	}
+
+	service.requireCitations = true
+	result, _ = service.RequireCitations(passage(john...))(context.Background(), mcp.CallToolRequest{})
+	if result.IsError {
+		t.Fatalf("Unexpected error result: %+v", result)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "Cite as: John 3:16-17 (New Testament") {
+		t.Errorf("Expected a citation footer, got %q", text)
+	}
+	if citations, ok := result.Meta.AdditionalFields["citations"].([]Citation); !ok || len(citations) != 1 || citations[0].Reference != "John 3:16-17" {
+		t.Errorf("Expected the citation in _meta, got %v", result.Meta.AdditionalFields)
+	}
+
+	// With a block per chapter, as from get_book, the footer follows the last
+	result, _ = service.RequireCitations(service.GetBook)(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"book": "Helaman", "start_chapter": float64(15)}}})
+	if first, last := result.Content[0].(mcp.TextContent).Text, result.Content[1].(mcp.TextContent).Text; strings.Contains(first, "Cite as:") || !strings.Contains(last, "Cite as: Helaman 16:1-25") {
+		t.Errorf("Expected the citation after the last chapter, got %q and %q", first, last)
+	}
+
+	unattributed := []Scripture{
+		{Book: "John", Chapter: 3, Verse: 16, Text: "For God so loved the world"},
+		{Book: "John", Chapter: 3, Verse: 16, Text: "For God so loved the world", Reference: "John 3:17"},
+	}
+	for _, verse := range unattributed {
+		result, _ = service.RequireCitations(passage(verse))(context.Background(), mcp.CallToolRequest{})
+		if !result.IsError {
+			t.Errorf("Expected %q to be refused", verse.Reference)
+		}
+	}
+}
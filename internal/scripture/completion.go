@@ -0,0 +1,92 @@
+package scripture
+
+import "strings"
+
+// ArgumentCompletion is an argument a client is typing, to suggest values for
+type ArgumentCompletion struct {
+	Argument string            // Argument name: book, collection or scope
+	Value    string            // What has been typed so far, e.g. "1 Ne"
+	Resource bool              // Completing a resource URI template, whose arguments are slugs
+	Resolved map[string]string // Arguments already chosen, e.g. the collection of a book
+}
+
+// CompleteArgument suggests values for a book, collection or scope
+// argument, in reading order. A book matches when its name, or one of its
+// aliases such as "D&C" or "1 Ne.", starts with what was typed, so "1 Ne"
+// suggests "1 Nephi" and "dc" suggests "Doctrine and Covenants". A
+// collection matches when its name or any word of it does, so "mor"
+// suggests "Book of Mormon". A scope is a collection or a book. When the
+// collection is already chosen, only its books are suggested. Arguments of
+// resource URI templates are completed as slugs, such as "1-nephi".
+func (s *Service) CompleteArgument(completion ArgumentCompletion) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var values []string
+	switch completion.Argument {
+	case "collection":
+		values = s.completeCollections(completion)
+	case "book":
+		values = s.completeBooks(completion)
+	case "scope":
+		values = append(s.completeCollections(completion), s.completeBooks(completion)...)
+	}
+	return values
+}
+
+// completeCollections returns the loaded collections whose name or a word
+// of it starts with the value
+func (s *Service) completeCollections(completion ArgumentCompletion) []string {
+	typed := strings.ToLower(strings.TrimSpace(completion.Value))
+	var values []string
+	for _, collection := range s.collections() {
+		if !s.hasCollection(collection.Name) {
+			continue
+		}
+		value, words := collection.Name, strings.Fields(collection.Name)
+		if completion.Resource {
+			value = s.collectionSlug(collection.Name)
+			words = strings.Split(value, "-")
+		}
+		matched := strings.HasPrefix(strings.ToLower(value), typed)
+		for _, word := range words {
+			matched = matched || strings.HasPrefix(strings.ToLower(word), typed)
+		}
+		if matched {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// completeBooks returns the loaded books whose name or an alias of it
+// starts with the value, within the chosen collection if any
+func (s *Service) completeBooks(completion ArgumentCompletion) []string {
+	typed := normalizeBookName(completion.Value)
+	if completion.Resource {
+		typed = normalizeBookName(strings.ReplaceAll(completion.Value, "-", " "))
+	}
+	aliased := make(map[string]bool)
+	for alias, book := range bookAliases {
+		if strings.HasPrefix(alias, typed) {
+			aliased[book] = true
+		}
+	}
+
+	collection := completion.Resolved["collection"]
+	var values []string
+	for _, book := range s.orderedBooks() {
+		if collection != "" && !strings.EqualFold(collection, s.bookCollections[book]) && collection != s.collectionSlug(s.bookCollections[book]) {
+			continue
+		}
+		if !aliased[book] && !strings.HasPrefix(normalizeBookName(book), typed) {
+			continue
+		}
+		if completion.Resource {
+			values = append(values, slugify(book))
+		} else {
+			values = append(values, book)
+		}
+	}
+	return values
+}
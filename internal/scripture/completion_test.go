@@ -0,0 +1,45 @@
+package scripture
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestService_CompleteArgument(t *testing.T) {
+	service := NewService()
+
+	tests := []struct {
+		name       string
+		completion ArgumentCompletion
+		expected   string // Values joined with "|", or the first values followed by "|..."
+	}{
+		{name: "Book prefix", completion: ArgumentCompletion{Argument: "book", Value: "1 Ne"}, expected: "1 Nephi"},
+		{name: "Book prefix without a space", completion: ArgumentCompletion{Argument: "book", Value: "1ne"}, expected: "1 Nephi"},
+		{name: "Alias", completion: ArgumentCompletion{Argument: "book", Value: "D&C"}, expected: "Doctrine and Covenants"},
+		{name: "Several books", completion: ArgumentCompletion{Argument: "book", Value: "Jo"}, expected: "Joshua|Job|Joel|Jonah|John|Joseph Smith—Matthew|Joseph Smith—History"},
+		{name: "Any book", completion: ArgumentCompletion{Argument: "book"}, expected: "Genesis|Exodus|Leviticus|..."},
+		{name: "Books of the chosen collection", completion: ArgumentCompletion{Argument: "book", Value: "m", Resolved: map[string]string{"collection": "Book of Mormon"}}, expected: "Mosiah|Mormon|Moroni"},
+		{name: "Book slug", completion: ArgumentCompletion{Argument: "book", Value: "joseph-smith-h", Resource: true}, expected: "joseph-smith-history"},
+		{name: "Book slugs of the chosen collection", completion: ArgumentCompletion{Argument: "book", Value: "1", Resource: true, Resolved: map[string]string{"collection": "book-of-mormon"}}, expected: "1-nephi"},
+		{name: "Collection word", completion: ArgumentCompletion{Argument: "collection", Value: "mor"}, expected: "Book of Mormon"},
+		{name: "Collection slug", completion: ArgumentCompletion{Argument: "collection", Value: "new", Resource: true}, expected: "new-testament"},
+		{name: "Scope", completion: ArgumentCompletion{Argument: "scope", Value: "Doc"}, expected: "Doctrine and Covenants|Doctrine and Covenants"},
+		{name: "No match", completion: ArgumentCompletion{Argument: "book", Value: "Hezekiah"}, expected: ""},
+		{name: "Other argument", completion: ArgumentCompletion{Argument: "query", Value: "faith"}, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := strings.Join(service.CompleteArgument(tt.completion), "|")
+			if prefix, ok := strings.CutSuffix(tt.expected, "|..."); ok {
+				if !strings.HasPrefix(values, prefix+"|") {
+					t.Errorf("Expected values starting %q, got %q", prefix, values)
+				}
+				return
+			}
+			if values != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, values)
+			}
+		})
+	}
+}
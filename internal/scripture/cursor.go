@@ -0,0 +1,50 @@
+package scripture
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A search cursor is an opaque token for the next page of search_scriptures
+// results. It records the offset of that page along with a fingerprint of the
+// search it came from, so a cursor passed with a different query, sort or
+// mode is rejected instead of silently paging through the wrong results.
+
+// cursorVersion prefixes every cursor so the format can change later
+const cursorVersion = "c1"
+
+// searchFingerprint identifies a search by everything that decides its results and their order
+func searchFingerprint(query, order, mode string, preferSimple bool) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{query, order, mode, strconv.FormatBool(preferSimple)}, "\x00")))
+	return hex.EncodeToString(sum[:6])
+}
+
+// encodeCursor returns the cursor for the page of a search starting at offset
+func encodeCursor(fingerprint string, offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s:%d", cursorVersion, fingerprint, offset)))
+}
+
+// decodeCursor returns the offset a cursor points at, checking that it was
+// issued for the search with the given fingerprint
+func decodeCursor(cursor, fingerprint string) (int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("malformed cursor")
+	}
+	parts := strings.Split(string(data), ":")
+	if len(parts) != 3 || parts[0] != cursorVersion {
+		return 0, fmt.Errorf("malformed cursor")
+	}
+	offset, err := strconv.Atoi(parts[2])
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("malformed cursor")
+	}
+	if parts[1] != fingerprint {
+		return 0, fmt.Errorf("cursor belongs to a different search; pass the same query, sort, mode and prefer_simple")
+	}
+	return offset, nil
+}
@@ -0,0 +1,125 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestDecodeCursor(t *testing.T) {
+	fingerprint := searchFingerprint("faith", sortCanonical, modePhrase, false)
+
+	tests := []struct {
+		name        string
+		cursor      string
+		expected    int
+		expectError string
+	}{
+		{name: "Round trip", cursor: encodeCursor(fingerprint, 20), expected: 20},
+		{name: "First page", cursor: encodeCursor(fingerprint, 0), expected: 0},
+		{name: "Other query", cursor: encodeCursor(searchFingerprint("hope", sortCanonical, modePhrase, false), 20), expectError: "different search"},
+		{name: "Other sort", cursor: encodeCursor(searchFingerprint("faith", sortRelevance, modePhrase, false), 20), expectError: "different search"},
+		{name: "Other mode", cursor: encodeCursor(searchFingerprint("faith", sortCanonical, modeAllWords, false), 20), expectError: "different search"},
+		{name: "Not base64", cursor: "not a cursor!", expectError: "malformed"},
+		{name: "Unknown version", cursor: "YzI6MDA6MjA", expectError: "malformed"},
+		{name: "Negative offset", cursor: encodeCursor(fingerprint, -1), expectError: "malformed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			offset, err := decodeCursor(tt.cursor, fingerprint)
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if offset != tt.expected {
+				t.Errorf("Expected offset %d, got %d", tt.expected, offset)
+			}
+		})
+	}
+}
+
+func TestService_SearchScriptures_Cursor(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	service.recordBook("Alma", "Book of Mormon")
+	for verse := 1; verse <= 5; verse++ {
+		service.scriptures["Alma"] = append(service.scriptures["Alma"], Scripture{
+			Book: "Alma", Chapter: 32, Verse: verse, Text: "the word of the Lord", Reference: fmt.Sprintf("Alma 32:%d", verse),
+		})
+	}
+
+	search := func(arguments map[string]interface{}) *mcp.CallToolResult {
+		t.Helper()
+		request := mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: arguments,
+			},
+		}
+		result, err := service.SearchScriptures(context.Background(), request)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	// Follow the cursors from the first page to the last
+	var references []string
+	arguments := map[string]interface{}{"query": "lord", "limit": float64(2)}
+	for pages := 1; ; pages++ {
+		result := search(arguments)
+		if result.IsError {
+			t.Fatalf("Unexpected tool error on page %d: %v", pages, result.Content)
+		}
+		for _, line := range strings.Split(result.Content[0].(mcp.TextContent).Text, "\n") {
+			if _, rest, ok := strings.Cut(line, ". Alma "); ok {
+				references = append(references, "Alma "+strings.Fields(rest)[0])
+			}
+		}
+
+		var truncation *Truncation
+		if result.Meta != nil {
+			truncation, _ = result.Meta.AdditionalFields["truncated"].(*Truncation)
+		}
+		if truncation == nil {
+			if pages != 3 {
+				t.Errorf("Expected 3 pages, got %d", pages)
+			}
+			break
+		}
+		if !strings.Contains(result.Content[0].(mcp.TextContent).Text, "Next page cursor: "+truncation.NextCursor) {
+			t.Errorf("Expected the cursor in the text output on page %d", pages)
+		}
+		arguments = map[string]interface{}{"query": "lord", "limit": float64(2), "cursor": truncation.NextCursor}
+	}
+	if strings.Join(references, ", ") != "Alma 32:1, Alma 32:2, Alma 32:3, Alma 32:4, Alma 32:5" {
+		t.Errorf("Expected every verse once in order, got %v", references)
+	}
+
+	cursor := encodeCursor(searchFingerprint("lord", sortCanonical, modePhrase, false), 2)
+	errors := []struct {
+		name      string
+		arguments map[string]interface{}
+	}{
+		{name: "Different query", arguments: map[string]interface{}{"query": "word", "cursor": cursor}},
+		{name: "Different mode", arguments: map[string]interface{}{"query": "lord", "mode": "any_word", "cursor": cursor}},
+		{name: "With offset", arguments: map[string]interface{}{"query": "lord", "offset": float64(2), "cursor": cursor}},
+		{name: "Malformed", arguments: map[string]interface{}{"query": "lord", "cursor": "garbage"}},
+	}
+	for _, tt := range errors {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := search(tt.arguments); !result.IsError {
+				t.Errorf("Expected an error, got %v", result.Content)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+//go:build !wasm
+
+package scripture
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// executableDataDir returns the data directory next to the executable, the
+// legacy data layout
+func executableDataDir() (string, bool) {
+	exePath, err := os.Executable()
+	if err != nil || exePath == "" {
+		return "", false
+	}
+	return filepath.Join(filepath.Dir(exePath), "data"), true
+}
@@ -0,0 +1,7 @@
+package scripture
+
+// executableDataDir reports no data directory under WebAssembly, where there
+// is no executable path; data is embedded or passed to NewServiceFromArchive
+func executableDataDir() (string, bool) {
+	return "", false
+}
@@ -0,0 +1,58 @@
+package scripture
+
+import (
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Optional datasets a tool can need, by the name reported when one is
+// missing. Tools that only read more from a dataset when it is there, such
+// as get_cross_references with the footnotes, need none.
+const (
+	DatasetBibleDictionary  = "bible_dictionary"
+	DatasetStories          = "scripture_stories"
+	DatasetTranslations     = "bible_translations"
+	DatasetLanguageEditions = "language_editions"
+)
+
+// datasetHints tells how to add each optional dataset
+var datasetHints = map[string]string{
+	DatasetBibleDictionary:  fmt.Sprintf("place %s in SCRIPTURES_DATA_DIR or the state data directory", dictionaryFile),
+	DatasetStories:          fmt.Sprintf("place %s in SCRIPTURES_DATA_DIR or the state data directory", storiesFile),
+	DatasetTranslations:     fmt.Sprintf("place a translation's data files in a folder such as '%s/web' of SCRIPTURES_DATA_DIR or the state data directory", translationsDir),
+	DatasetLanguageEditions: "place another edition's data files in a folder named for its language code, such as 'es', of SCRIPTURES_DATA_DIR or the state data directory",
+}
+
+// HasDataset reports whether an optional dataset is loaded, so tools that
+// need it can be left out of the tool list when it is not
+func (s *Service) HasDataset(dataset string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.hasDataset(dataset)
+}
+
+// hasDataset is HasDataset for callers already holding the lock
+func (s *Service) hasDataset(dataset string) bool {
+	switch dataset {
+	case DatasetBibleDictionary:
+		return len(s.dictionary) > 0
+	case DatasetStories:
+		return len(s.stories) > 0
+	case DatasetTranslations:
+		return len(s.translations) > 0
+	case DatasetLanguageEditions:
+		return len(s.editions) > 0
+	}
+	return false
+}
+
+// datasetUnavailable is the result of a tool called without the optional
+// dataset it needs: an error a client can tell apart from a bad argument by
+// its structured content
+func datasetUnavailable(dataset string) *mcp.CallToolResult {
+	structured := CapabilityUnavailableResult{Dataset: dataset, Hint: datasetHints[dataset]}
+	result := mcp.NewToolResultStructured(structured, fmt.Sprintf("capability unavailable: dataset %s not loaded; %s", dataset, structured.Hint))
+	result.IsError = true
+	return result
+}
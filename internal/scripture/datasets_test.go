@@ -0,0 +1,39 @@
+package scripture
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func TestService_HasDataset(t *testing.T) {
+	service := &Service{scriptures: map[string][]Scripture{}}
+	tools := map[string]server.ToolHandlerFunc{
+		DatasetBibleDictionary:  service.BibleDictionary,
+		DatasetStories:          service.GetScriptureStory,
+		DatasetTranslations:     service.CompareTranslations,
+		DatasetLanguageEditions: service.ParallelText,
+	}
+	for dataset, handler := range tools {
+		if service.HasDataset(dataset) {
+			t.Errorf("Expected %s missing from an empty service", dataset)
+		}
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]any{"entry": "Aaron", "query": "John 3:16"}
+		result, err := handler(context.Background(), request)
+		if err != nil || !result.IsError {
+			t.Fatalf("Expected an error result for %s, got %+v %v", dataset, result, err)
+		}
+		unavailable, ok := result.StructuredContent.(CapabilityUnavailableResult)
+		if !ok || unavailable.Dataset != dataset || unavailable.Hint == "" {
+			t.Errorf("Expected %s reported unavailable, got %+v", dataset, result.StructuredContent)
+		}
+	}
+
+	service.dictionary = []DictionaryEntry{{Name: "Aaron"}}
+	if !service.HasDataset(DatasetBibleDictionary) || service.HasDataset("conference_talks") {
+		t.Error("Expected only the loaded dataset reported")
+	}
+}
@@ -0,0 +1,89 @@
+package scripture
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// decodeScriptureData streams a scripture JSON document, handing each book and
+// section to its callback as soon as it is decoded rather than unmarshaling
+// the whole file at once. Unknown top-level fields are skipped.
+func decodeScriptureData(r io.Reader, onBook func(Book), onSection func(Section)) error {
+	decoder := json.NewDecoder(r)
+	if err := expectDelim(decoder, '{'); err != nil {
+		return err
+	}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := token.(string)
+		if !ok {
+			return fmt.Errorf("unexpected token %v", token)
+		}
+
+		switch key {
+		case "books":
+			err = decodeArray(decoder, func() error {
+				var book Book
+				if err := decoder.Decode(&book); err != nil {
+					return err
+				}
+				onBook(book)
+				return nil
+			})
+		case "sections":
+			err = decodeArray(decoder, func() error {
+				var section Section
+				if err := decoder.Decode(&section); err != nil {
+					return err
+				}
+				onSection(section)
+				return nil
+			})
+		default:
+			var skipped json.RawMessage
+			err = decoder.Decode(&skipped)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+
+	return expectDelim(decoder, '}')
+}
+
+// decodeArray walks a JSON array, calling element once per item. A null array is empty.
+func decodeArray(decoder *json.Decoder, element func() error) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		return nil
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected array, got %v", token)
+	}
+	for decoder.More() {
+		if err := element(); err != nil {
+			return err
+		}
+	}
+	return expectDelim(decoder, ']')
+}
+
+// expectDelim consumes the next token and checks that it is the given delimiter
+func expectDelim(decoder *json.Decoder, want json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != want {
+		return fmt.Errorf("expected %v, got %v", want, token)
+	}
+	return nil
+}
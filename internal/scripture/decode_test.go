@@ -0,0 +1,95 @@
+package scripture
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDecodeScriptureData(t *testing.T) {
+	tests := []struct {
+		name             string
+		input            string
+		expectedBooks    []string
+		expectedSections int
+		expectError      bool
+	}{
+		{
+			name:          "Books with unknown fields",
+			input:         `{"title": "The Book of Mormon", "books": [{"book": "1 Nephi", "chapters": [{"chapter": 3, "verses": [{"verse": 7, "text": "I will go and do", "reference": "1 Nephi 3:7"}]}]}, {"book": "Enos", "chapters": []}], "version": 2}`,
+			expectedBooks: []string{"1 Nephi", "Enos"},
+		},
+		{
+			name:             "Sections",
+			input:            `{"sections": [{"section": 1, "verses": []}, {"section": 4, "verses": []}]}`,
+			expectedSections: 2,
+		},
+		{
+			name:  "Null books",
+			input: `{"books": null}`,
+		},
+		{
+			name:          "Truncated after first book",
+			input:         `{"books": [{"book": "1 Nephi", "chapters": []}, {"book": "2 Ne`,
+			expectedBooks: []string{"1 Nephi"},
+			expectError:   true,
+		},
+		{
+			name:        "Not an object",
+			input:       `[]`,
+			expectError: true,
+		},
+		{
+			name:        "Books not an array",
+			input:       `{"books": {}}`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var books []string
+			sections := 0
+			err := decodeScriptureData(strings.NewReader(tt.input),
+				func(book Book) { books = append(books, book.Book) },
+				func(section Section) { sections++ },
+			)
+
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if strings.Join(books, ",") != strings.Join(tt.expectedBooks, ",") {
+				t.Errorf("Expected books %v, got %v", tt.expectedBooks, books)
+			}
+			if sections != tt.expectedSections {
+				t.Errorf("Expected %d sections, got %d", tt.expectedSections, sections)
+			}
+		})
+	}
+}
+
+func TestService_parseAndStore_Progress(t *testing.T) {
+	var loaded []string
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+		progress: func(collection, book string, verses int) {
+			loaded = append(loaded, collection+"/"+book)
+			if verses != 1 {
+				t.Errorf("Expected 1 verse for %s, got %d", book, verses)
+			}
+		},
+	}
+
+	input := `{"books": [
+		{"book": "1 Nephi", "chapters": [{"chapter": 3, "verses": [{"verse": 7, "text": "I will go and do", "reference": "1 Nephi 3:7"}]}]},
+		{"book": "Enos", "chapters": [{"chapter": 1, "verses": [{"verse": 1, "text": "Behold", "reference": "Enos 1:1"}]}]}
+	]}`
+	service.parseAndStore(strings.NewReader(input), "book-of-mormon.json")
+
+	expected := "Book of Mormon/1 Nephi,Book of Mormon/Enos"
+	if got := strings.Join(loaded, ","); got != expected {
+		t.Errorf("Expected progress %q, got %q", expected, got)
+	}
+}
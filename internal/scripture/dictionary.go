@@ -0,0 +1,228 @@
+package scripture
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// dictionaryFile is the optional Bible Dictionary dataset, read from a data
+// directory. The dictionary is not part of the scripture data and is not
+// redistributable, so none is embedded.
+const dictionaryFile = "bible-dictionary.json"
+
+// maxDictionarySuggestions caps the other entry names offered for a query
+const maxDictionarySuggestions = 5
+
+// Kinds of dictionary match
+const (
+	dictionaryExact = "exact"
+	dictionaryFuzzy = "fuzzy"
+)
+
+// DictionaryEntry is an entry of the Bible Dictionary
+type DictionaryEntry struct {
+	Name       string   `json:"name"`                 // Entry name, e.g. "Ephesus"
+	Text       string   `json:"text"`                 // Entry text
+	References []string `json:"references,omitempty"` // Passages the entry cites, besides those in its text
+}
+
+// dictionaryData is the structure of the Bible Dictionary dataset file
+type dictionaryData struct {
+	Entries []DictionaryEntry `json:"entries"`
+}
+
+// DictionaryReference is a passage cited by a dictionary entry
+type DictionaryReference struct {
+	Reference string `json:"reference"`       // As cited in the entry
+	Query     string `json:"query,omitempty"` // Reference to pass to get_scripture; empty if the passage is not loaded
+}
+
+// loadDictionary reads the optional Bible Dictionary dataset from
+// SCRIPTURES_DATA_DIR or the state data directory, if present
+func (s *Service) loadDictionary() {
+	data, _, err := readDataFile(dictionaryFile)
+	if err != nil {
+		return
+	}
+	entries, err := parseDictionary(data)
+	if err != nil {
+		slog.Warn("Could not parse Bible Dictionary dataset", "file", dictionaryFile, "error", err)
+		return
+	}
+	s.dictionary = entries
+}
+
+// parseDictionary decodes a Bible Dictionary dataset, keeping only entries
+// with a name and text
+func parseDictionary(data []byte) ([]DictionaryEntry, error) {
+	var parsed dictionaryData
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return nil, err
+	}
+
+	var entries []DictionaryEntry
+	for _, entry := range parsed.Entries {
+		entry.Name = strings.TrimSpace(entry.Name)
+		if dictionaryKey(entry.Name) == "" || strings.TrimSpace(entry.Text) == "" {
+			slog.Warn("Skipping dictionary entry: needs a name and text", "name", entry.Name, "file", dictionaryFile)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// BibleDictionary looks up a Bible Dictionary entry by name, tolerating
+// misspellings, and links the passages it cites to get_scripture
+func (s *Service) BibleDictionary(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	query, ok := arguments["entry"].(string)
+	if !ok || dictionaryKey(query) == "" {
+		return mcp.NewToolResultError("dictionary entry cannot be empty"), nil
+	}
+
+	if !s.hasDataset(DatasetBibleDictionary) {
+		return datasetUnavailable(DatasetBibleDictionary), nil
+	}
+
+	matches := s.dictionaryMatches(query)
+	structured := DictionaryResult{Query: query}
+	if len(matches) == 0 {
+		return mcp.NewToolResultStructured(structured, fmt.Sprintf("No Bible Dictionary entry found for '%s'.", query)), nil
+	}
+
+	entry := matches[0]
+	structured.Entry = &entry
+	structured.Match = dictionaryFuzzy
+	if dictionaryKey(entry.Name) == dictionaryKey(query) {
+		structured.Match = dictionaryExact
+	}
+	structured.References = s.dictionaryReferences(entry)
+	for _, other := range matches[1:min(len(matches), maxDictionarySuggestions+1)] {
+		structured.Suggestions = append(structured.Suggestions, other.Name)
+	}
+
+	response := fmt.Sprintf("Bible Dictionary: %s\n\n", entry.Name)
+	if structured.Match == dictionaryFuzzy {
+		response = fmt.Sprintf("Bible Dictionary: %s (closest entry to '%s')\n\n", entry.Name, query)
+	}
+	response += strings.TrimSpace(entry.Text) + "\n"
+	if len(structured.References) > 0 {
+		response += "\nReferences (use get_scripture to read them):\n"
+		for _, reference := range structured.References {
+			switch {
+			case reference.Query == "":
+				response += fmt.Sprintf("- %s (not in the loaded scriptures)\n", reference.Reference)
+			case reference.Query != reference.Reference:
+				response += fmt.Sprintf("- %s → %s\n", reference.Reference, reference.Query)
+			default:
+				response += fmt.Sprintf("- %s\n", reference.Reference)
+			}
+		}
+	}
+	if len(structured.Suggestions) > 0 {
+		response += fmt.Sprintf("\nSee also: %s\n", strings.Join(structured.Suggestions, ", "))
+	}
+	return mcp.NewToolResultStructured(structured, response), nil
+}
+
+// dictionaryMatches returns the entries matching a name, best first: the
+// entry of the same name, then entries within a few typos of it or starting
+// with it, closest first
+func (s *Service) dictionaryMatches(query string) []DictionaryEntry {
+	key := dictionaryKey(query)
+	tolerance := max(1, len([]rune(key))/4)
+
+	type candidate struct {
+		entry    DictionaryEntry
+		distance int
+	}
+	var candidates []candidate
+	for _, entry := range s.dictionary {
+		name := dictionaryKey(entry.Name)
+		distance := editDistance(name, key)
+		if distance <= tolerance || (len(key) >= 3 && strings.HasPrefix(name, key)) {
+			candidates = append(candidates, candidate{entry: entry, distance: distance})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+
+	matches := make([]DictionaryEntry, 0, len(candidates))
+	for _, candidate := range candidates {
+		matches = append(matches, candidate.entry)
+	}
+	return matches
+}
+
+// dictionaryReferences returns the passages an entry cites: its listed
+// references followed by those in its text, without repeats
+func (s *Service) dictionaryReferences(entry DictionaryEntry) []DictionaryReference {
+	var references []DictionaryReference
+	seen := make(map[string]bool)
+	add := func(cited string, ref *ScriptureReference) {
+		if seen[cited] {
+			return
+		}
+		seen[cited] = true
+		reference := DictionaryReference{Reference: cited}
+		if ref != nil {
+			if verses := s.getScripturesByReference(ref); len(verses) > 0 {
+				reference.Query = spanReference(verses, verseSpan{Start: 0, End: len(verses) - 1})
+			}
+		}
+		references = append(references, reference)
+	}
+
+	for _, listed := range entry.References {
+		listed = strings.TrimSpace(listed)
+		ref, err := s.parseSelector(listed)
+		if err != nil || ref.Chapter == 0 {
+			ref = nil // Whole books are not linked
+		}
+		add(listed, ref)
+	}
+	for _, cited := range s.citedReferences(entry.Text) {
+		add(cited.text, cited.ref)
+	}
+	return references
+}
+
+// dictionaryKey normalizes an entry name for matching: lowercase letters and
+// digits, with single spaces between words
+func dictionaryKey(name string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+// editDistance returns the Levenshtein distance between two strings in runes
+func editDistance(a, b string) int {
+	from, to := []rune(a), []rune(b)
+	previous := make([]int, len(to)+1)
+	current := make([]int, len(to)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := range from {
+		current[0] = i + 1
+		for j := range to {
+			substitution := previous[j]
+			if from[i] != to[j] {
+				substitution++
+			}
+			current[j+1] = min(substitution, previous[j+1]+1, current[j]+1)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(to)]
+}
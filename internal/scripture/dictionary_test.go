@@ -0,0 +1,125 @@
+package scripture
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const testDictionary = `{"entries": [
+	{"name": "Ephesus", "text": "The capital of the Roman province of Asia. Paul visited it (Acts 18:19-21).", "references": ["Eph. 1:1", "Rev. 2:1-7", "Hezekiah 1:1"]},
+	{"name": "Ephesians, Epistle to the", "text": "Written by Paul while a prisoner at Rome."},
+	{"name": "Faith", "text": "To have faith is to have confidence in something or someone. See Heb. 11 and Alma 32:21.", "references": ["Alma 32:21"]},
+	{"name": "Broken Entry"},
+	{"name": " ", "text": "No name."}
+]}`
+
+func TestService_loadDictionary(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, dictionaryFile), []byte(testDictionary), 0644); err != nil {
+		t.Fatalf("Failed to write dictionary: %v", err)
+	}
+	t.Setenv("SCRIPTURES_DATA_DIR", dir)
+
+	service := &Service{scriptures: make(map[string][]Scripture)}
+	service.loadDictionary()
+
+	// Entries without a name or text are skipped
+	if len(service.dictionary) != 3 {
+		t.Errorf("Expected 3 valid entries, got %d", len(service.dictionary))
+	}
+}
+
+func TestService_BibleDictionary(t *testing.T) {
+	service := NewService()
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]any{"entry": "Faith"}
+	if result, _ := service.BibleDictionary(context.Background(), request); !result.IsError {
+		t.Errorf("Expected an error without a dataset, got %+v", result)
+	}
+
+	entries, err := parseDictionary([]byte(testDictionary))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	service.dictionary = entries
+
+	tests := []struct {
+		entry       string
+		name        string
+		match       string
+		references  []string // Cited reference and the get_scripture query for it
+		suggestions []string
+		isError     bool
+	}{
+		{entry: "Ephesus", name: "Ephesus", match: dictionaryExact, references: []string{"Eph. 1:1=Ephesians 1:1", "Rev. 2:1-7=Revelation 2:1-7", "Hezekiah 1:1=", "Acts 18:19-21=Acts 18:19-21"}},
+		{entry: "ephesis", name: "Ephesus", match: dictionaryFuzzy},
+		{entry: "Ephes", name: "Ephesus", match: dictionaryFuzzy, suggestions: []string{"Ephesians, Epistle to the"}},
+		{entry: "FAITH", name: "Faith", match: dictionaryExact, references: []string{"Alma 32:21=Alma 32:21", "Heb. 11=Hebrews 11:1-40"}},
+		{entry: "Charity"},
+		{entry: " ", isError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.entry, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]any{"entry": tt.entry}
+			result, err := service.BibleDictionary(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.IsError != tt.isError {
+				t.Fatalf("Expected IsError %v, got %+v", tt.isError, result)
+			}
+			if tt.isError {
+				return
+			}
+
+			structured := result.StructuredContent.(DictionaryResult)
+			if tt.name == "" {
+				if structured.Entry != nil || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "No Bible Dictionary entry found") {
+					t.Errorf("Expected no entry, got %+v", structured)
+				}
+				return
+			}
+			if structured.Entry == nil || structured.Entry.Name != tt.name || structured.Match != tt.match {
+				t.Fatalf("Expected %s match %s, got %+v", tt.match, tt.name, structured)
+			}
+			if tt.references != nil {
+				var got []string
+				for _, reference := range structured.References {
+					got = append(got, reference.Reference+"="+reference.Query)
+				}
+				if strings.Join(got, "|") != strings.Join(tt.references, "|") {
+					t.Errorf("Expected references %q, got %q", tt.references, got)
+				}
+			}
+			if strings.Join(structured.Suggestions, "|") != strings.Join(tt.suggestions, "|") {
+				t.Errorf("Expected suggestions %q, got %q", tt.suggestions, structured.Suggestions)
+			}
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "ephesus", b: "ephesus", expected: 0},
+		{a: "ephesus", b: "ephesis", expected: 1},
+		{a: "faith", b: "fath", expected: 1},
+		{a: "", b: "abc", expected: 3},
+		{a: "kitten", b: "sitting", expected: 3},
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.expected {
+			t.Errorf("editDistance(%q, %q) = %d, expected %d", tt.a, tt.b, got, tt.expected)
+		}
+	}
+}
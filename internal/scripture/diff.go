@@ -0,0 +1,111 @@
+package scripture
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Word diff operations
+const (
+	diffEqual   = "="
+	diffRemoved = "-"
+	diffAdded   = "+"
+)
+
+// DiffSegment is a run of words that are equal, removed or added between two texts
+type DiffSegment struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+// wordDiff computes a word-level diff from one text to another using the
+// longest common subsequence of words. Words are compared ignoring case and
+// surrounding punctuation; the output keeps the original spelling.
+func wordDiff(from, to string) []DiffSegment {
+	a := strings.Fields(from)
+	b := strings.Fields(to)
+
+	// lcs[i][j] is the LCS length of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if diffKey(a[i]) == diffKey(b[j]) {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var segments []DiffSegment
+	appendWord := func(op, word string) {
+		if n := len(segments); n > 0 && segments[n-1].Op == op {
+			segments[n-1].Text += " " + word
+			return
+		}
+		segments = append(segments, DiffSegment{Op: op, Text: word})
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case diffKey(a[i]) == diffKey(b[j]):
+			appendWord(diffEqual, b[j])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			appendWord(diffRemoved, a[i])
+			i++
+		default:
+			appendWord(diffAdded, b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		appendWord(diffRemoved, a[i])
+	}
+	for ; j < len(b); j++ {
+		appendWord(diffAdded, b[j])
+	}
+
+	return segments
+}
+
+// diffKey normalizes a word for comparison
+func diffKey(word string) string {
+	return strings.ToLower(strings.TrimFunc(word, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}))
+}
+
+// renderDiff formats diff segments inline, marking removals as [-words-] and
+// additions as {+words+}
+func renderDiff(segments []DiffSegment) string {
+	parts := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		switch segment.Op {
+		case diffRemoved:
+			parts = append(parts, "[-"+segment.Text+"-]")
+		case diffAdded:
+			parts = append(parts, "{+"+segment.Text+"+}")
+		default:
+			parts = append(parts, segment.Text)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// diffChanged reports whether a diff contains any removals or additions
+func diffChanged(segments []DiffSegment) bool {
+	for _, segment := range segments {
+		if segment.Op != diffEqual {
+			return true
+		}
+	}
+	return false
+}
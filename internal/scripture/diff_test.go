@@ -0,0 +1,78 @@
+package scripture
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWordDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		expected []DiffSegment
+	}{
+		{
+			name:     "Identical ignoring punctuation",
+			from:     "Awake, awake; put on thy strength",
+			to:       "Awake, awake, put on thy strength",
+			expected: []DiffSegment{{Op: diffEqual, Text: "Awake, awake, put on thy strength"}},
+		},
+		{
+			name: "Insertion",
+			from: "And upon all the ships of Tarshish",
+			to:   "And upon all the ships of the sea, and upon all the ships of Tarshish",
+			expected: []DiffSegment{
+				{Op: diffEqual, Text: "And upon all the ships of"},
+				{Op: diffAdded, Text: "the sea, and upon all the ships of"},
+				{Op: diffEqual, Text: "Tarshish"},
+			},
+		},
+		{
+			name: "Removal and replacement",
+			from: "arise, and sit down",
+			to:   "arise, sit up",
+			expected: []DiffSegment{
+				{Op: diffEqual, Text: "arise,"},
+				{Op: diffRemoved, Text: "and"},
+				{Op: diffEqual, Text: "sit"},
+				{Op: diffRemoved, Text: "down"},
+				{Op: diffAdded, Text: "up"},
+			},
+		},
+		{
+			name:     "Empty source",
+			from:     "",
+			to:       "new words",
+			expected: []DiffSegment{{Op: diffAdded, Text: "new words"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := wordDiff(tt.from, tt.to)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRenderDiff(t *testing.T) {
+	segments := []DiffSegment{
+		{Op: diffEqual, Text: "arise,"},
+		{Op: diffRemoved, Text: "and"},
+		{Op: diffEqual, Text: "sit"},
+		{Op: diffAdded, Text: "up"},
+	}
+	expected := "arise, [-and-] sit {+up+}"
+	if got := renderDiff(segments); got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+	if !diffChanged(segments) {
+		t.Error("Expected diff to report changes")
+	}
+	if diffChanged(segments[:1]) {
+		t.Error("Expected equal-only diff to report no changes")
+	}
+}
@@ -0,0 +1,244 @@
+package scripture
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// scheduleFile is the optional study schedule dataset, such as the year's
+// Come, Follow Me reading, read from a data directory. Curricula change
+// yearly and are not redistributable, so none is embedded.
+const scheduleFile = "study-schedule.json"
+
+// digestDateLayout is the date format of daily_digest and the schedule
+const digestDateLayout = "2006-01-02"
+
+// StudyWeek is an entry of the study schedule: the passages to read between
+// two dates, inclusive
+type StudyWeek struct {
+	Start    string   `json:"start"` // e.g. "2026-01-05"
+	End      string   `json:"end"`
+	Title    string   `json:"title,omitempty"`
+	Passages []string `json:"passages"` // Books, chapters or verse ranges, e.g. "Moses 1"
+}
+
+// scheduleData is the structure of the study schedule dataset file
+type scheduleData struct {
+	Weeks []StudyWeek `json:"weeks"`
+}
+
+// VerseOfTheDay is the passage chosen for a date from the curated themes,
+// or from the passages suggested for a topic
+type VerseOfTheDay struct {
+	Theme string `json:"theme"` // The theme, or the topic it was suggested for
+	ThemePassage
+}
+
+// loadSchedule reads the optional study schedule from SCRIPTURES_DATA_DIR or
+// the state data directory, if present
+func (s *Service) loadSchedule() {
+	data, _, err := readDataFile(scheduleFile)
+	if err != nil {
+		return
+	}
+	schedule, err := s.parseSchedule(data)
+	if err != nil {
+		slog.Warn("Could not parse study schedule", "file", scheduleFile, "error", err)
+		return
+	}
+	s.schedule = schedule
+}
+
+// parseSchedule decodes a study schedule, keeping only weeks with valid
+// dates and passages
+func (s *Service) parseSchedule(data []byte) ([]StudyWeek, error) {
+	var parsed scheduleData
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return nil, err
+	}
+
+	var schedule []StudyWeek
+	for _, week := range parsed.Weeks {
+		start, startErr := time.Parse(digestDateLayout, week.Start)
+		end, endErr := time.Parse(digestDateLayout, week.End)
+		valid := startErr == nil && endErr == nil && !end.Before(start) && len(week.Passages) > 0
+		for _, passage := range week.Passages {
+			if _, err := s.parseSelector(passage); err != nil {
+				valid = false
+			}
+		}
+		if !valid {
+			slog.Warn("Skipping study week: needs start and end dates and valid passages", "start", week.Start, "title", week.Title, "file", scheduleFile)
+			continue
+		}
+		schedule = append(schedule, week)
+	}
+	return schedule, nil
+}
+
+// DailyDigest composes the study bundle for a date: a verse of the day and
+// the scheduled reading for that date's week
+func (s *Service) DailyDigest(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	date := time.Now()
+	if dateText, _ := arguments["date"].(string); dateText != "" {
+		parsed, err := time.Parse(digestDateLayout, dateText)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid date '%s': use YYYY-MM-DD", dateText)), nil
+		}
+		date = parsed
+	}
+	day := date.Format(digestDateLayout)
+
+	structured := DigestResult{Date: day, VerseOfTheDay: s.verseOfTheDay(date, s.verseOfTheDayPool(ctx, "", nil)), Study: s.studyWeek(day)}
+
+	response := fmt.Sprintf("Daily Study Digest for %s\n\n", date.Format("Monday, January 2, 2006"))
+	if verse := structured.VerseOfTheDay; verse != nil {
+		response += fmt.Sprintf("Verse of the Day (%s): %s\n", verse.Theme, verse.Reference)
+		for _, v := range verse.Verses {
+			response += fmt.Sprintf("%s %d:%d - %s\n", v.Book, v.Chapter, v.Verse, v.Text)
+		}
+		response += "\n"
+	}
+	switch week := structured.Study; {
+	case week != nil:
+		title := week.Title
+		if title == "" {
+			title = strings.Join(week.Passages, "; ")
+		}
+		response += fmt.Sprintf("This Week's Study (%s to %s): %s\n", week.Start, week.End, title)
+		for _, passage := range week.Passages {
+			response += "- " + passage + "\n"
+		}
+	case len(s.schedule) == 0:
+		response += fmt.Sprintf("No study schedule loaded; place %s in SCRIPTURES_DATA_DIR or the state data directory to include the week's reading.\n", scheduleFile)
+	default:
+		response += "The study schedule has no week covering this date.\n"
+	}
+	return mcp.NewToolResultStructured(structured, response), nil
+}
+
+// GetVerseOfTheDay returns the passage for a date, the same for every call
+// with the same date and filters, so clients can build daily devotionals on
+// it. Without filters it is the verse of the day of daily_digest.
+func (s *Service) GetVerseOfTheDay(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	date := time.Now()
+	if dateText, _ := arguments["date"].(string); dateText != "" {
+		parsed, err := time.Parse(digestDateLayout, dateText)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid date '%s': use YYYY-MM-DD", dateText)), nil
+		}
+		date = parsed
+	}
+
+	collection := ""
+	if value, _ := arguments["collection"].(string); strings.TrimSpace(value) != "" {
+		var loaded []string
+		for _, c := range s.collections() {
+			if s.hasCollection(c.Name) {
+				loaded = append(loaded, c.Name)
+				if strings.EqualFold(c.Name, strings.TrimSpace(value)) {
+					collection = c.Name
+				}
+			}
+		}
+		if collection == "" {
+			return mcp.NewToolResultError(fmt.Sprintf("unknown collection '%s'; loaded collections: %s", value, strings.Join(loaded, ", "))), nil
+		}
+	}
+
+	var topics []string
+	if list, _ := arguments["topics"].(string); strings.TrimSpace(list) != "" {
+		for _, topic := range strings.Split(list, ",") {
+			if topic = strings.TrimSpace(topic); topic != "" {
+				topics = append(topics, topic)
+			}
+		}
+	}
+
+	structured := VerseOfTheDayResult{
+		Date:          date.Format(digestDateLayout),
+		Collection:    collection,
+		Topics:        topics,
+		VerseOfTheDay: s.verseOfTheDay(date, s.verseOfTheDayPool(ctx, collection, topics)),
+	}
+	verse := structured.VerseOfTheDay
+	if verse == nil {
+		return mcp.NewToolResultStructured(structured, fmt.Sprintf("No passage found for %s with these filters.", structured.Date)), nil
+	}
+	response := fmt.Sprintf("Verse of the Day for %s (%s): %s\n\n", date.Format("Monday, January 2, 2006"), verse.Theme, verse.Reference)
+	for _, v := range verse.Verses {
+		response += fmt.Sprintf("%d. %s\n", v.Verse, v.Text)
+	}
+	return mcp.NewToolResultStructured(structured, response), nil
+}
+
+// verseOfTheDayPool returns the passages a verse of the day is picked from:
+// the curated theme passages, or those suggested for each topic when topics
+// are given, kept to a collection when one is given
+func (s *Service) verseOfTheDayPool(ctx context.Context, collection string, topics []string) []VerseOfTheDay {
+	keep := func(verse Scripture) bool {
+		return collection == "" || s.bookCollections[verse.Book] == collection
+	}
+	var pool []VerseOfTheDay
+	if len(topics) == 0 {
+		for _, tag := range themeTags {
+			for _, passage := range s.themePassages(tag, nil) {
+				if keep(passage.Verses[0]) {
+					pool = append(pool, VerseOfTheDay{Theme: tag.Theme, ThemePassage: passage})
+				}
+			}
+		}
+		return pool
+	}
+	for _, topic := range topics {
+		words := make(map[string]bool)
+		for _, word := range s.tokenizer().Words(topic) {
+			words[word] = true
+		}
+		for _, passage := range s.topicPassages(ctx, topic, keep) {
+			chosen := VerseOfTheDay{Theme: topic, ThemePassage: ThemePassage{Reference: passage.Reference, Verses: passage.Verses}}
+			for _, verse := range passage.Verses {
+				for _, word := range s.tokenizer().Words(verse.Text) {
+					chosen.Keyword = chosen.Keyword || words[word]
+				}
+			}
+			pool = append(pool, chosen)
+		}
+	}
+	return pool
+}
+
+// verseOfTheDay picks a passage of a pool for a date, cycling through the
+// pool in turn so each date always gets the same one
+func (s *Service) verseOfTheDay(date time.Time, pool []VerseOfTheDay) *VerseOfTheDay {
+	if len(pool) == 0 {
+		return nil
+	}
+	days := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC).Unix() / (24 * 60 * 60)
+	index := int(days % int64(len(pool)))
+	if index < 0 {
+		index += len(pool) // Dates before 1970
+	}
+	return &pool[index]
+}
+
+// studyWeek returns the schedule entry covering a date, if any
+func (s *Service) studyWeek(day string) *StudyWeek {
+	for _, week := range s.schedule {
+		// Dates in the layout compare correctly as strings
+		if week.Start <= day && day <= week.End {
+			return &week
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package scripture
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const testSchedule = `{"weeks": [
+	{"start": "2026-10-12", "end": "2026-10-18", "title": "Daniel 1-6", "passages": ["Daniel 1", "Daniel 6"]},
+	{"start": "2026-10-19", "end": "2026-10-25", "passages": ["Hosea 1; Hosea 14"]},
+	{"start": "2026-10-26", "end": "2026-10-20", "passages": ["Joel 1"]},
+	{"start": "November", "end": "2026-11-08", "passages": ["Joel 1"]},
+	{"start": "2026-11-09", "end": "2026-11-15"}
+]}`
+
+func TestService_loadSchedule(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, scheduleFile), []byte(testSchedule), 0644); err != nil {
+		t.Fatalf("Failed to write schedule: %v", err)
+	}
+	t.Setenv("SCRIPTURES_DATA_DIR", dir)
+
+	service := NewService()
+	// Weeks with bad dates, bad passages or no passages are skipped
+	if len(service.schedule) != 1 || service.schedule[0].Title != "Daniel 1-6" {
+		t.Errorf("Expected only the first week, got %+v", service.schedule)
+	}
+}
+
+func TestService_DailyDigest(t *testing.T) {
+	service := NewService()
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]any{"date": "2026-10-14"}
+	result, err := service.DailyDigest(context.Background(), request)
+	if err != nil || result.IsError {
+		t.Fatalf("Unexpected error: %v %+v", err, result)
+	}
+	digest := result.StructuredContent.(DigestResult)
+	if digest.Date != "2026-10-14" || digest.VerseOfTheDay == nil || len(digest.VerseOfTheDay.Verses) == 0 || digest.Study != nil {
+		t.Errorf("Expected a verse of the day and no study week, got %+v", digest)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "Wednesday, October 14, 2026") || !strings.Contains(text, "No study schedule loaded") {
+		t.Errorf("Unexpected digest text:\n%s", text)
+	}
+
+	// The same date always gets the same verse, and the next date the next one
+	again, _ := service.DailyDigest(context.Background(), request)
+	if again.StructuredContent.(DigestResult).VerseOfTheDay.Reference != digest.VerseOfTheDay.Reference {
+		t.Error("Expected the same verse of the day for the same date")
+	}
+	request.Params.Arguments = map[string]any{"date": "2026-10-15"}
+	next, _ := service.DailyDigest(context.Background(), request)
+	if next.StructuredContent.(DigestResult).VerseOfTheDay.Reference == digest.VerseOfTheDay.Reference {
+		t.Error("Expected a different verse of the day for the next date")
+	}
+
+	schedule, err := service.parseSchedule([]byte(testSchedule))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	service.schedule = schedule
+	for date, expected := range map[string]string{"2026-10-12": "Daniel 1-6", "2026-10-18": "Daniel 1-6", "2026-10-19": ""} {
+		request.Params.Arguments = map[string]any{"date": date}
+		result, _ := service.DailyDigest(context.Background(), request)
+		study := result.StructuredContent.(DigestResult).Study
+		if (study == nil) != (expected == "") || (study != nil && study.Title != expected) {
+			t.Errorf("%s: expected study %q, got %+v", date, expected, study)
+		}
+	}
+
+	request.Params.Arguments = map[string]any{"date": "10/14/2026"}
+	if result, _ := service.DailyDigest(context.Background(), request); !result.IsError {
+		t.Error("Expected an error for a malformed date")
+	}
+}
+
+func TestService_GetVerseOfTheDay(t *testing.T) {
+	service := NewService()
+	call := func(arguments map[string]any) *mcp.CallToolResult {
+		t.Helper()
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = arguments
+		result, err := service.GetVerseOfTheDay(context.Background(), request)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+	verse := func(arguments map[string]any) *VerseOfTheDay {
+		t.Helper()
+		result := call(arguments)
+		if result.IsError {
+			t.Fatalf("Unexpected error: %+v", result)
+		}
+		return result.StructuredContent.(VerseOfTheDayResult).VerseOfTheDay
+	}
+
+	// Without filters it is daily_digest's verse of the day
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]any{"date": "2026-10-14"}
+	digest, _ := service.DailyDigest(context.Background(), request)
+	plain := verse(map[string]any{"date": "2026-10-14"})
+	if plain == nil || plain.Reference != digest.StructuredContent.(DigestResult).VerseOfTheDay.Reference {
+		t.Errorf("Expected daily_digest's verse of the day, got %+v", plain)
+	}
+
+	// Filters are deterministic by date too
+	filtered := map[string]any{"date": "2026-10-14", "collection": "book of mormon", "topics": "faith, repentance"}
+	chosen := verse(filtered)
+	if chosen == nil || service.bookCollections[chosen.Verses[0].Book] != "Book of Mormon" || (chosen.Theme != "faith" && chosen.Theme != "repentance") {
+		t.Fatalf("Expected a Book of Mormon passage on faith or repentance, got %+v", chosen)
+	}
+	if again := verse(filtered); again.Reference != chosen.Reference {
+		t.Errorf("Expected %s again for the same date, got %s", chosen.Reference, again.Reference)
+	}
+	filtered["date"] = "2026-10-15"
+	if next := verse(filtered); next.Reference == chosen.Reference {
+		t.Errorf("Expected another passage for the next date, got %s", next.Reference)
+	}
+
+	if result := call(map[string]any{"date": "2026-10-14", "collection": "Pearl of Great Price", "topics": "xyzzy"}); result.IsError || result.StructuredContent.(VerseOfTheDayResult).VerseOfTheDay != nil {
+		t.Errorf("Expected no passage for an unknown topic, got %+v", result)
+	}
+	for _, arguments := range []map[string]any{
+		{"date": "10/14/2026"},
+		{"collection": "Apocrypha"},
+	} {
+		if result := call(arguments); !result.IsError {
+			t.Errorf("Expected an error for %v, got %+v", arguments, result)
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package scripture
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// DoctorCheck is the result of a single self-test check
+type DoctorCheck struct {
+	Name   string `json:"name"`
+	Passed bool   `json:"passed"`
+	Detail string `json:"detail"`
+}
+
+// doctorCanaries are well-known verses that must resolve to the expected text
+var doctorCanaries = []struct {
+	Reference string
+	Contains  string
+}{
+	{Reference: "1 Nephi 3:7", Contains: "I will go and do the things which the Lord hath commanded"},
+	{Reference: "John 3:16", Contains: "For God so loved the world"},
+	{Reference: "Doctrine and Covenants 4:2", Contains: "embark in the service of God"},
+}
+
+// doctorSearchCanary is a known search that must find the expected verse
+var doctorSearchCanary = struct {
+	Query    string
+	Expected string
+}{Query: "still small voice", Expected: "1 Kings 19:12"}
+
+// doctorIndexQueries are searches the search index must answer exactly as a
+// linear scan does: a phrase, a word part, a possessive, a book name and
+// punctuation alone
+var doctorIndexQueries = []string{"still small voice", "charit", "lord's house", "nephi", "--"}
+
+// Doctor runs the self-test checks used by the --doctor command: archive
+// integrity, collection coverage, verse store and search index health and
+// canary queries.
+func (s *Service) Doctor() []DoctorCheck {
+	checks := []DoctorCheck{
+		checkArchive(),
+		s.checkCollections(),
+		s.checkVerseStore(),
+		s.checkIndex(),
+	}
+	checks = append(checks, s.checkCanaries()...)
+	return checks
+}
+
+// WriteDoctorReport prints a pass/fail line per check and reports whether all checks passed
+func WriteDoctorReport(w io.Writer, checks []DoctorCheck) bool {
+	passed := 0
+	for _, check := range checks {
+		status := "FAIL"
+		if check.Passed {
+			status = "PASS"
+			passed++
+		}
+		fmt.Fprintf(w, "[%s] %s: %s\n", status, check.Name, check.Detail)
+	}
+	fmt.Fprintf(w, "\n%d/%d checks passed\n", passed, len(checks))
+	return passed == len(checks)
+}
+
+// checkArchive verifies the CRC and JSON validity of every file in the data archive
+func checkArchive() DoctorCheck {
+	check := DoctorCheck{Name: "data archive integrity"}
+
+	data, label, err := dataArchive()
+	if err != nil {
+		check.Detail = fmt.Sprintf("could not read %s: %v", label, err)
+		return check
+	}
+	count, err := verifyArchive(data)
+	if err != nil {
+		check.Detail = fmt.Sprintf("%s: %v", label, err)
+		return check
+	}
+
+	check.Passed = true
+	check.Detail = fmt.Sprintf("%s: %d data files verified", label, count)
+	return check
+}
+
+// dataArchive returns the scripture archive the service loads from, along with a label for reporting
+func dataArchive() ([]byte, string, error) {
+	if data, zipPath, err := readDataFile("scriptures.zip"); err == nil {
+		return data, zipPath, nil
+	}
+	data, err := embeddedData.ReadFile(embeddedArchive)
+	return data, "embedded zip", err
+}
+
+// verifyArchive reads every JSON file in a zip archive, which validates its
+// CRC, confirms the contents parse as JSON and checks the data files against
+// the archive's checksum manifest when it has one
+func verifyArchive(data []byte) (int, error) {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return 0, err
+	}
+
+	var manifest *Manifest
+	observed := make(map[string]string)
+	for _, f := range r.File {
+		if f.FileInfo().IsDir() || !strings.HasSuffix(f.Name, ".json") {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return len(observed), fmt.Errorf("open %s: %w", f.Name, err)
+		}
+		fileBytes, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return len(observed), fmt.Errorf("read %s: %w", f.Name, err)
+		}
+		if !json.Valid(fileBytes) {
+			return len(observed), fmt.Errorf("%s is not valid JSON", f.Name)
+		}
+		if f.Name == manifestName {
+			if manifest, err = parseManifest(fileBytes); err != nil {
+				return len(observed), err
+			}
+			continue
+		}
+		sum := sha256.Sum256(fileBytes)
+		observed[f.Name] = hex.EncodeToString(sum[:])
+	}
+
+	if len(observed) == 0 {
+		return 0, fmt.Errorf("archive contains no JSON data files")
+	}
+	if status := verifyChecksums("", manifest, observed); !status.OK() {
+		return len(observed), fmt.Errorf("%s", status.problems())
+	}
+	return len(observed), nil
+}
+
+// checkCollections confirms every standard work contributed at least one book
+func (s *Service) checkCollections() DoctorCheck {
+	check := DoctorCheck{Name: "collections loaded"}
+
+	var missing []string
+	for _, work := range standardWorks {
+		if !s.hasCollection(work.Name) {
+			missing = append(missing, work.Name)
+		}
+	}
+	if reason := s.partialData(); reason != "" && len(missing) < len(standardWorks) {
+		check.Passed = true
+		check.Detail = fmt.Sprintf("%d collections, %d books (%s without %s)", len(standardWorks)-len(missing), len(s.scriptures), reason, strings.Join(missing, ", "))
+		return check
+	}
+	if len(missing) > 0 {
+		check.Detail = "missing " + strings.Join(missing, ", ")
+		return check
+	}
+
+	check.Passed = true
+	check.Detail = fmt.Sprintf("%d collections, %d books", len(standardWorks), len(s.scriptures))
+	return check
+}
+
+// checkVerseStore confirms every loaded book has verses and no duplicate chapter:verse entries
+func (s *Service) checkVerseStore() DoctorCheck {
+	check := DoctorCheck{Name: "verse store health"}
+
+	if len(s.scriptures) == 0 {
+		check.Detail = "no verses loaded"
+		return check
+	}
+
+	total := 0
+	for _, book := range s.orderedBooks() {
+		verses := s.scriptures[book]
+		if len(verses) == 0 {
+			check.Detail = fmt.Sprintf("%s has no verses", book)
+			return check
+		}
+		seen := make(map[[2]int]bool, len(verses))
+		for _, verse := range verses {
+			key := [2]int{verse.Chapter, verse.Verse}
+			if seen[key] {
+				check.Detail = fmt.Sprintf("duplicate verse %s %d:%d", book, verse.Chapter, verse.Verse)
+				return check
+			}
+			seen[key] = true
+		}
+		total += len(verses)
+	}
+
+	check.Passed = true
+	check.Detail = fmt.Sprintf("%d verses across %d books", total, len(s.scriptures))
+	return check
+}
+
+// checkIndex confirms the search index answers the canary index queries
+// with the same verses, in the same order, as a linear scan of the loaded
+// verses, so a stale or corrupt index (or index cache) is caught
+func (s *Service) checkIndex() DoctorCheck {
+	check := DoctorCheck{Name: "search index health"}
+	if s.index == nil {
+		check.Detail = "no search index built"
+		return check
+	}
+
+	verses := s.flatVerses()
+	for _, query := range doctorIndexQueries {
+		var scanned []string
+		for _, verse := range verses {
+			if matchesQuery(verse, query) {
+				scanned = append(scanned, verse.Reference)
+			}
+		}
+		var indexed []string
+		for _, verse := range s.index.search(context.Background(), query) {
+			indexed = append(indexed, verse.Reference)
+		}
+		if !slices.Equal(indexed, scanned) {
+			check.Detail = fmt.Sprintf("search '%s' found %d verses with the index and %d by scanning", query, len(indexed), len(scanned))
+			return check
+		}
+	}
+
+	check.Passed = true
+	check.Detail = fmt.Sprintf("%d canary searches match a linear scan", len(doctorIndexQueries))
+	return check
+}
+
+// checkCanaries runs the canary lookups and the canary search
+func (s *Service) checkCanaries() []DoctorCheck {
+	var checks []DoctorCheck
+
+	for _, canary := range doctorCanaries {
+		check := DoctorCheck{Name: "canary " + canary.Reference}
+		ref, err := s.parseReference(canary.Reference)
+		if err != nil {
+			check.Detail = err.Error()
+			checks = append(checks, check)
+			continue
+		}
+		verses := s.getScripturesByReference(ref)
+		switch {
+		case s.leftOut(ref.Book):
+			check.Passed = true
+			check.Detail = "skipped; not in this " + s.partialData()
+		case len(verses) == 0:
+			check.Detail = "reference not found"
+		case !strings.Contains(verses[0].Text, canary.Contains):
+			check.Detail = "unexpected verse text"
+		default:
+			check.Passed = true
+			check.Detail = "ok"
+		}
+		checks = append(checks, check)
+	}
+
+	check := DoctorCheck{Name: fmt.Sprintf("canary search '%s'", doctorSearchCanary.Query)}
+	check.Detail = fmt.Sprintf("%s not found in results", doctorSearchCanary.Expected)
+	if ref, err := s.parseReference(doctorSearchCanary.Expected); err == nil && s.leftOut(ref.Book) {
+		check.Passed = true
+		check.Detail = "skipped; not in this " + s.partialData()
+		return append(checks, check)
+	}
+	for _, result := range s.performSearch(doctorSearchCanary.Query, 100) {
+		if fmt.Sprintf("%s %d:%d", result.Book, result.Chapter, result.Verse) == doctorSearchCanary.Expected {
+			check.Passed = true
+			check.Detail = "ok"
+			break
+		}
+	}
+	checks = append(checks, check)
+
+	return checks
+}
+
+// leftOut reports whether a canary's book is missing because this build
+// embeds only some collections or SCRIPTURES_COLLECTIONS loads only some
+func (s *Service) leftOut(book string) bool {
+	_, loaded := s.scriptures[book]
+	return s.partialData() != "" && !loaded
+}
+
+// partialData describes why only some standard works may be loaded, or
+// returns "" when all of them should be
+func (s *Service) partialData() string {
+	switch {
+	case slimBuild:
+		return "slim build"
+	case s.works != nil:
+		return "SCRIPTURES_COLLECTIONS selection"
+	}
+	return ""
+}
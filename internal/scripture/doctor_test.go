@@ -0,0 +1,177 @@
+package scripture
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// buildTestZip creates an in-memory zip archive from a map of file name to contents
+func buildTestZip(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, contents := range files {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatalf("Failed to create zip entry: %v", err)
+		}
+		if _, err := f.Write([]byte(contents)); err != nil {
+			t.Fatalf("Failed to write zip entry: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Failed to close zip: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestVerifyArchive(t *testing.T) {
+	tests := []struct {
+		name          string
+		files         map[string]string
+		expectedCount int
+		expectError   bool
+	}{
+		{
+			name:          "Valid archive",
+			files:         map[string]string{"a.json": `{"books": []}`, "b.json": `{}`, "readme.txt": "ignored"},
+			expectedCount: 2,
+			expectError:   false,
+		},
+		{
+			name:        "Checksum mismatch",
+			files:       map[string]string{"a.json": `{"books": []}`, manifestName: `{"files": {"a.json": {"sha256": "deadbeef", "size": 13}}}`},
+			expectError: true,
+		},
+		{
+			name:        "Invalid JSON",
+			files:       map[string]string{"a.json": `{"books": [`},
+			expectError: true,
+		},
+		{
+			name:        "No data files",
+			files:       map[string]string{"readme.txt": "nothing here"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count, err := verifyArchive(buildTestZip(t, tt.files))
+
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if count != tt.expectedCount {
+				t.Errorf("Expected %d files, got %d", tt.expectedCount, count)
+			}
+		})
+	}
+
+	t.Run("Corrupted archive", func(t *testing.T) {
+		data := buildTestZip(t, map[string]string{"a.json": `{"books": []}`})
+		corrupted := bytes.Replace(data, []byte(`{"books": []}`), []byte(`{"books": {}}`), 1)
+		if _, err := verifyArchive(corrupted); err == nil {
+			t.Error("Expected checksum error for corrupted archive")
+		}
+	})
+}
+
+func TestService_checkVerseStore(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+
+	if service.checkVerseStore().Passed {
+		t.Error("Expected empty verse store to fail")
+	}
+
+	service.scriptures["John"] = []Scripture{
+		{Book: "John", Chapter: 3, Verse: 16, Text: "For God so loved the world", Reference: "John 3:16"},
+	}
+	if check := service.checkVerseStore(); !check.Passed {
+		t.Errorf("Expected verse store to pass, got: %s", check.Detail)
+	}
+
+	service.scriptures["John"] = append(service.scriptures["John"], service.scriptures["John"][0])
+	if service.checkVerseStore().Passed {
+		t.Error("Expected duplicate verse to fail")
+	}
+}
+
+func TestService_checkCollections(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	service.recordBook("1 Nephi", "Book of Mormon")
+
+	check := service.checkCollections()
+	if check.Passed {
+		t.Error("Expected missing collections to fail")
+	}
+	if !strings.Contains(check.Detail, "Old Testament") || strings.Contains(check.Detail, "Book of Mormon") {
+		t.Errorf("Unexpected missing collections detail: %s", check.Detail)
+	}
+}
+
+func TestService_checkIndex(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	service.recordBook("1 Kings", "Old Testament")
+	service.scriptures["1 Kings"] = []Scripture{
+		{Book: "1 Kings", Chapter: 19, Verse: 12, Text: "And after the earthquake a fire; and after the fire a still small voice.", Reference: "1 Kings 19:12"},
+		{Book: "1 Kings", Chapter: 19, Verse: 13, Text: "And it was so, when Elijah heard it", Reference: "1 Kings 19:13"},
+	}
+	if service.checkIndex().Passed {
+		t.Error("Expected a service without an index to fail")
+	}
+
+	service.index = service.newSearchIndex()
+	if check := service.checkIndex(); !check.Passed {
+		t.Errorf("Expected the index to pass, got: %s", check.Detail)
+	}
+
+	// A verse changed after indexing leaves the index stale
+	service.scriptures["1 Kings"][1].Text = "a still small voice again"
+	check := service.checkIndex()
+	if check.Passed || !strings.Contains(check.Detail, "still small voice") {
+		t.Errorf("Expected the stale index to fail, got: %+v", check)
+	}
+}
+
+func TestWriteDoctorReport(t *testing.T) {
+	var buf bytes.Buffer
+	ok := WriteDoctorReport(&buf, []DoctorCheck{
+		{Name: "first", Passed: true, Detail: "ok"},
+		{Name: "second", Passed: false, Detail: "broken"},
+	})
+
+	if ok {
+		t.Error("Expected report with a failed check to return false")
+	}
+	output := buf.String()
+	for _, want := range []string{"[PASS] first: ok", "[FAIL] second: broken", "1/2 checks passed"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected report to contain '%s', got:\n%s", want, output)
+		}
+	}
+}
+
+func TestService_Doctor_EmbeddedData(t *testing.T) {
+	t.Setenv("SCRIPTURES_DATA_DIR", "")
+	service := NewService()
+
+	for _, check := range service.Doctor() {
+		if !check.Passed {
+			t.Errorf("Check '%s' failed: %s", check.Name, check.Detail)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// defaultDuplicateSimilarity is the Jaccard similarity two verses need to be
+// reported as duplicates when the caller does not choose a threshold
+const defaultDuplicateSimilarity = 0.8
+
+// DuplicateCluster is a group of verses whose text is the same or nearly so.
+// Similarity is the weakest pairwise link that holds the cluster together.
+type DuplicateCluster struct {
+	References []string `json:"references"`
+	Similarity float64  `json:"similarity"`
+	Text       string   `json:"text"`
+}
+
+// verseSimilarity links two verses, by index into a verse list
+type verseSimilarity struct {
+	A, B       int
+	Similarity float64
+}
+
+// FindDuplicateVerses reports clusters of verbatim or near-verbatim duplicate verses
+func (s *Service) FindDuplicateVerses(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	minSimilarity := defaultDuplicateSimilarity
+	if similarityVal, ok := arguments["min_similarity"].(float64); ok {
+		minSimilarity = similarityVal
+	}
+	if minSimilarity <= 0 || minSimilarity > 1 {
+		return mcp.NewToolResultError("min_similarity must be greater than 0 and at most 1"), nil
+	}
+
+	limit := 10
+	if limitVal, ok := arguments["limit"].(float64); ok {
+		limit = max(int(limitVal), 1)
+	}
+
+	offset := 0
+	if offsetVal, ok := arguments["offset"].(float64); ok {
+		offset = int(offsetVal)
+		if offset < 0 {
+			return mcp.NewToolResultError("offset cannot be negative"), nil
+		}
+	}
+
+	var scope []*ScriptureReference
+	scopeText, _ := arguments["scope"].(string)
+	if scopeText != "" {
+		ref, err := s.parseSelector(scopeText)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		scope = append(scope, ref)
+	}
+
+	verses := s.flatVerses()
+	clusters := duplicateClusters(duplicatePairs(s.tokenizer(), verses, minSimilarity))
+	if scope != nil {
+		filtered := clusters[:0]
+		for _, cluster := range clusters {
+			if slices.ContainsFunc(cluster.members, func(i int) bool { return selectorMatches(scope, verses[i]) }) {
+				filtered = append(filtered, cluster)
+			}
+		}
+		clusters = filtered
+	}
+
+	total := len(clusters)
+	structured := DuplicatesResult{MinSimilarity: minSimilarity, Offset: offset, Total: total, Clusters: []DuplicateCluster{}}
+	if total == 0 {
+		return mcp.NewToolResultStructured(structured, fmt.Sprintf("No duplicate verses found at similarity %.2f or above.", minSimilarity)), nil
+	}
+	if offset >= total {
+		return mcp.NewToolResultStructured(structured, fmt.Sprintf("No duplicate clusters at offset %d; there are %d in total.", offset, total)), nil
+	}
+	page := clusters[offset:min(offset+limit, total)]
+
+	response := fmt.Sprintf("Found %d duplicate %s at similarity %.2f or above:\n\n", total, pluralize(total, "cluster", "clusters"), minSimilarity)
+	results := make([]DuplicateCluster, 0, len(page))
+	for i, cluster := range page {
+		result := DuplicateCluster{Text: verses[cluster.members[0]].Text, Similarity: round2(cluster.similarity)}
+		for _, member := range cluster.members {
+			result.References = append(result.References, verses[member].Reference)
+		}
+		results = append(results, result)
+
+		response += fmt.Sprintf("%d. %d verses, similarity %.2f: %s\n", offset+i+1, len(result.References), result.Similarity, result.Text)
+		response += fmt.Sprintf("   %s\n\n", strings.Join(result.References, "; "))
+	}
+
+	structured.Clusters = results
+	meta := map[string]any{"clusters": results}
+	if truncation := newTruncation(offset, len(page), total); truncation != nil {
+		response += truncation.String() + "\n"
+		meta["truncated"] = truncation
+	}
+
+	result := mcp.NewToolResultStructured(structured, response)
+	result.Meta = &mcp.Meta{AdditionalFields: meta}
+	return result, nil
+}
+
+// duplicatePairs finds every pair of verses with at least minSimilarity
+// Jaccard similarity between their shingle sets. It uses prefix filtering:
+// with each set ordered rarest shingle first, two sets that similar must share
+// a shingle within their first len-ceil(minSimilarity*len)+1 entries, so only
+// those prefixes are indexed and verses with nothing rare in common are never compared.
+func duplicatePairs(tokenizer Tokenizer, verses []Scripture, minSimilarity float64) []verseSimilarity {
+	sets := make([][]uint64, len(verses))
+	frequency := make(map[uint64]int)
+	for i, verse := range verses {
+		sets[i] = shingles(tokenizer, verse.Text)
+		for _, shingle := range sets[i] {
+			frequency[shingle]++
+		}
+	}
+
+	index := make(map[uint64][]int)
+	seen := make([]int, len(verses)) // Last verse each candidate was collected for, plus one
+	var pairs []verseSimilarity
+	var candidates []int
+	for i, set := range sets {
+		if len(set) == 0 {
+			continue
+		}
+		prefix := make([]rankedShingle, len(set))
+		for k, shingle := range set {
+			prefix[k] = rankedShingle{shingle: shingle, frequency: frequency[shingle]}
+		}
+		sort.Slice(prefix, func(a, b int) bool {
+			if prefix[a].frequency != prefix[b].frequency {
+				return prefix[a].frequency < prefix[b].frequency
+			}
+			return prefix[a].shingle < prefix[b].shingle
+		})
+		prefix = prefix[:len(prefix)-int(math.Ceil(minSimilarity*float64(len(prefix))))+1]
+
+		candidates = candidates[:0]
+		for _, ranked := range prefix {
+			for _, j := range index[ranked.shingle] {
+				if seen[j] != i+1 {
+					seen[j] = i + 1
+					candidates = append(candidates, j)
+				}
+			}
+			index[ranked.shingle] = append(index[ranked.shingle], i)
+		}
+
+		for _, j := range candidates {
+			// Jaccard similarity can be no higher than the ratio of the set sizes
+			small, large := min(len(set), len(sets[j])), max(len(set), len(sets[j]))
+			if float64(small) < minSimilarity*float64(large) {
+				continue
+			}
+			if similarity := jaccard(set, sets[j]); similarity >= minSimilarity {
+				pairs = append(pairs, verseSimilarity{A: j, B: i, Similarity: similarity})
+			}
+		}
+	}
+	return pairs
+}
+
+// rankedShingle orders a shingle by how many verses contain it
+type rankedShingle struct {
+	shingle   uint64
+	frequency int
+}
+
+// verseCluster is a duplicate cluster as verse indexes in reading order
+type verseCluster struct {
+	members    []int
+	similarity float64
+}
+
+// duplicateClusters joins linked verses into clusters (single-linkage).
+// Clusters are ordered largest first, then most similar, then by reading order.
+func duplicateClusters(pairs []verseSimilarity) []verseCluster {
+	parent := make(map[int]int)
+	var find func(int) int
+	find = func(i int) int {
+		p := parent[i]
+		if p == i {
+			return i
+		}
+		root := find(p)
+		parent[i] = root
+		return root
+	}
+
+	for _, pair := range pairs {
+		for _, i := range []int{pair.A, pair.B} {
+			if _, ok := parent[i]; !ok {
+				parent[i] = i
+			}
+		}
+		a, b := find(pair.A), find(pair.B)
+		if a != b {
+			parent[max(a, b)] = min(a, b)
+		}
+	}
+
+	byRoot := make(map[int]*verseCluster)
+	for _, pair := range pairs {
+		root := find(pair.A)
+		cluster, ok := byRoot[root]
+		if !ok {
+			cluster = &verseCluster{similarity: 1}
+			byRoot[root] = cluster
+		}
+		cluster.similarity = min(cluster.similarity, pair.Similarity)
+	}
+	for i := range parent {
+		cluster := byRoot[find(i)]
+		cluster.members = append(cluster.members, i)
+	}
+
+	clusters := make([]verseCluster, 0, len(byRoot))
+	for _, cluster := range byRoot {
+		sort.Ints(cluster.members)
+		clusters = append(clusters, *cluster)
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		a, b := clusters[i], clusters[j]
+		if len(a.members) != len(b.members) {
+			return len(a.members) > len(b.members)
+		}
+		if a.similarity != b.similarity {
+			return a.similarity > b.similarity
+		}
+		return a.members[0] < b.members[0]
+	})
+	return clusters
+}
@@ -0,0 +1,110 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestService_FindDuplicateVerses(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+
+	// Add test data
+	service.recordBook("Isaiah", "Old Testament")
+	service.recordBook("Leviticus", "Old Testament")
+	service.recordBook("2 Nephi", "Book of Mormon")
+	service.scriptures["Leviticus"] = []Scripture{
+		{Book: "Leviticus", Chapter: 4, Verse: 1, Text: "And the LORD spake unto Moses, saying,", Reference: "Leviticus 4:1"},
+		{Book: "Leviticus", Chapter: 5, Verse: 14, Text: "And the LORD spake unto Moses, saying,", Reference: "Leviticus 5:14"},
+		{Book: "Leviticus", Chapter: 6, Verse: 1, Text: "And the LORD spake unto Moses, saying,", Reference: "Leviticus 6:1"},
+	}
+	service.scriptures["Isaiah"] = []Scripture{
+		{Book: "Isaiah", Chapter: 2, Verse: 3, Text: "And many people shall go and say, Come ye, and let us go up to the mountain of the LORD, to the house of the God of Jacob", Reference: "Isaiah 2:3"},
+		{Book: "Isaiah", Chapter: 2, Verse: 4, Text: "And he shall judge among the nations, and shall rebuke many people", Reference: "Isaiah 2:4"},
+	}
+	service.scriptures["2 Nephi"] = []Scripture{
+		{Book: "2 Nephi", Chapter: 12, Verse: 3, Text: "And many people shall go and say, Come ye, and let us go up to the mountain of the Lord, to the house of the God of Jacob", Reference: "2 Nephi 12:3"},
+		{Book: "2 Nephi", Chapter: 12, Verse: 4, Text: "And he shall judge among the nations, and shall rebuke many people; and they shall beat their swords into plowshares", Reference: "2 Nephi 12:4"},
+	}
+
+	tests := []struct {
+		name             string
+		arguments        map[string]interface{}
+		expectError      bool
+		shouldContain    []string
+		shouldNotContain []string
+	}{
+		{
+			name:          "Default threshold",
+			arguments:     map[string]interface{}{},
+			shouldContain: []string{"Found 2 duplicate clusters", "1. 3 verses, similarity 1.00", "Leviticus 4:1; Leviticus 5:14; Leviticus 6:1", "Isaiah 2:3; 2 Nephi 12:3"},
+		},
+		{
+			name:             "Lower threshold finds near duplicates",
+			arguments:        map[string]interface{}{"min_similarity": 0.5},
+			shouldContain:    []string{"Found 3 duplicate clusters", "similarity 0.56", "Isaiah 2:4; 2 Nephi 12:4"},
+			shouldNotContain: []string{"more results"},
+		},
+		{
+			name:             "Scope",
+			arguments:        map[string]interface{}{"scope": "2 Nephi 12"},
+			shouldContain:    []string{"Found 1 duplicate cluster ", "Isaiah 2:3; 2 Nephi 12:3"},
+			shouldNotContain: []string{"Leviticus"},
+		},
+		{
+			name:          "Paging",
+			arguments:     map[string]interface{}{"limit": float64(1)},
+			shouldContain: []string{"1 more result; call again with offset=1."},
+		},
+		{
+			name:          "Nothing above threshold in scope",
+			arguments:     map[string]interface{}{"scope": "Isaiah 2:4"},
+			shouldContain: []string{"No duplicate verses found at similarity 0.80 or above."},
+		},
+		{
+			name:        "Threshold out of range",
+			arguments:   map[string]interface{}{"min_similarity": 1.5},
+			expectError: true,
+		},
+		{
+			name:        "Unknown scope",
+			arguments:   map[string]interface{}{"scope": "Hezekiah"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+			result, err := service.FindDuplicateVerses(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.IsError != tt.expectError {
+				t.Fatalf("Expected IsError = %v, got %v: %v", tt.expectError, result.IsError, result.Content)
+			}
+			if tt.expectError {
+				return
+			}
+			text := result.Content[0].(mcp.TextContent).Text
+			for _, expected := range tt.shouldContain {
+				if !strings.Contains(text, expected) {
+					t.Errorf("Expected output to contain %q, got:\n%s", expected, text)
+				}
+			}
+			for _, unexpected := range tt.shouldNotContain {
+				if strings.Contains(text, unexpected) {
+					t.Errorf("Expected output not to contain %q, got:\n%s", unexpected, text)
+				}
+			}
+		})
+	}
+}
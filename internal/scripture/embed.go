@@ -1,3 +1,5 @@
+//go:build !scriptures_slim
+
 package scripture
 
 import "embed"
@@ -6,3 +8,9 @@ import "embed"
 // Run ./sync-data.sh (or .\sync-data.ps1) to refresh data/scriptures.zip before building.
 //go:embed data/scriptures.zip
 var embeddedData embed.FS
+
+// slimBuild reports whether the embedded data holds only some collections
+const slimBuild = false
+
+// embeddedArchive is the path of the archive within embeddedData
+const embeddedArchive = "data/scriptures.zip"
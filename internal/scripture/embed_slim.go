@@ -0,0 +1,17 @@
+//go:build scriptures_slim
+
+package scripture
+
+import "embed"
+
+// Embedded slim scripture data holding only selected collections, for
+// constrained environments. Run `scriptures-mcp slim-data` to create
+// data/scriptures-slim.zip before building with -tags scriptures_slim.
+//go:embed data/scriptures-slim.zip
+var embeddedData embed.FS
+
+// slimBuild reports whether the embedded data holds only some collections
+const slimBuild = true
+
+// embeddedArchive is the path of the archive within embeddedData
+const embeddedArchive = "data/scriptures-slim.zip"
@@ -0,0 +1,191 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ExportAnkiDeck converts a list of references into an Anki-importable TSV deck
+func (s *Service) ExportAnkiDeck(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	list, ok := arguments["references"].(string)
+	if !ok || strings.TrimSpace(list) == "" {
+		return mcp.NewToolResultError("references cannot be empty"), nil
+	}
+
+	references := splitReferences(list)
+	deck, err := s.ankiDeck(references)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultStructured(AnkiDeckResult{Cards: len(references), Deck: deck}, deck), nil
+}
+
+// ankiDeck builds a tab-separated deck with one reference -> text card per reference
+func (s *Service) ankiDeck(references []string) (string, error) {
+	if len(references) == 0 {
+		return "", fmt.Errorf("no references given")
+	}
+
+	var deck strings.Builder
+	deck.WriteString("#separator:tab\n")
+	deck.WriteString("#html:false\n")
+	deck.WriteString("#columns:Reference\tText\n")
+
+	for _, reference := range references {
+		ref, err := s.parseReference(reference)
+		if err != nil {
+			return "", fmt.Errorf("invalid scripture reference '%s': %v", reference, err)
+		}
+		scriptures := s.getScripturesByReference(ref)
+		if len(scriptures) == 0 {
+			return "", fmt.Errorf("scripture reference '%s' not found", reference)
+		}
+
+		texts := make([]string, 0, len(scriptures))
+		for _, scripture := range scriptures {
+			texts = append(texts, scripture.Text)
+		}
+		fmt.Fprintf(&deck, "%s\t%s\n", ankiField(reference), ankiField(strings.Join(texts, " ")))
+	}
+
+	return deck.String(), nil
+}
+
+// ankiField removes characters that would break a TSV row
+func ankiField(value string) string {
+	return strings.NewReplacer("\t", " ", "\r", " ", "\n", " ").Replace(strings.TrimSpace(value))
+}
+
+// splitReferences splits a semicolon or newline separated list of references
+func splitReferences(list string) []string {
+	var references []string
+	for _, part := range strings.FieldsFunc(list, func(r rune) bool { return r == ';' || r == '\n' }) {
+		if part = strings.TrimSpace(part); part != "" {
+			references = append(references, part)
+		}
+	}
+	return references
+}
+
+// ExportMarkdownVault writes every loaded chapter as a markdown note with YAML
+// frontmatter and wiki-links, laid out as <dir>/<collection>/<book>/<book> <chapter>.md
+// so the corpus can be opened as an Obsidian or Logseq vault. It returns the
+// number of notes written.
+func (s *Service) ExportMarkdownVault(dir string) (int, error) {
+	sequence := s.chapterSequence()
+	if len(sequence) == 0 {
+		return 0, fmt.Errorf("no scripture data loaded")
+	}
+
+	written := 0
+	for i, key := range sequence {
+		var prev, next *chapterKey
+		if i > 0 {
+			prev = &sequence[i-1]
+		}
+		if i < len(sequence)-1 {
+			next = &sequence[i+1]
+		}
+
+		bookDir := s.vaultBookDir(dir, key.book)
+		if err := os.MkdirAll(bookDir, 0755); err != nil {
+			return written, err
+		}
+		note := s.chapterNote(key, prev, next)
+		if err := os.WriteFile(filepath.Join(bookDir, vaultFileName(key.String())), []byte(note), 0644); err != nil {
+			return written, err
+		}
+		written++
+	}
+
+	// One index note per book links its chapters together in the graph
+	for _, book := range s.orderedBooks() {
+		if err := os.WriteFile(filepath.Join(s.vaultBookDir(dir, book), vaultFileName(book)), []byte(s.bookNote(book)), 0644); err != nil {
+			return written, err
+		}
+		written++
+	}
+
+	return written, nil
+}
+
+// vaultBookDir returns the directory a book's notes are written to
+func (s *Service) vaultBookDir(dir, book string) string {
+	collection := s.bookCollections[book]
+	if collection == "" {
+		collection = "Other"
+	}
+	return filepath.Join(dir, vaultName(collection), vaultName(book))
+}
+
+// chapterNote renders a chapter as a markdown note
+func (s *Service) chapterNote(key chapterKey, prev, next *chapterKey) string {
+	verses := s.getChapter(key.book, key.chapter)
+
+	var note strings.Builder
+	note.WriteString("---\n")
+	fmt.Fprintf(&note, "title: %q\n", key.String())
+	fmt.Fprintf(&note, "book: %q\n", key.book)
+	fmt.Fprintf(&note, "chapter: %d\n", key.chapter)
+	if collection := s.bookCollections[key.book]; collection != "" {
+		fmt.Fprintf(&note, "collection: %q\n", collection)
+	}
+	fmt.Fprintf(&note, "verses: %d\n", len(verses))
+	note.WriteString("tags: [scripture]\n")
+	note.WriteString("---\n\n")
+
+	fmt.Fprintf(&note, "# %s\n\n", key.String())
+	for _, verse := range verses {
+		// Block IDs let other notes link to [[1 Nephi 3#^v7]]
+		fmt.Fprintf(&note, "**%d** %s ^v%d\n\n", verse.Verse, verse.Text, verse.Verse)
+	}
+
+	note.WriteString("---\n")
+	var links []string
+	if prev != nil {
+		links = append(links, fmt.Sprintf("← [[%s]]", prev.String()))
+	}
+	links = append(links, fmt.Sprintf("[[%s]]", key.book))
+	if next != nil {
+		links = append(links, fmt.Sprintf("[[%s]] →", next.String()))
+	}
+	note.WriteString(strings.Join(links, " | ") + "\n")
+
+	return note.String()
+}
+
+// bookNote renders the index note for a book, linking to each of its chapters
+func (s *Service) bookNote(book string) string {
+	var note strings.Builder
+	note.WriteString("---\n")
+	fmt.Fprintf(&note, "title: %q\n", book)
+	if collection := s.bookCollections[book]; collection != "" {
+		fmt.Fprintf(&note, "collection: %q\n", collection)
+	}
+	note.WriteString("tags: [scripture, book]\n")
+	note.WriteString("---\n\n")
+
+	fmt.Fprintf(&note, "# %s\n\n", book)
+	for _, chapter := range s.chapterNumbers(book) {
+		fmt.Fprintf(&note, "- [[%s %d]]\n", book, chapter)
+	}
+	return note.String()
+}
+
+// vaultName makes a note or folder name safe to use on every platform
+func vaultName(name string) string {
+	return strings.NewReplacer("/", "-", "\\", "-", ":", "-").Replace(name)
+}
+
+// vaultFileName returns the markdown file name for a note
+func vaultFileName(name string) string {
+	return vaultName(name) + ".md"
+}
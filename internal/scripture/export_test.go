@@ -0,0 +1,164 @@
+package scripture
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestSplitReferences(t *testing.T) {
+	tests := []struct {
+		name     string
+		list     string
+		expected []string
+	}{
+		{
+			name:     "Semicolons",
+			list:     "John 3:16; Moroni 10:4-5",
+			expected: []string{"John 3:16", "Moroni 10:4-5"},
+		},
+		{
+			name:     "Newlines and blanks",
+			list:     "John 3:16\n\n1 Nephi 3:7;",
+			expected: []string{"John 3:16", "1 Nephi 3:7"},
+		},
+		{
+			name:     "Empty",
+			list:     " ; ",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitReferences(tt.list); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestService_ExportAnkiDeck(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+
+	// Add test data
+	service.scriptures["John"] = []Scripture{
+		{Book: "John", Chapter: 3, Verse: 16, Text: "For God so loved the world", Reference: "John 3:16"},
+		{Book: "John", Chapter: 3, Verse: 17, Text: "For God sent not his Son", Reference: "John 3:17"},
+	}
+
+	tests := []struct {
+		name          string
+		arguments     map[string]interface{}
+		expectError   bool
+		shouldContain string
+	}{
+		{
+			name: "Verse range card",
+			arguments: map[string]interface{}{
+				"references": "John 3:16-17",
+			},
+			expectError:   false,
+			shouldContain: "John 3:16-17\tFor God so loved the world For God sent not his Son\n",
+		},
+		{
+			name: "Unknown reference",
+			arguments: map[string]interface{}{
+				"references": "John 3:16; John 99:1",
+			},
+			expectError: true,
+		},
+		{
+			name:        "Missing references",
+			arguments:   map[string]interface{}{},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+			result, err := service.ExportAnkiDeck(context.Background(), request)
+
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			if tt.expectError {
+				if !result.IsError {
+					t.Error("Expected error result but got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Error("Expected success but got error result")
+			}
+
+			text := result.Content[0].(mcp.TextContent).Text
+			if !strings.HasPrefix(text, "#separator:tab\n") {
+				t.Errorf("Expected Anki separator header, got:\n%s", text)
+			}
+			if !strings.Contains(text, tt.shouldContain) {
+				t.Errorf("Expected deck to contain %q, got:\n%s", tt.shouldContain, text)
+			}
+		})
+	}
+}
+
+func TestService_ExportMarkdownVault(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	service.recordBook("1 Nephi", "Book of Mormon")
+	service.scriptures["1 Nephi"] = []Scripture{
+		{Book: "1 Nephi", Chapter: 3, Verse: 7, Text: "I will go and do", Reference: "1 Nephi 3:7"},
+		{Book: "1 Nephi", Chapter: 4, Verse: 1, Text: "And it came to pass", Reference: "1 Nephi 4:1"},
+	}
+
+	dir := t.TempDir()
+	written, err := service.ExportMarkdownVault(dir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if written != 3 {
+		t.Errorf("Expected 3 notes (2 chapters + book index), got %d", written)
+	}
+
+	note, err := os.ReadFile(filepath.Join(dir, "Book of Mormon", "1 Nephi", "1 Nephi 3.md"))
+	if err != nil {
+		t.Fatalf("Expected chapter note: %v", err)
+	}
+	for _, want := range []string{"title: \"1 Nephi 3\"", "collection: \"Book of Mormon\"", "**7** I will go and do ^v7", "[[1 Nephi 4]] →"} {
+		if !strings.Contains(string(note), want) {
+			t.Errorf("Expected chapter note to contain %q, got:\n%s", want, note)
+		}
+	}
+
+	index, err := os.ReadFile(filepath.Join(dir, "Book of Mormon", "1 Nephi", "1 Nephi.md"))
+	if err != nil {
+		t.Fatalf("Expected book index note: %v", err)
+	}
+	if !strings.Contains(string(index), "- [[1 Nephi 4]]") {
+		t.Errorf("Expected book index to link chapters, got:\n%s", index)
+	}
+}
+
+func TestService_ExportMarkdownVault_NoData(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	if _, err := service.ExportMarkdownVault(t.TempDir()); err == nil {
+		t.Error("Expected error when no data is loaded")
+	}
+}
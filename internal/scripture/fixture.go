@@ -0,0 +1,59 @@
+package scripture
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// DumpFixture writes a reduced dataset in the scripture JSON format, for use
+// as a test fixture. Each selector is a book ("Enos"), a chapter ("John 3")
+// or a verse range ("1 Nephi 3:7-8"). Output is deterministic: books follow
+// load order and chapters and verses are ascending.
+func (s *Service) DumpFixture(w io.Writer, selectors []string) error {
+	if len(selectors) == 0 {
+		return fmt.Errorf("no fixture selectors given")
+	}
+
+	refs := make([]*ScriptureReference, 0, len(selectors))
+	for _, selector := range selectors {
+		ref, err := s.parseSelector(selector)
+		if err != nil {
+			return err
+		}
+		refs = append(refs, ref)
+	}
+
+	var books []Book
+	for _, book := range s.orderedBooks() {
+		title := s.bookTitles[book]
+		fixture := Book{Book: book, FullTitle: title.FullTitle, Heading: title.Heading}
+		for _, verse := range s.scriptures[book] {
+			if !selectorMatches(refs, verse) {
+				continue
+			}
+			if n := len(fixture.Chapters); n == 0 || fixture.Chapters[n-1].Chapter != verse.Chapter {
+				fixture.Chapters = append(fixture.Chapters, Chapter{Chapter: verse.Chapter, Heading: s.chapterHeadings[chapterKey{book: book, chapter: verse.Chapter}]})
+			}
+			chapter := &fixture.Chapters[len(fixture.Chapters)-1]
+			chapter.Verses = append(chapter.Verses, Verse{
+				Verse:     verse.Verse,
+				Text:      verse.Text,
+				Reference: verse.Reference,
+				Pilcrow:   verse.Pilcrow,
+			})
+		}
+		if len(fixture.Chapters) > 0 {
+			books = append(books, fixture)
+		}
+	}
+
+	if len(books) == 0 {
+		return fmt.Errorf("no verses matched %s", strings.Join(selectors, "; "))
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(ScriptureData{Books: books})
+}
@@ -0,0 +1,92 @@
+package scripture
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestService_DumpFixture(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+
+	// Add test data
+	service.recordBook("1 Nephi", "Book of Mormon")
+	service.recordBook("Enos", "Book of Mormon")
+	service.scriptures["1 Nephi"] = []Scripture{
+		{Book: "1 Nephi", Chapter: 3, Verse: 7, Text: "I will go and do", Reference: "1 Nephi 3:7"},
+		{Book: "1 Nephi", Chapter: 3, Verse: 8, Text: "And it came to pass", Reference: "1 Nephi 3:8"},
+		{Book: "1 Nephi", Chapter: 17, Verse: 50, Text: "If God had commanded", Reference: "1 Nephi 17:50"},
+	}
+	service.scriptures["Enos"] = []Scripture{
+		{Book: "Enos", Chapter: 1, Verse: 1, Text: "Behold, it came to pass", Reference: "Enos 1:1"},
+	}
+
+	tests := []struct {
+		name          string
+		selectors     []string
+		expectedBooks int
+		expectedCount int
+		expectError   bool
+	}{
+		{name: "Verse range", selectors: []string{"1 Nephi 3:8"}, expectedBooks: 1, expectedCount: 1},
+		{name: "Chapter", selectors: []string{"1 Nephi 3"}, expectedBooks: 1, expectedCount: 2},
+		{name: "Whole book and chapter", selectors: []string{"Enos", "1 Nephi 17"}, expectedBooks: 2, expectedCount: 2},
+		{name: "Unknown book", selectors: []string{"Nonexistent"}, expectError: true},
+		{name: "No matching verses", selectors: []string{"1 Nephi 99"}, expectError: true},
+		{name: "No selectors", selectors: nil, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := service.DumpFixture(&buf, tt.selectors)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			// The fixture must load back through the regular loader
+			path := filepath.Join(t.TempDir(), "fixture.json")
+			if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+				t.Fatalf("Failed to write fixture: %v", err)
+			}
+			loaded := &Service{
+				scriptures: make(map[string][]Scripture),
+			}
+			loaded.loadScriptureFile(path)
+
+			if len(loaded.scriptures) != tt.expectedBooks {
+				t.Errorf("Expected %d books, got %d", tt.expectedBooks, len(loaded.scriptures))
+			}
+			count := 0
+			for _, verses := range loaded.scriptures {
+				count += len(verses)
+			}
+			if count != tt.expectedCount {
+				t.Errorf("Expected %d verses, got %d", tt.expectedCount, count)
+			}
+		})
+	}
+
+	t.Run("Deterministic", func(t *testing.T) {
+		var first, second bytes.Buffer
+		if err := service.DumpFixture(&first, []string{"Enos", "1 Nephi"}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if err := service.DumpFixture(&second, []string{"1 Nephi", "Enos"}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !bytes.Equal(first.Bytes(), second.Bytes()) {
+			t.Error("Expected identical fixtures regardless of selector order")
+		}
+	})
+}
@@ -0,0 +1,221 @@
+package scripture
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// footnotesFile is the optional footnotes and cross-references dataset, read
+// from a data directory. Study edition footnotes are not redistributable,
+// so none are embedded.
+const footnotesFile = "footnotes.json"
+
+// maxLinkedVerses caps the verses returned for one linked passage, so a link
+// to a long range does not flood the response
+const maxLinkedVerses = 10
+
+// Kinds of cross-reference
+const (
+	crossReferenceFootnote = "footnote"
+	crossReferenceParallel = "parallel"
+)
+
+// Footnote is a study note attached to a word of a verse, linking it to
+// other passages
+type Footnote struct {
+	Reference string   `json:"reference"`        // Verse the note is attached to, e.g. "1 Nephi 3:7"
+	Marker    string   `json:"marker,omitempty"` // Footnote letter in the verse, e.g. "a"
+	Word      string   `json:"word,omitempty"`   // Word or phrase the note annotates
+	Note      string   `json:"note,omitempty"`   // Explanatory text, e.g. "HEB lifted up"
+	Links     []string `json:"links,omitempty"`  // Linked passages ("Gen. 12:1") or topics ("TG Obedience")
+}
+
+// footnotesData is the structure of the footnotes dataset file
+type footnotesData struct {
+	Footnotes []Footnote `json:"footnotes"`
+}
+
+// CrossReference is a footnote or parallel of a verse with the passages it links to
+type CrossReference struct {
+	Verse        string          `json:"verse"` // Verse the cross-reference is attached to
+	Kind         string          `json:"kind"`  // footnote or parallel
+	Marker       string          `json:"marker,omitempty"`
+	Word         string          `json:"word,omitempty"`
+	Note         string          `json:"note,omitempty"`
+	ExpandedNote string          `json:"expandedNote,omitempty"` // Note with its abbreviations spelled out, e.g. "Hebrew: lifted up"
+	Links        []LinkedPassage `json:"links"`
+}
+
+// LinkedPassage is a passage a cross-reference points to. Links that name no
+// scripture passage, such as topical guide entries, have no verses.
+type LinkedPassage struct {
+	Reference string      `json:"reference"`
+	Kind      string      `json:"kind,omitempty"`   // passage, topical_guide, bible_dictionary or jst, when understood
+	Label     string      `json:"label,omitempty"`  // Full form, e.g. "Topical Guide: Obedience" or "Genesis 12:1-4"
+	Target    *LinkTarget `json:"target,omitempty"` // Tool call that follows the link
+	Verses    []Scripture `json:"verses,omitempty"`
+	More      int         `json:"more,omitempty"` // Verses of the passage left out after maxLinkedVerses
+}
+
+// loadFootnotes reads the optional footnotes dataset from SCRIPTURES_DATA_DIR or the
+// state data directory, if present
+func (s *Service) loadFootnotes() {
+	data, _, err := readDataFile(footnotesFile)
+	if err != nil {
+		return
+	}
+	footnotes, err := s.parseFootnotes(data)
+	if err != nil {
+		slog.Warn("Could not parse footnotes dataset", "file", footnotesFile, "error", err)
+		return
+	}
+	s.footnotes = footnotes
+}
+
+// parseFootnotes decodes a footnotes dataset into the footnotes of each
+// verse, keeping only footnotes attached to a single valid verse reference
+func (s *Service) parseFootnotes(data []byte) (map[verseKey][]Footnote, error) {
+	var parsed footnotesData
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return nil, err
+	}
+
+	footnotes := make(map[verseKey][]Footnote)
+	for _, footnote := range parsed.Footnotes {
+		ref, err := s.parseReference(footnote.Reference)
+		if err != nil || ref.Verse != ref.EndVerse || (len(footnote.Links) == 0 && footnote.Note == "") {
+			slog.Warn("Skipping footnote: needs a single verse reference and a note or links", "reference", footnote.Reference, "marker", footnote.Marker, "file", footnotesFile)
+			continue
+		}
+		key := verseKey{book: ref.Book, chapter: ref.Chapter, verse: ref.Verse}
+		footnotes[key] = append(footnotes[key], footnote)
+	}
+	return footnotes, nil
+}
+
+// GetCrossReferences returns the footnotes and parallels of a verse, a verse
+// range or a chapter, with the text of the passages they link to
+func (s *Service) GetCrossReferences(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	query, ok := arguments["query"].(string)
+	if !ok || query == "" {
+		return mcp.NewToolResultError("scripture reference cannot be empty"), nil
+	}
+
+	ref, err := s.parseReference(query)
+	if err != nil {
+		// A whole chapter is also accepted
+		chapterRef, chapterErr := s.parseChapterReference(query)
+		if chapterErr != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid scripture reference: %v", err)), nil
+		}
+		ref = chapterRef
+		ref.Verse = 1
+		ref.EndVerse = math.MaxInt
+	}
+
+	crossReferences := s.crossReferences(ref)
+	structured := CrossReferencesResult{Reference: query, CrossReferences: append([]CrossReference{}, crossReferences...)}
+	if len(crossReferences) == 0 {
+		response := fmt.Sprintf("No cross-references found for '%s'.", query)
+		if len(s.footnotes) == 0 {
+			response += fmt.Sprintf(" Only parallel passages are known; place %s in SCRIPTURES_DATA_DIR or the state data directory to add footnotes.", footnotesFile)
+		}
+		return mcp.NewToolResultStructured(structured, response), nil
+	}
+
+	response := fmt.Sprintf("Cross-references for '%s':\n\n", query)
+	for _, crossReference := range crossReferences {
+		response += crossReference.String()
+	}
+	return mcp.NewToolResultStructured(structured, response), nil
+}
+
+// crossReferences returns the footnotes of each verse of a reference in
+// verse order, followed on each verse by its parallel passage, if any
+func (s *Service) crossReferences(ref *ScriptureReference) []CrossReference {
+	var crossReferences []CrossReference
+	for _, verse := range s.scriptures[ref.Book] {
+		if verse.Chapter != ref.Chapter || verse.Verse < ref.Verse || verse.Verse > ref.EndVerse {
+			continue
+		}
+		label := fmt.Sprintf("%s %d:%d", verse.Book, verse.Chapter, verse.Verse)
+
+		for _, footnote := range s.footnotes[verseKey{book: verse.Book, chapter: verse.Chapter, verse: verse.Verse}] {
+			crossReference := CrossReference{Verse: label, Kind: crossReferenceFootnote, Marker: footnote.Marker, Word: footnote.Word, Note: footnote.Note, ExpandedNote: expandNote(footnote.Note), Links: []LinkedPassage{}}
+			for _, link := range footnote.Links {
+				crossReference.Links = append(crossReference.Links, s.linkedPassage(link))
+			}
+			crossReferences = append(crossReferences, crossReference)
+		}
+
+		single := &ScriptureReference{Book: verse.Book, Chapter: verse.Chapter, Verse: verse.Verse, EndVerse: verse.Verse}
+		for _, pair := range s.parallelPairs(single) {
+			counterpart := pair.SourceReference
+			if counterpart == label {
+				counterpart = pair.Reference
+			}
+			crossReferences = append(crossReferences, CrossReference{Verse: label, Kind: crossReferenceParallel, Note: pair.Set, Links: []LinkedPassage{s.linkedPassage(counterpart)}})
+		}
+	}
+	return crossReferences
+}
+
+// linkedPassage looks up the verses a link names and expands its
+// abbreviations; a link that is not a verse reference has no verses
+func (s *Service) linkedPassage(link string) LinkedPassage {
+	passage := LinkedPassage{Reference: strings.TrimSpace(link)}
+	s.resolveLink(&passage)
+	ref, err := s.parseReference(passage.Reference)
+	if err != nil {
+		return passage
+	}
+	verses := s.getScripturesByReference(ref)
+	if len(verses) > maxLinkedVerses {
+		passage.More = len(verses) - maxLinkedVerses
+		verses = verses[:maxLinkedVerses]
+	}
+	passage.Verses = append(passage.Verses, verses...)
+	return passage
+}
+
+// String renders a cross-reference and its linked passages for text output
+func (c CrossReference) String() string {
+	heading := c.Verse + c.Marker
+	if c.Word != "" {
+		heading += fmt.Sprintf(" \"%s\"", c.Word)
+	}
+	heading += fmt.Sprintf(" (%s)", c.Kind)
+	if c.Note != "" {
+		heading += ": " + c.Note
+	}
+	if c.ExpandedNote != "" {
+		heading += fmt.Sprintf(" (%s)", c.ExpandedNote)
+	}
+
+	lines := []string{heading}
+	for _, link := range c.Links {
+		if len(link.Verses) == 0 {
+			line := "  → " + link.Reference
+			if link.Label != "" && link.Label != link.Reference {
+				line += fmt.Sprintf(" (%s)", link.Label)
+			}
+			lines = append(lines, line)
+			continue
+		}
+		for _, verse := range link.Verses {
+			lines = append(lines, fmt.Sprintf("  → %s %d:%d: %s", verse.Book, verse.Chapter, verse.Verse, verse.Text))
+		}
+		if link.More > 0 {
+			lines = append(lines, fmt.Sprintf("  → ... %d more verses of %s", link.More, link.Reference))
+		}
+	}
+	return strings.Join(lines, "\n") + "\n\n"
+}
@@ -0,0 +1,116 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const testFootnotes = `{"footnotes": [
+	{"reference": "1 Nephi 3:7", "marker": "a", "word": "go", "links": ["Gen. 12:1-4", "TG Obedience"]},
+	{"reference": "1 Ne. 3:7", "marker": "b", "word": "prepare", "note": "IE provide", "links": ["1 Ne. 17:3"]},
+	{"reference": "Moroni 10:4", "marker": "a", "links": ["Alma 32"]},
+	{"reference": "1 Nephi 3", "marker": "a", "links": ["Gen. 12:1"]},
+	{"reference": "1 Nephi 3:7-8", "marker": "a", "links": ["Gen. 12:1"]},
+	{"reference": "1 Nephi 3:8", "marker": "a"}
+]}`
+
+func TestService_ParseFootnotes(t *testing.T) {
+	service := NewService()
+
+	footnotes, err := service.parseFootnotes([]byte(testFootnotes))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// Chapters, ranges and footnotes without a note or links are skipped
+	if len(footnotes) != 2 {
+		t.Errorf("Expected footnotes on 2 verses, got %v", footnotes)
+	}
+	if notes := footnotes[verseKey{book: "1 Nephi", chapter: 3, verse: 7}]; len(notes) != 2 || notes[1].Marker != "b" {
+		t.Errorf("Expected both footnotes of 1 Nephi 3:7 in order, got %+v", notes)
+	}
+
+	if _, err := service.parseFootnotes([]byte(`{"footnotes": {}}`)); err == nil {
+		t.Error("Expected an error for a malformed dataset")
+	}
+}
+
+func TestService_GetCrossReferences(t *testing.T) {
+	service := NewService()
+	footnotes, err := service.parseFootnotes([]byte(testFootnotes))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	service.footnotes = footnotes
+
+	tests := []struct {
+		query    string
+		expected []string // Verse, kind and linked references of each cross-reference
+		contains string
+		isError  bool
+	}{
+		{query: "1 Nephi 3:7", expected: []string{"1 Nephi 3:7 footnote Gen. 12:1-4, TG Obedience", "1 Nephi 3:7 footnote 1 Ne. 17:3"}, contains: `1 Nephi 3:7b "prepare" (footnote): IE provide`},
+		{query: "2 Nephi 12:2", expected: []string{"2 Nephi 12:2 parallel Isaiah 2:2"}, contains: "→ Isaiah 2:2: And it shall come to pass"},
+		{query: "Isaiah 2:2-3", expected: []string{"Isaiah 2:2 parallel 2 Nephi 12:2", "Isaiah 2:3 parallel 2 Nephi 12:3"}},
+		{query: "Moroni 10", expected: []string{"Moroni 10:4 footnote Alma 32"}, contains: "→ Alma 32"},
+		{query: "John 3:16", expected: nil, contains: "No cross-references found"},
+		{query: "John", isError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]any{"query": tt.query}
+			result, err := service.GetCrossReferences(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.IsError != tt.isError {
+				t.Fatalf("Expected IsError %v, got %+v", tt.isError, result)
+			}
+			if tt.isError {
+				return
+			}
+
+			var got []string
+			for _, crossReference := range result.StructuredContent.(CrossReferencesResult).CrossReferences {
+				var links []string
+				for _, link := range crossReference.Links {
+					links = append(links, link.Reference)
+				}
+				got = append(got, crossReference.Verse+" "+crossReference.Kind+" "+strings.Join(links, ", "))
+			}
+			if strings.Join(got, "|") != strings.Join(tt.expected, "|") {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+			if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, tt.contains) {
+				t.Errorf("Expected text containing %q, got:\n%s", tt.contains, text)
+			}
+		})
+	}
+}
+
+func TestService_LinkedPassage(t *testing.T) {
+	service := NewService()
+
+	tests := []struct {
+		link   string
+		verses int
+		more   int
+	}{
+		{link: "Gen. 12:1-4", verses: 4},
+		{link: "Alma 32:1-43", verses: maxLinkedVerses, more: 43 - maxLinkedVerses},
+		{link: "TG Obedience"},
+		{link: "Hezekiah 1:1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.link, func(t *testing.T) {
+			passage := service.linkedPassage(tt.link)
+			if passage.Reference != tt.link || len(passage.Verses) != tt.verses || passage.More != tt.more {
+				t.Errorf("Expected %d verses and %d more, got %+v", tt.verses, tt.more, passage)
+			}
+		})
+	}
+}
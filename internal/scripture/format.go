@@ -0,0 +1,269 @@
+package scripture
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Output formats accepted by the retrieval tools
+const (
+	formatProse      = "prose"
+	formatPoetry     = "poetry"
+	formatSpeech     = "speech"
+	formatAccessible = "accessible"
+)
+
+// OutputFormats lists the accepted values of the format argument of the
+// retrieval tools, for their input schemas
+var OutputFormats = []string{formatProse, formatPoetry, formatSpeech, formatAccessible}
+
+// accessibleLineWidth keeps accessible output within a 40-cell braille display
+const accessibleLineWidth = 40
+
+// poeticBooks are books printed as poetry in modern editions
+var poeticBooks = map[string]bool{
+	"Job":            true,
+	"Psalms":         true,
+	"Proverbs":       true,
+	"Ecclesiastes":   true,
+	"Solomon's Song": true,
+	"Isaiah":         true,
+	"Lamentations":   true,
+	"Hosea":          true,
+	"Joel":           true,
+	"Amos":           true,
+	"Micah":          true,
+	"Nahum":          true,
+	"Habakkuk":       true,
+	"Zephaniah":      true,
+	"Obadiah":        true,
+}
+
+// poeticChapters are the Book of Mormon chapters quoting Isaiah
+var poeticChapters = map[string][][2]int{
+	"1 Nephi": {{20, 21}},
+	"2 Nephi": {{7, 8}, {12, 24}},
+	"Mosiah":  {{14, 14}},
+	"3 Nephi": {{22, 22}},
+}
+
+// parseFormat reads the optional format argument, defaulting to prose
+func parseFormat(arguments map[string]any) (string, error) {
+	format, ok := arguments["format"].(string)
+	if !ok || format == "" {
+		return formatProse, nil
+	}
+	format = strings.ToLower(strings.TrimSpace(format))
+	for _, allowed := range OutputFormats {
+		if format == allowed {
+			return format, nil
+		}
+	}
+	return "", fmt.Errorf("unknown format '%s' (expected one of: %s)", format, strings.Join(OutputFormats, ", "))
+}
+
+// isPoetic reports whether a chapter is poetry and should be rendered as lines
+func isPoetic(book string, chapter int) bool {
+	if poeticBooks[book] {
+		return true
+	}
+	for _, span := range poeticChapters[book] {
+		if chapter >= span[0] && chapter <= span[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// poeticLines splits a verse into poetic lines after clause-ending punctuation,
+// which in the KJV usually separates the halves of a parallelism
+func poeticLines(text string) []string {
+	var lines []string
+	start := 0
+	for i, r := range text {
+		if r != ';' && r != ':' && r != '?' && r != '!' {
+			continue
+		}
+		if line := strings.TrimSpace(text[start : i+1]); line != "" {
+			lines = append(lines, line)
+		}
+		start = i + 1
+	}
+	if rest := strings.TrimSpace(text[start:]); rest != "" {
+		lines = append(lines, rest)
+	}
+	return lines
+}
+
+// formatVerseText renders a verse's text in the requested format. indent is
+// prefixed to every line after the first when the verse spans several lines.
+func formatVerseText(scripture Scripture, format, indent string) string {
+	if format == formatPoetry && isPoetic(scripture.Book, scripture.Chapter) {
+		return strings.Join(poeticLines(scripture.Text), "\n"+indent)
+	}
+	return scripture.Text
+}
+
+// poeticLineMetadata returns the poetic lines of each poetic verse, keyed by reference
+func poeticLineMetadata(scriptures []Scripture) map[string][]string {
+	lines := make(map[string][]string)
+	for _, scripture := range scriptures {
+		if isPoetic(scripture.Book, scripture.Chapter) {
+			lines[scripture.Reference] = poeticLines(scripture.Text)
+		}
+	}
+	return lines
+}
+
+// speechAbbreviations expands abbreviations a text-to-speech engine would spell out
+var speechAbbreviations = strings.NewReplacer(
+	"D&C", "Doctrine and Covenants",
+	"JS—H", "Joseph Smith—History",
+	"JS—M", "Joseph Smith—Matthew",
+	"&c.", "et cetera.",
+)
+
+// speechPauses turns dashes into comma pauses
+var speechPauses = strings.NewReplacer("—,", ", ", "—", ", ")
+
+// spokenOrdinals replaces the leading number of book names such as "1 Nephi"
+var spokenOrdinals = map[string]string{"1": "First", "2": "Second", "3": "Third", "4": "Fourth"}
+
+// speechPassage renders verses from one chapter for reading aloud: a spoken
+// heading, no verse numbers, and a paragraph pause at each outline section
+func speechPassage(heading string, scriptures []Scripture) string {
+	pauses := make(map[int]bool)
+	sections, _ := outlineSections(scriptures)
+	for _, section := range sections {
+		pauses[section.StartVerse] = true
+	}
+
+	var b strings.Builder
+	b.WriteString(speechText(heading) + ".\n\n")
+	for i, scripture := range scriptures {
+		if i > 0 {
+			if pauses[scripture.Verse] {
+				b.WriteString("\n\n")
+			} else {
+				b.WriteString(" ")
+			}
+		}
+		b.WriteString(speechText(scripture.Text))
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// speechText expands abbreviations, turns dashes into pauses and lowercases
+// words printed in capitals (LORD) so they are not spelled out letter by letter
+func speechText(text string) string {
+	text = speechAbbreviations.Replace(text)
+	text = speechPauses.Replace(text)
+
+	words := strings.Fields(text)
+	for i, word := range words {
+		words[i] = speechWord(word)
+	}
+	return strings.Join(words, " ")
+}
+
+// speechWord title-cases an all-capitals word of two or more letters
+func speechWord(word string) string {
+	letters := 0
+	for _, r := range word {
+		if unicode.IsLower(r) {
+			return word
+		}
+		if unicode.IsUpper(r) {
+			letters++
+		}
+	}
+	if letters < 2 {
+		return word
+	}
+	runes := []rune(strings.ToLower(word))
+	for i, r := range runes {
+		if unicode.IsLetter(r) {
+			runes[i] = unicode.ToUpper(r)
+			break
+		}
+	}
+	return string(runes)
+}
+
+// spokenBook spells out the leading number of a book name ("1 Nephi" becomes "First Nephi")
+func spokenBook(book string) string {
+	if number, rest, found := strings.Cut(book, " "); found {
+		if ordinal, ok := spokenOrdinals[number]; ok {
+			return ordinal + " " + rest
+		}
+	}
+	return book
+}
+
+// spokenChapter names a chapter for reading aloud ("Alma, chapter 32")
+func spokenChapter(book string, chapter int) string {
+	unit := "chapter"
+	if book == doctrineAndCovenants {
+		unit = "section"
+	}
+	return fmt.Sprintf("%s, %s %d", spokenBook(book), unit, chapter)
+}
+
+// spokenReference names a run of verses from one chapter for reading aloud
+// ("Alma, chapter 32, verses 17 through 27")
+func spokenReference(scriptures []Scripture) string {
+	first, last := scriptures[0], scriptures[len(scriptures)-1]
+	heading := spokenChapter(first.Book, first.Chapter)
+	if first.Verse == last.Verse {
+		return fmt.Sprintf("%s, verse %d", heading, first.Verse)
+	}
+	return fmt.Sprintf("%s, verses %d through %d", heading, first.Verse, last.Verse)
+}
+
+// accessiblePassage renders verses for screen readers and braille displays:
+// plain labeled lines ("Verse 7:") wrapped to a short width, with no list
+// markers or separator symbols. nav adds previous/next chapter lines when set.
+func accessiblePassage(heading string, scriptures []Scripture, nav *ChapterNavigation) string {
+	var b strings.Builder
+	b.WriteString(heading + "\n\n")
+	for _, scripture := range scriptures {
+		fmt.Fprintf(&b, "Verse %d:\n", scripture.Verse)
+		for _, line := range wrapLine(scripture.Text, accessibleLineWidth) {
+			b.WriteString(line + "\n")
+		}
+		b.WriteString("\n")
+	}
+	if nav != nil {
+		if nav.Previous != "" {
+			fmt.Fprintf(&b, "Previous chapter: %s\n", nav.Previous)
+		}
+		if nav.Next != "" {
+			fmt.Fprintf(&b, "Next chapter: %s\n", nav.Next)
+		}
+	}
+	return b.String()
+}
+
+// wrapLine breaks text into lines of at most width characters at word
+// boundaries. A single word longer than width gets a line of its own.
+func wrapLine(text string, width int) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		switch {
+		case line == "":
+			line = word
+		case len([]rune(line))+1+len([]rune(word)) <= width:
+			line += " " + word
+		default:
+			lines = append(lines, line)
+			line = word
+		}
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
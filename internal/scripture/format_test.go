@@ -0,0 +1,277 @@
+package scripture
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		name        string
+		arguments   map[string]any
+		expected    string
+		expectError bool
+	}{
+		{name: "Default", arguments: map[string]any{}, expected: formatProse},
+		{name: "Poetry", arguments: map[string]any{"format": "Poetry"}, expected: formatPoetry},
+		{name: "Unknown", arguments: map[string]any{"format": "limerick"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, err := parseFormat(tt.arguments)
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if format != tt.expected {
+				t.Errorf("Expected format '%s', got '%s'", tt.expected, format)
+			}
+		})
+	}
+}
+
+func TestIsPoetic(t *testing.T) {
+	tests := []struct {
+		book     string
+		chapter  int
+		expected bool
+	}{
+		{"Psalms", 23, true},
+		{"Isaiah", 53, true},
+		{"2 Nephi", 12, true},
+		{"2 Nephi", 25, false},
+		{"Mosiah", 14, true},
+		{"Alma", 32, false},
+	}
+
+	for _, tt := range tests {
+		if got := isPoetic(tt.book, tt.chapter); got != tt.expected {
+			t.Errorf("isPoetic(%s, %d) = %v, expected %v", tt.book, tt.chapter, got, tt.expected)
+		}
+	}
+}
+
+func TestPoeticLines(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected []string
+	}{
+		{
+			text:     "The LORD is my shepherd; I shall not want.",
+			expected: []string{"The LORD is my shepherd;", "I shall not want."},
+		},
+		{
+			text:     "He restoreth my soul: he leadeth me in the paths of righteousness for his name's sake.",
+			expected: []string{"He restoreth my soul:", "he leadeth me in the paths of righteousness for his name's sake."},
+		},
+		{
+			text:     "Praise ye the LORD.",
+			expected: []string{"Praise ye the LORD."},
+		},
+	}
+
+	for _, tt := range tests {
+		if got := poeticLines(tt.text); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("poeticLines(%q) = %q, expected %q", tt.text, got, tt.expected)
+		}
+	}
+}
+
+func TestService_GetChapter_PoetryFormat(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+
+	// Add test data
+	service.scriptures["Psalms"] = []Scripture{
+		{Book: "Psalms", Chapter: 23, Verse: 1, Text: "The LORD is my shepherd; I shall not want.", Reference: "Psalms 23:1"},
+	}
+	service.scriptures["Alma"] = []Scripture{
+		{Book: "Alma", Chapter: 32, Verse: 21, Text: "And now as I said concerning faith; faith is not to have a perfect knowledge of things", Reference: "Alma 32:21"},
+	}
+
+	tests := []struct {
+		name          string
+		arguments     map[string]interface{}
+		expectError   bool
+		shouldContain string
+	}{
+		{
+			name:          "Poetic chapter",
+			arguments:     map[string]interface{}{"query": "Psalms 23", "format": "poetry"},
+			shouldContain: "1. The LORD is my shepherd;\n   I shall not want.",
+		},
+		{
+			name:          "Prose chapter unchanged",
+			arguments:     map[string]interface{}{"query": "Alma 32", "format": "poetry"},
+			shouldContain: "21. And now as I said concerning faith; faith is not",
+		},
+		{
+			name:          "Default prose",
+			arguments:     map[string]interface{}{"query": "Psalms 23"},
+			shouldContain: "1. The LORD is my shepherd; I shall not want.",
+		},
+		{
+			name:        "Unknown format",
+			arguments:   map[string]interface{}{"query": "Psalms 23", "format": "limerick"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+			result, err := service.GetChapter(context.Background(), request)
+
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			if tt.expectError {
+				if !result.IsError {
+					t.Error("Expected error result but got success")
+				}
+				return
+			}
+
+			text := result.Content[0].(mcp.TextContent).Text
+			if !strings.Contains(text, tt.shouldContain) {
+				t.Errorf("Expected output to contain %q, got:\n%s", tt.shouldContain, text)
+			}
+		})
+	}
+}
+
+func TestSpeechText(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected string
+	}{
+		{"The LORD is my shepherd;", "The Lord is my shepherd;"},
+		{"I AM THAT I AM", "I Am That I Am"},
+		{"a just man—for he taught me", "a just man, for he taught me"},
+		{"in the thing—, and", "in the thing, and"},
+		{"See D&C 4:2", "See Doctrine and Covenants 4:2"},
+		{"O Lord, I am", "O Lord, I am"},
+	}
+
+	for _, tt := range tests {
+		if got := speechText(tt.text); got != tt.expected {
+			t.Errorf("speechText(%q) = %q, expected %q", tt.text, got, tt.expected)
+		}
+	}
+}
+
+func TestSpokenReference(t *testing.T) {
+	tests := []struct {
+		scriptures []Scripture
+		expected   string
+	}{
+		{[]Scripture{{Book: "1 Nephi", Chapter: 3, Verse: 7}}, "First Nephi, chapter 3, verse 7"},
+		{[]Scripture{{Book: "Alma", Chapter: 32, Verse: 17}, {Book: "Alma", Chapter: 32, Verse: 27}}, "Alma, chapter 32, verses 17 through 27"},
+		{[]Scripture{{Book: doctrineAndCovenants, Chapter: 4, Verse: 2}}, "Doctrine and Covenants, section 4, verse 2"},
+	}
+
+	for _, tt := range tests {
+		if got := spokenReference(tt.scriptures); got != tt.expected {
+			t.Errorf("spokenReference(%v) = %q, expected %q", tt.scriptures, got, tt.expected)
+		}
+	}
+}
+
+func TestService_GetScripture_SpeechFormat(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+
+	// Add test data
+	service.scriptures["Psalms"] = []Scripture{
+		{Book: "Psalms", Chapter: 23, Verse: 1, Text: "The LORD is my shepherd; I shall not want.", Reference: "Psalms 23:1"},
+		{Book: "Psalms", Chapter: 23, Verse: 2, Text: "He maketh me to lie down in green pastures:", Reference: "Psalms 23:2"},
+	}
+
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: map[string]interface{}{"query": "Psalms 23:1-2", "format": "speech"},
+		},
+	}
+	result, err := service.GetScripture(context.Background(), request)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	text := result.Content[0].(mcp.TextContent).Text
+	expected := "Psalms, chapter 23, verses 1 through 2.\n\nThe Lord is my shepherd; I shall not want. He maketh me to lie down in green pastures:\n"
+	if text != expected {
+		t.Errorf("Expected %q, got %q", expected, text)
+	}
+}
+
+func TestWrapLine(t *testing.T) {
+	tests := []struct {
+		text     string
+		width    int
+		expected []string
+	}{
+		{"Jesus wept.", 40, []string{"Jesus wept."}},
+		{"Mercy unto you, and peace, and love, be multiplied.", 20, []string{"Mercy unto you, and", "peace, and love, be", "multiplied."}},
+		{"Mahershalalhashbaz is long", 10, []string{"Mahershalalhashbaz", "is long"}},
+		{"", 10, nil},
+	}
+
+	for _, tt := range tests {
+		if got := wrapLine(tt.text, tt.width); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("wrapLine(%q, %d) = %q, expected %q", tt.text, tt.width, got, tt.expected)
+		}
+	}
+}
+
+func TestService_GetChapter_AccessibleFormat(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+
+	// Add test data
+	service.scriptures["John"] = []Scripture{
+		{Book: "John", Chapter: 11, Verse: 35, Text: "Jesus wept.", Reference: "John 11:35"},
+	}
+
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: map[string]interface{}{"query": "John 11", "format": "accessible"},
+		},
+	}
+	result, err := service.GetChapter(context.Background(), request)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	text := result.Content[0].(mcp.TextContent).Text
+	if !strings.HasPrefix(text, "John chapter 11\n\nVerse 35:\nJesus wept.\n") {
+		t.Errorf("Expected labeled verse lines, got:\n%s", text)
+	}
+	for _, symbol := range []string{"|", "#", "*", "35."} {
+		if strings.Contains(text, symbol) {
+			t.Errorf("Expected no %q in accessible output, got:\n%s", symbol, text)
+		}
+	}
+	for _, line := range strings.Split(text, "\n") {
+		if len(line) > accessibleLineWidth {
+			t.Errorf("Line longer than %d characters: %q", accessibleLineWidth, line)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// bookTitle is the title material printed before a book's first chapter
+type bookTitle struct {
+	FullTitle string // e.g. "The First Book of Nephi"
+	Heading   string // The book's own heading, as in the Book of Mormon
+}
+
+// recordHeadings remembers the titles and chapter headings of a decoded book
+func (s *Service) recordHeadings(book Book) {
+	if book.FullTitle != "" || book.Heading != "" {
+		if s.bookTitles == nil {
+			s.bookTitles = make(map[string]bookTitle)
+		}
+		s.bookTitles[book.Book] = bookTitle{FullTitle: strings.TrimSpace(book.FullTitle), Heading: strings.TrimSpace(book.Heading)}
+	}
+	for _, chapter := range book.Chapters {
+		s.recordChapterHeading(book.Book, chapter.Chapter, chapter.Heading)
+	}
+}
+
+// recordChapterHeading remembers the heading (summary) of a chapter or section, if it has one
+func (s *Service) recordChapterHeading(book string, chapter int, heading string) {
+	heading = strings.TrimSpace(heading)
+	if heading == "" {
+		return
+	}
+	if s.chapterHeadings == nil {
+		s.chapterHeadings = make(map[chapterKey]string)
+	}
+	s.chapterHeadings[chapterKey{book: book, chapter: chapter}] = heading
+}
+
+// chapterSummary returns the headings of a chapter. The book's title and
+// heading belong to its first chapter, where they are printed.
+func (s *Service) chapterSummary(book string, chapter int) ChapterSummaryResult {
+	summary := ChapterSummaryResult{Book: book, Chapter: chapter, Heading: s.chapterHeadings[chapterKey{book: book, chapter: chapter}]}
+	if chapter == 1 {
+		title := s.bookTitles[book]
+		summary.BookTitle, summary.BookHeading = title.FullTitle, title.Heading
+	}
+	return summary
+}
+
+// String renders the headings as printed above the chapter's verses, or ""
+// when there are none
+func (c ChapterSummaryResult) String() string {
+	var parts []string
+	for _, part := range []string{c.BookTitle, c.BookHeading, c.Heading} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// GetChapterSummary returns a chapter's heading (its summary) without the verses
+func (s *Service) GetChapterSummary(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	query, ok := arguments["query"].(string)
+	if !ok || query == "" {
+		return mcp.NewToolResultError("chapter reference cannot be empty"), nil
+	}
+
+	ref, err := s.parseChapterReference(query)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid chapter reference: %v", err)), nil
+	}
+
+	if len(s.getChapter(ref.Book, ref.Chapter)) == 0 {
+		structured := ChapterSummaryResult{Book: ref.Book, Chapter: ref.Chapter}
+		return mcp.NewToolResultStructured(structured, fmt.Sprintf("Chapter '%s' not found.", query)), nil
+	}
+
+	summary := s.chapterSummary(ref.Book, ref.Chapter)
+	response := fmt.Sprintf("%s Chapter %d\n\n", ref.Book, ref.Chapter)
+	switch {
+	case summary.Heading == "" && summary.String() != "":
+		response += summary.String() + "\n\nThe loaded data has no chapter heading for this chapter."
+	case summary.Heading == "":
+		response += "The loaded data has no chapter heading for this chapter."
+	default:
+		response += summary.String()
+	}
+	return mcp.NewToolResultStructured(summary, response), nil
+}
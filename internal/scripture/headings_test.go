@@ -0,0 +1,81 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestService_ChapterHeadings_Loaded(t *testing.T) {
+	data := `{"books": [{"book": "Enos", "full_title": "The Book of Enos", "heading": "Enos prays mightily.", "chapters": [
+		{"chapter": 1, "heading": " Enos prays and receives a remission of his sins. ", "verses": [{"verse": 1, "text": "Behold", "reference": "Enos 1:1"}]}]}],
+		"sections": [{"section": 4, "heading": "Revelation given to the Prophet's father.", "verses": [{"verse": 1, "text": "Now behold", "reference": "D&C 4:1"}]}]}`
+	service := &Service{scriptures: make(map[string][]Scripture)}
+	service.parseAndStore(strings.NewReader(data), "book-of-mormon.json")
+
+	expected := ChapterSummaryResult{Book: "Enos", Chapter: 1, BookTitle: "The Book of Enos", BookHeading: "Enos prays mightily.", Heading: "Enos prays and receives a remission of his sins."}
+	if summary := service.chapterSummary("Enos", 1); summary != expected {
+		t.Errorf("Expected %+v, got %+v", expected, summary)
+	}
+	if heading := service.chapterSummary(doctrineAndCovenants, 4).Heading; heading != "Revelation given to the Prophet's father." {
+		t.Errorf("Expected the section heading, got %q", heading)
+	}
+}
+
+func TestService_GetChapterSummary(t *testing.T) {
+	service := NewService()
+
+	tests := []struct {
+		query       string
+		heading     string // Expected chapter heading prefix
+		bookHeading bool   // Expect the book's title and heading
+		contains    string
+		isError     bool
+	}{
+		{query: "Alma 5", heading: "The words which Alma, the High Priest", contains: "The words which Alma"},
+		{query: "1 Ne 1", bookHeading: true, contains: "The loaded data has no chapter heading"},
+		{query: "1 Nephi 2", contains: "The loaded data has no chapter heading"},
+		{query: "John 3", contains: "The loaded data has no chapter heading"},
+		{query: "John 99", contains: "not found"},
+		{query: "John", isError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]any{"query": tt.query}
+			result, err := service.GetChapterSummary(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.IsError != tt.isError {
+				t.Fatalf("Expected IsError %v, got %+v", tt.isError, result)
+			}
+			if tt.isError {
+				return
+			}
+
+			summary := result.StructuredContent.(ChapterSummaryResult)
+			if !strings.HasPrefix(summary.Heading, tt.heading) || (tt.heading == "") != (summary.Heading == "") {
+				t.Errorf("Expected heading starting %q, got %q", tt.heading, summary.Heading)
+			}
+			if (summary.BookTitle != "" && summary.BookHeading != "") != tt.bookHeading {
+				t.Errorf("Expected book title and heading %v, got %+v", tt.bookHeading, summary)
+			}
+			if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, tt.contains) {
+				t.Errorf("Expected text containing %q, got %q", tt.contains, text)
+			}
+		})
+	}
+
+	// get_chapter prints the headings before the verses
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]any{"query": "Alma 5"}
+	result, _ := service.GetChapter(context.Background(), request)
+	text := result.Content[0].(mcp.TextContent).Text
+	if !strings.HasPrefix(text, "Alma Chapter 5\n\nThe words which Alma") || result.StructuredContent.(ChapterResult).Heading == "" {
+		t.Errorf("Expected the chapter heading in get_chapter, got %q", text[:min(len(text), 120)])
+	}
+}
@@ -0,0 +1,124 @@
+package scripture
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// defaultHighlightMarker wraps matched terms when no delimiters are given,
+// rendering them bold in markdown
+const defaultHighlightMarker = "**"
+
+// highlighter wraps every case-insensitive occurrence of a set of terms in
+// a verse's text with delimiters, so a reader can see why the verse matched
+type highlighter struct {
+	matcher    *matcher
+	lengths    []int // Length in bytes of each lowercased term
+	wholeWords bool  // Only highlight occurrences that are whole words
+	open       string
+	close      string
+}
+
+// newHighlighter returns a highlighter for the terms, or nil if none can match
+func newHighlighter(terms []string, wholeWords bool, open, close string) *highlighter {
+	var patterns []string
+	for _, term := range terms {
+		if term = strings.ToLower(strings.TrimSpace(term)); term != "" {
+			patterns = append(patterns, term)
+		}
+	}
+	if len(patterns) == 0 {
+		return nil
+	}
+
+	h := &highlighter{matcher: newMatcher(patterns), wholeWords: wholeWords, open: open, close: close}
+	for _, pattern := range patterns {
+		h.lengths = append(h.lengths, len(pattern))
+	}
+	return h
+}
+
+// highlightTerms returns the terms to highlight for a search: the words of a
+// word-mode or relevance search, the terms of a boolean query, and otherwise
+// the query as a whole, split into words by the tokenizer that searched.
+// Relevance search matches whole words only.
+func highlightTerms(tokenizer Tokenizer, query, order, mode string, node queryNode) ([]string, bool) {
+	switch {
+	case order == sortRelevance:
+		return tokenizer.Words(query), true
+	case node != nil:
+		return node.terms(), false
+	case mode != modePhrase:
+		return tokenizer.Words(query), false
+	default:
+		return []string{query}, false
+	}
+}
+
+// apply wraps the occurrences of the terms in text. Overlapping and adjacent
+// occurrences are merged into a single highlighted span.
+func (h *highlighter) apply(text string) string {
+	lower, offsets := lowerWithOffsets(text)
+
+	// Collect spans in the lowercased text, ordered by end offset; a span
+	// that starts before the previous one ends extends it
+	type span struct{ start, end int }
+	var spans []span
+	h.matcher.scan(lower, func(id, end int) {
+		start := end - h.lengths[id]
+		if h.wholeWords && (wordRuneBefore(lower, start) || wordRuneAt(lower, end)) {
+			return
+		}
+		for len(spans) > 0 && start <= spans[len(spans)-1].end {
+			start = min(start, spans[len(spans)-1].start)
+			spans = spans[:len(spans)-1]
+		}
+		spans = append(spans, span{start, end})
+	})
+	if len(spans) == 0 {
+		return text
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, s := range spans {
+		start, end := offsets[s.start], offsets[s.end]
+		b.WriteString(text[last:start])
+		b.WriteString(h.open)
+		b.WriteString(text[start:end])
+		b.WriteString(h.close)
+		last = end
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// lowerWithOffsets lowercases text rune by rune, returning for each byte
+// offset of the result (and its end) the matching offset in text, since
+// lowercasing can change a rune's encoded length
+func lowerWithOffsets(text string) (string, []int) {
+	var b strings.Builder
+	offsets := make([]int, 0, len(text)+1)
+	for i, r := range text {
+		before := b.Len()
+		b.WriteRune(unicode.ToLower(r))
+		for range b.Len() - before {
+			offsets = append(offsets, i)
+		}
+	}
+	offsets = append(offsets, len(text))
+	return b.String(), offsets
+}
+
+// wordRuneBefore reports whether a letter or digit ends text[:offset]
+func wordRuneBefore(text string, offset int) bool {
+	r, size := utf8.DecodeLastRuneInString(text[:offset])
+	return size > 0 && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+// wordRuneAt reports whether a letter or digit starts text[offset:]
+func wordRuneAt(text string, offset int) bool {
+	r, size := utf8.DecodeRuneInString(text[offset:])
+	return size > 0 && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
@@ -0,0 +1,147 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestHighlighter_Apply(t *testing.T) {
+	tests := []struct {
+		name       string
+		terms      []string
+		wholeWords bool
+		text       string
+		expected   string
+	}{
+		{
+			name:     "Phrase, ignoring case",
+			terms:    []string{"Still Small Voice"},
+			text:     "after the fire a still small voice.",
+			expected: "after the fire a **still small voice**.",
+		},
+		{
+			name:     "Every occurrence of every term",
+			terms:    []string{"faith", "hope"},
+			text:     "Faith, hope, and faith again",
+			expected: "**Faith**, **hope**, and **faith** again",
+		},
+		{
+			name:     "Part of a word, as search matches it",
+			terms:    []string{"faith"},
+			text:     "a faithful servant",
+			expected: "a **faith**ful servant",
+		},
+		{
+			name:       "Whole words only",
+			terms:      []string{"faith"},
+			wholeWords: true,
+			text:       "a faithful servant of faith",
+			expected:   "a faithful servant of **faith**",
+		},
+		{
+			name:     "Overlapping terms merge",
+			terms:    []string{"small voice", "still small"},
+			text:     "a still small voice",
+			expected: "a **still small voice**",
+		},
+		{
+			name:     "Text whose length changes when lowercased",
+			terms:    []string{"lord"},
+			text:     "ȺLORD Ⱥ lord",
+			expected: "Ⱥ**LORD** Ⱥ **lord**",
+		},
+		{
+			name:     "No match",
+			terms:    []string{"mercy"},
+			text:     "and justice",
+			expected: "and justice",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newHighlighter(tt.terms, tt.wholeWords, "**", "**")
+			if got := h.apply(tt.text); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+
+	if h := newHighlighter([]string{" ", ""}, false, "**", "**"); h != nil {
+		t.Error("Expected no highlighter for blank terms")
+	}
+}
+
+func TestService_SearchScriptures_Highlight(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	service.recordBook("Moroni", "Book of Mormon")
+	service.scriptures["Moroni"] = []Scripture{
+		{Book: "Moroni", Chapter: 7, Verse: 42, Text: "if a man have faith he must needs have hope", Reference: "Moroni 7:42"},
+		{Book: "Moroni", Chapter: 7, Verse: 47, Text: "But charity is the pure love of Christ", Reference: "Moroni 7:47"},
+	}
+
+	tests := []struct {
+		name          string
+		arguments     map[string]interface{}
+		shouldContain string
+	}{
+		{
+			name:          "Off by default",
+			arguments:     map[string]interface{}{"query": "pure love"},
+			shouldContain: "- But charity is the pure love of Christ",
+		},
+		{
+			name:          "Phrase",
+			arguments:     map[string]interface{}{"query": "pure love", "highlight": true},
+			shouldContain: "- But charity is the **pure love** of Christ",
+		},
+		{
+			name:          "Custom delimiters",
+			arguments:     map[string]interface{}{"query": "pure love", "highlight": true, "highlight_open": "<mark>", "highlight_close": "</mark>"},
+			shouldContain: "- But charity is the <mark>pure love</mark> of Christ",
+		},
+		{
+			name:          "One delimiter for both sides",
+			arguments:     map[string]interface{}{"query": "pure love", "highlight": true, "highlight_open": "__"},
+			shouldContain: "- But charity is the __pure love__ of Christ",
+		},
+		{
+			name:          "Word mode",
+			arguments:     map[string]interface{}{"query": "hope faith", "mode": "all_words", "highlight": true},
+			shouldContain: "- if a man have **faith** he must needs have **hope**",
+		},
+		{
+			name:          "Boolean query",
+			arguments:     map[string]interface{}{"query": "charity OR hope", "highlight": true},
+			shouldContain: "- But **charity** is the pure love of Christ",
+		},
+		{
+			name:          "Relevance",
+			arguments:     map[string]interface{}{"query": "love christ", "sort": "relevance", "highlight": true},
+			shouldContain: "- But charity is the pure **love** of **Christ**",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+			result, err := service.SearchScriptures(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			text := result.Content[0].(mcp.TextContent).Text
+			if !strings.Contains(text, tt.shouldContain) {
+				t.Errorf("Expected output to contain %q, got:\n%s", tt.shouldContain, text)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package scripture
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+)
+
+// searchIndex is an inverted index from each lowercased word to the verses
+// containing it. Verse IDs are positions in canonical reading order, so
+// postings are ascending and results come out in the same order as a linear scan.
+type searchIndex struct {
+	verses     []Scripture          // Every verse in canonical order, indexed by verse ID
+	texts      []string             // Lowercased text of each verse
+	books      []indexedBook        // Each book's range of verse IDs, in canonical order
+	tokenizer  Tokenizer            // Splits verses and queries into the indexed words
+	words      map[string][]posting // Word to postings in ascending verse ID order
+	lengths    []int                // Number of words in each verse
+	totalWords int
+
+	normsOnce sync.Once
+	norms     []float64 // Length of each verse's TF-IDF vector, for find_similar_verses
+}
+
+// posting records how many times a word occurs in a verse
+type posting struct {
+	id    int32
+	count int32
+}
+
+// indexedBook is the range of verse IDs [start, end) belonging to a book
+type indexedBook struct {
+	nameLower  string
+	start, end int
+}
+
+// buildIndex indexes every loaded verse. It runs once loading is done;
+// services assembled by hand (as in tests) search by linear scan instead.
+// The word postings are read from the index cache when it matches the
+// loaded data, and saved to it otherwise.
+func (s *Service) buildIndex() {
+	path, ok := s.indexCachePath()
+	if !ok {
+		s.index = s.newSearchIndex()
+		return
+	}
+
+	index := s.newIndexedVerses()
+	fingerprint := index.fingerprint()
+	if err := index.loadCache(path, fingerprint); err == nil {
+		s.index = index
+		return
+	}
+	index.tokenize()
+	if err := index.saveCache(path, fingerprint); err != nil {
+		slog.Warn("Could not save the search index cache", "path", path, "error", err)
+	}
+	s.index = index
+}
+
+// newSearchIndex indexes the loaded verses in canonical order
+func (s *Service) newSearchIndex() *searchIndex {
+	index := s.newIndexedVerses()
+	index.tokenize()
+	return index
+}
+
+// newIndexedVerses lays out the loaded verses in canonical order, with
+// their lowercased text and each book's range, ready to be tokenized
+func (s *Service) newIndexedVerses() *searchIndex {
+	index := &searchIndex{tokenizer: s.tokenizer()}
+	for _, book := range s.orderedBooks() {
+		indexed := indexedBook{nameLower: strings.ToLower(book), start: len(index.verses)}
+		for _, verse := range s.scriptures[book] {
+			index.verses = append(index.verses, verse)
+			index.texts = append(index.texts, strings.ToLower(verse.Text))
+		}
+		indexed.end = len(index.verses)
+		index.books = append(index.books, indexed)
+	}
+	return index
+}
+
+// tokenize builds the word postings and lengths of the indexed verses
+func (idx *searchIndex) tokenize() {
+	idx.words = make(map[string][]posting)
+	idx.lengths = make([]int, len(idx.verses))
+	idx.totalWords = 0
+	for i, verse := range idx.verses {
+		id := int32(i)
+		words := idx.tokenizer.Words(verse.Text)
+		for _, word := range words {
+			postings := idx.words[word]
+			if n := len(postings); n > 0 && postings[n-1].id == id {
+				postings[n-1].count++
+			} else {
+				idx.words[word] = append(postings, posting{id: id, count: 1})
+			}
+		}
+		idx.lengths[i] = len(words)
+		idx.totalWords += len(words)
+	}
+}
+
+// search returns every verse matching the lowercased query, with the same
+// substring semantics as matchesQuery, in canonical order.
+//
+// Any text containing the query contains each of the query's words as part of
+// one of its own words, so candidates are the verses that, for every query
+// word, have some indexed word containing it. Candidates are then checked
+// against their lowercased text; verses of books whose name matches are
+// always included.
+func (idx *searchIndex) search(ctx context.Context, queryLower string) []Scripture {
+	candidates, ok := idx.candidates(queryLower)
+
+	var results []Scripture
+	next := 0
+	for _, book := range idx.books {
+		if ctx.Err() != nil {
+			break // Out of time; keep the matches found so far
+		}
+		if strings.Contains(book.nameLower, queryLower) {
+			results = append(results, idx.verses[book.start:book.end]...)
+			continue
+		}
+		if !ok {
+			for id := book.start; id < book.end; id++ {
+				if strings.Contains(idx.texts[id], queryLower) {
+					results = append(results, idx.verses[id])
+				}
+			}
+			continue
+		}
+		for next < len(candidates) && int(candidates[next]) < book.start {
+			next++
+		}
+		for ; next < len(candidates) && int(candidates[next]) < book.end; next++ {
+			// The book name does not match, so only the text can
+			if strings.Contains(idx.texts[candidates[next]], queryLower) {
+				results = append(results, idx.verses[candidates[next]])
+			}
+		}
+	}
+	return results
+}
+
+// candidates returns the ascending IDs of verses that may match the query, or
+// false if the query has no words to look up (such as punctuation alone)
+func (idx *searchIndex) candidates(queryLower string) ([]int32, bool) {
+	queryWords := idx.tokenizer.Words(queryLower)
+	if len(queryWords) == 0 {
+		return nil, false
+	}
+
+	// Mark, for each query word, every verse with a word containing it,
+	// scanning the vocabulary once for all query words together
+	marked := make([][]bool, len(queryWords))
+	for i := range marked {
+		marked[i] = make([]bool, len(idx.verses))
+	}
+	m := newMatcher(queryWords)
+	contained := make([]bool, len(queryWords))
+	for word, postings := range idx.words {
+		clear(contained)
+		m.scan(word, func(i, end int) {
+			if contained[i] {
+				return
+			}
+			contained[i] = true
+			for _, p := range postings {
+				marked[i][p.id] = true
+			}
+		})
+	}
+
+	var candidates []int32
+	for id, ok := range marked[0] {
+		if ok {
+			candidates = append(candidates, int32(id))
+		}
+	}
+	for _, verses := range marked[1:] {
+		kept := candidates[:0]
+		for _, id := range candidates {
+			if verses[id] {
+				kept = append(kept, id)
+			}
+		}
+		candidates = kept
+	}
+	return candidates, true
+}
@@ -0,0 +1,44 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestSearchIndex_MatchesLinearScan(t *testing.T) {
+	service := NewService()
+	if service.index == nil {
+		t.Fatal("Expected NewService to build the search index")
+	}
+	linear := &Service{scriptures: service.scriptures, bookOrder: service.bookOrder, bookCollections: service.bookCollections}
+
+	queries := []string{
+		"faith",
+		"still small voice",
+		"charit",       // Part of a word
+		"ill sma",      // Phrase starting and ending mid-word
+		"the LORD's",   // Punctuation inside a word
+		"saying, I am", // Punctuation between words
+		"nephi",        // Also matches book names
+		"jarom",
+		"1 ne",
+		"—",     // No words at all
+		"xyzzy", // No matches
+	}
+	for _, query := range queries {
+		t.Run(query, func(t *testing.T) {
+			queryLower := strings.ToLower(query)
+			indexed := service.searchMatches(context.Background(), queryLower)
+			scanned := linear.searchMatches(context.Background(), queryLower)
+			if len(indexed) != len(scanned) {
+				t.Fatalf("Expected %d matches, index found %d", len(scanned), len(indexed))
+			}
+			for i := range scanned {
+				if indexed[i].Reference != scanned[i].Reference {
+					t.Fatalf("Match %d: expected %s, index found %s", i, scanned[i].Reference, indexed[i].Reference)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package scripture
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// indexCacheVersion changes whenever the cached postings would differ for
+// the same verses, such as a change to a tokenizer, or the file format
+// changes, so older caches are rebuilt
+const indexCacheVersion = 4
+
+// indexCacheMagic starts every index cache file, followed by the version as
+// a little-endian uint32 and then the gob-encoded indexCache. The header is
+// checked before decoding, so a file of another format or version is
+// rebuilt without being decoded.
+const indexCacheMagic = "SMCPIDX\x00"
+
+// indexCacheDir is the folder of the state directory holding the search
+// index caches
+const indexCacheDir = "cache"
+
+// indexCache is the on-disk form of a search index's word postings. The
+// verses themselves are not stored: they are loaded as usual and the
+// fingerprint ties the postings to them.
+type indexCache struct {
+	Fingerprint string
+	Words       []string  // Indexed words, each with its postings at the same position
+	IDs         [][]int32 // Verse IDs of each word's postings
+	Counts      [][]int32 // Occurrences of each word in those verses
+	Lengths     []int     // Number of words in each verse
+}
+
+// indexCachePath returns the index cache file for the service's edition. It
+// reports false when there is no state directory or caching is turned off
+// with SCRIPTURES_INDEX_CACHE=off.
+func (s *Service) indexCachePath() (string, bool) {
+	if strings.EqualFold(strings.TrimSpace(os.Getenv("SCRIPTURES_INDEX_CACHE")), "off") {
+		return "", false
+	}
+	dir, ok := StateDir()
+	if !ok {
+		return "", false
+	}
+	name := fmt.Sprintf("search-index-%s-%s.gob", s.languageCode(), s.translationCode())
+	return filepath.Join(dir, indexCacheDir, name), true
+}
+
+// fingerprint hashes the cache version and every indexed verse, so any
+// change to the loaded data invalidates the cache
+func (idx *searchIndex) fingerprint() string {
+	hash := sha256.New()
+	var buf [8]byte
+	writeInt := func(n int) {
+		binary.LittleEndian.PutUint64(buf[:], uint64(n))
+		hash.Write(buf[:])
+	}
+	writeString := func(s string) {
+		writeInt(len(s))
+		hash.Write([]byte(s))
+	}
+	writeInt(indexCacheVersion)
+	writeInt(len(idx.verses))
+	for _, verse := range idx.verses {
+		writeString(verse.Book)
+		writeInt(verse.Chapter)
+		writeInt(verse.Verse)
+		writeString(verse.Text)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// loadCache fills in the word postings and lengths from the cache file when
+// it was built from the same verses by the same version
+func (idx *searchIndex) loadCache(path, fingerprint string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	header := make([]byte, len(indexCacheMagic)+4)
+	if _, err := io.ReadFull(reader, header); err != nil || string(header[:len(indexCacheMagic)]) != indexCacheMagic {
+		return fmt.Errorf("%s is not an index cache", path)
+	}
+	if version := binary.LittleEndian.Uint32(header[len(indexCacheMagic):]); version != indexCacheVersion {
+		return fmt.Errorf("%s is index cache version %d, not %d", path, version, indexCacheVersion)
+	}
+
+	var cache indexCache
+	if err := gob.NewDecoder(reader).Decode(&cache); err != nil {
+		return fmt.Errorf("decode %s: %w", path, err)
+	}
+	if cache.Fingerprint != fingerprint {
+		return fmt.Errorf("%s is out of date", path)
+	}
+	if len(cache.Lengths) != len(idx.verses) || len(cache.IDs) != len(cache.Words) || len(cache.Counts) != len(cache.Words) {
+		return fmt.Errorf("%s is inconsistent", path)
+	}
+
+	words := make(map[string][]posting, len(cache.Words))
+	for i, word := range cache.Words {
+		ids, counts := cache.IDs[i], cache.Counts[i]
+		if len(ids) != len(counts) {
+			return fmt.Errorf("%s is inconsistent", path)
+		}
+		postings := make([]posting, len(ids))
+		for j, id := range ids {
+			if id < 0 || int(id) >= len(idx.verses) {
+				return fmt.Errorf("%s is inconsistent", path)
+			}
+			postings[j] = posting{id: id, count: counts[j]}
+		}
+		words[word] = postings
+	}
+	idx.words = words
+	idx.lengths = cache.Lengths
+	idx.totalWords = 0
+	for _, length := range cache.Lengths {
+		idx.totalWords += length
+	}
+	return nil
+}
+
+// saveCache writes the word postings and lengths to the cache file. It
+// writes a temporary file and renames it, so a concurrent start never reads
+// a partial cache.
+func (idx *searchIndex) saveCache(path, fingerprint string) error {
+	cache := indexCache{
+		Fingerprint: fingerprint,
+		Words:       make([]string, 0, len(idx.words)),
+		IDs:         make([][]int32, 0, len(idx.words)),
+		Counts:      make([][]int32, 0, len(idx.words)),
+		Lengths:     idx.lengths,
+	}
+	for word, postings := range idx.words {
+		ids := make([]int32, len(postings))
+		counts := make([]int32, len(postings))
+		for i, p := range postings {
+			ids[i], counts[i] = p.id, p.count
+		}
+		cache.Words = append(cache.Words, word)
+		cache.IDs = append(cache.IDs, ids)
+		cache.Counts = append(cache.Counts, counts)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	header := binary.LittleEndian.AppendUint32([]byte(indexCacheMagic), indexCacheVersion)
+	if _, err := file.Write(header); err != nil {
+		file.Close()
+		return err
+	}
+	if err := gob.NewEncoder(file).Encode(&cache); err != nil {
+		file.Close()
+		return fmt.Errorf("encode %s: %w", path, err)
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
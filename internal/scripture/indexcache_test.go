@@ -0,0 +1,103 @@
+package scripture
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	// Keep tests from writing index caches into the user's state directory;
+	// the cache tests turn it back on with their own state directory
+	os.Setenv("SCRIPTURES_INDEX_CACHE", "off")
+	os.Exit(m.Run())
+}
+
+func TestService_IndexCache(t *testing.T) {
+	state := t.TempDir()
+	t.Setenv("SCRIPTURES_STATE_DIR", state)
+	t.Setenv("SCRIPTURES_DATA_DIR", "")
+	t.Setenv("SCRIPTURES_INDEX_CACHE", "")
+
+	service := &Service{scriptures: map[string][]Scripture{
+		"Genesis": {
+			{Book: "Genesis", Chapter: 1, Verse: 1, Reference: "Genesis 1:1", Text: "In the beginning God created the heaven and the earth."},
+			{Book: "Genesis", Chapter: 1, Verse: 2, Reference: "Genesis 1:2", Text: "And the earth was without form, and void."},
+		},
+		"John": {
+			{Book: "John", Chapter: 1, Verse: 1, Reference: "John 1:1", Text: "In the beginning was the Word, and the Word was with God."},
+		},
+	}}
+	path, ok := service.indexCachePath()
+	if !ok || path != filepath.Join(state, indexCacheDir, "search-index-en-kjv.gob") {
+		t.Fatalf("Unexpected cache path %q", path)
+	}
+	expected := service.newSearchIndex()
+
+	service.buildIndex()
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Expected the index cache to be saved: %v", err)
+	}
+
+	// A second build reads the postings back from the cache
+	service.index = nil
+	service.buildIndex()
+	if again, _ := os.Stat(path); !again.ModTime().Equal(info.ModTime()) {
+		t.Error("Expected the index cache to be reused, not rewritten")
+	}
+	if !reflect.DeepEqual(service.index.words, expected.words) || !reflect.DeepEqual(service.index.lengths, expected.lengths) || service.index.totalWords != expected.totalWords {
+		t.Errorf("Expected the cached index to match a fresh one, got %+v", service.index)
+	}
+
+	// Changed data rebuilds the index instead of using stale postings
+	service.scriptures["John"][0].Text = "In the beginning was the Word."
+	service.buildIndex()
+	if _, ok := service.index.words["god"]; !ok || len(service.index.words["god"]) != 1 || service.index.lengths[2] != 6 {
+		t.Errorf("Expected the index to be rebuilt for the changed verse, got %+v", service.index.words["god"])
+	}
+	index := service.newIndexedVerses()
+	if err := index.loadCache(path, index.fingerprint()); err != nil {
+		t.Errorf("Expected the rebuilt index to be saved: %v", err)
+	}
+
+	// A cache from another version is not used
+	if err := index.loadCache(path, "stale"); err == nil {
+		t.Error("Expected a mismatched fingerprint to be rejected")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	binary.LittleEndian.PutUint32(data[len(indexCacheMagic):], indexCacheVersion+1)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := index.loadCache(path, index.fingerprint()); err == nil || !strings.Contains(err.Error(), "version") {
+		t.Errorf("Expected a cache of another version to be rejected before decoding, got %v", err)
+	}
+	if err := os.WriteFile(path, []byte("not a gob"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	service.buildIndex()
+	if len(service.index.words["beginning"]) != 2 {
+		t.Errorf("Expected a corrupt cache to be rebuilt, got %+v", service.index.words["beginning"])
+	}
+}
+
+func TestService_IndexCache_Off(t *testing.T) {
+	t.Setenv("SCRIPTURES_STATE_DIR", t.TempDir())
+	t.Setenv("SCRIPTURES_INDEX_CACHE", "off")
+	service := &Service{}
+	if path, ok := service.indexCachePath(); ok {
+		t.Errorf("Expected no index cache when turned off, got %q", path)
+	}
+	service.language = "es"
+	t.Setenv("SCRIPTURES_INDEX_CACHE", "")
+	if path, _ := service.indexCachePath(); filepath.Base(path) != "search-index-es-kjv.gob" {
+		t.Errorf("Expected a cache per edition, got %q", path)
+	}
+}
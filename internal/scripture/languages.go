@@ -0,0 +1,151 @@
+package scripture
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// defaultLanguage is the language of the embedded scripture data
+const defaultLanguage = "en"
+
+// languagePattern matches the language codes that name edition
+// directories, such as "es" in data/es
+var languagePattern = regexp.MustCompile(`^[a-z]{2,3}$`)
+
+// commonWords are each language's most frequent words, which count_terms
+// skips on request
+var commonWords = map[string][]string{
+	defaultLanguage: functionWords,
+	"es": {
+		"de", "la", "que", "el", "y", "a", "en", "los", "se", "del",
+		"las", "un", "por", "con", "no", "una", "su", "para", "es", "al",
+		"lo", "como", "más", "o", "pero", "sus", "le", "ha", "me", "si",
+		"sin", "sobre", "este", "ya", "entre", "cuando", "todo", "esta", "ser", "son",
+		"dos", "también", "fue", "había", "era", "muy", "hasta", "desde", "mi", "porque",
+		"he", "aquí", "os", "vosotros", "les", "él", "ellos", "yo", "te", "tu",
+	},
+	"pt": {
+		"de", "a", "o", "que", "e", "do", "da", "em", "um", "para",
+		"é", "com", "não", "uma", "os", "no", "se", "na", "por", "mais",
+		"as", "dos", "como", "mas", "foi", "ao", "ele", "das", "tem", "à",
+		"seu", "sua", "ou", "ser", "quando", "muito", "há", "nos", "já", "está",
+		"eu", "também", "só", "pelo", "pela", "até", "isso", "ela", "entre", "era",
+		"eis", "eles", "vós", "lhe", "lhes", "me", "te", "teu", "tua", "porque",
+	},
+}
+
+// loadEditions loads the optional language editions: each subdirectory of
+// SCRIPTURES_DATA_DIR or the state data directory named for a language
+// code, such as data/es, that holds scripture data files in the usual
+// layout. The first directory with an edition of a language wins.
+func (s *Service) loadEditions() {
+	for _, dir := range dataDirs() {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			language := entry.Name()
+			if !entry.IsDir() || !languagePattern.MatchString(language) || language == defaultLanguage || s.editions[language] != nil {
+				continue
+			}
+			edition := s.loadEdition(filepath.Join(dir, language))
+			if edition == nil {
+				continue
+			}
+			edition.language = language
+			edition.finishLoading()
+			if s.editions == nil {
+				s.editions = make(map[string]*Service)
+			}
+			s.editions[language] = edition
+		}
+	}
+}
+
+// loadEdition loads an edition's scripture data from a directory with the
+// settings of this service, returning nil when it has none. The caller sets
+// the edition's language or translation and then finishes loading it.
+func (s *Service) loadEdition(dir string) *Service {
+	if !s.hasScriptureData(dir) {
+		return nil
+	}
+	edition := &Service{
+		scriptures:       make(map[string][]Scripture),
+		bookCollections:  make(map[string]string),
+		optional:         s.optional,
+		budget:           s.budget,
+		requireCitations: s.requireCitations,
+	}
+	edition.loadFromDir(dir)
+	if len(edition.scriptures) == 0 {
+		slog.Warn("No scripture data loaded for edition", "dir", dir)
+		return nil
+	}
+	return edition
+}
+
+// languageCode returns the language of the service's scripture data
+func (s *Service) languageCode() string {
+	if s.language == "" {
+		return defaultLanguage
+	}
+	return s.language
+}
+
+// languages returns the codes of the loaded languages in alphabetical order
+func (s *Service) languages() []string {
+	languages := []string{s.languageCode()}
+	for language := range s.editions {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// edition returns the service for the language and translation arguments
+// of a request: this service when they are absent or name this service's
+// own, otherwise the loaded edition or translation they name
+func (s *Service) edition(arguments map[string]any) (*Service, error) {
+	language, _ := arguments["language"].(string)
+	language = strings.ToLower(strings.TrimSpace(language))
+	translation, _ := arguments["translation"].(string)
+	translation = strings.ToLower(strings.TrimSpace(translation))
+	if translation != "" && translation != s.translationCode() {
+		if language != "" && language != s.languageCode() {
+			return nil, fmt.Errorf("language and translation cannot be combined; translations are of the %s edition", s.languageCode())
+		}
+		return s.translationEdition(translation)
+	}
+	if language == "" || language == s.languageCode() {
+		return s, nil
+	}
+	if edition, ok := s.editions[language]; ok {
+		return edition, nil
+	}
+	return nil, fmt.Errorf("language '%s' is not loaded; available languages: %s (place an edition's data files in a '%s' folder of SCRIPTURES_DATA_DIR or the state data directory)",
+		language, strings.Join(s.languages(), ", "), language)
+}
+
+// skipCommonWords drops the terms that are common words of the service's
+// language, returning the terms kept and those skipped
+func (s *Service) skipCommonWords(terms []string) ([]string, []string) {
+	common := make(map[string]bool)
+	for _, word := range commonWords[s.languageCode()] {
+		common[word] = true
+	}
+	var kept, skipped []string
+	for _, term := range terms {
+		if common[strings.ToLower(term)] {
+			skipped = append(skipped, term)
+		} else {
+			kept = append(kept, term)
+		}
+	}
+	return kept, skipped
+}
@@ -0,0 +1,81 @@
+package scripture
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// testSpanishEdition is a one-verse Spanish edition of the Book of Mormon
+const testSpanishEdition = `{"books": [{"book": "1 Nefi", "chapters": [{"chapter": 3, "verses": [
+	{"verse": 7, "reference": "1 Nefi 3:7", "text": "Y aconteció que yo, Nefi, dije a mi padre: Iré y haré lo que el Señor ha mandado."}
+]}]}]}`
+
+func TestService_LanguageEditions(t *testing.T) {
+	state := t.TempDir()
+	t.Setenv("SCRIPTURES_STATE_DIR", state)
+	t.Setenv("SCRIPTURES_DATA_DIR", "")
+
+	editionDir := filepath.Join(state, "data", "es")
+	if err := os.MkdirAll(editionDir, 0755); err != nil {
+		t.Fatalf("Failed to create edition dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(editionDir, "book-of-mormon.json"), []byte(testSpanishEdition), 0644); err != nil {
+		t.Fatalf("Failed to write edition: %v", err)
+	}
+	// Directories not named for a language are not editions
+	if err := os.MkdirAll(filepath.Join(state, "data", "backup"), 0755); err != nil {
+		t.Fatalf("Failed to create dir: %v", err)
+	}
+
+	service := NewService()
+	if _, ok := service.scriptures["Alma"]; !ok {
+		t.Fatal("Expected the embedded English data alongside the edition")
+	}
+	if strings.Join(service.languages(), ",") != "en,es" {
+		t.Fatalf("Expected languages en and es, got %v", service.languages())
+	}
+
+	call := func(handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error), arguments map[string]any) *mcp.CallToolResult {
+		t.Helper()
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = arguments
+		result, err := handler(context.Background(), request)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+	text := func(result *mcp.CallToolResult) string {
+		return result.Content[0].(mcp.TextContent).Text
+	}
+
+	if result := call(service.GetScripture, map[string]any{"query": "1 Nefi 3:7", "language": "ES"}); result.IsError || !strings.Contains(text(result), "Iré y haré") {
+		t.Errorf("Expected the Spanish verse, got %+v", result)
+	}
+	if result := call(service.GetScripture, map[string]any{"query": "1 Nephi 3:7", "language": "en"}); result.IsError || !strings.Contains(text(result), "I will go and do") {
+		t.Errorf("Expected the English verse, got %+v", result)
+	}
+	if result := call(service.GetChapter, map[string]any{"query": "1 Nefi 3", "language": "es"}); result.IsError {
+		t.Errorf("Expected the Spanish chapter, got %+v", result)
+	}
+	if result := call(service.SearchScriptures, map[string]any{"query": "mandado", "language": "es"}); result.IsError || !strings.Contains(text(result), "1 Nefi 3:7") {
+		t.Errorf("Expected a match in the Spanish edition, got %+v", result)
+	}
+	if result := call(service.SearchScriptures, map[string]any{"query": "faith", "language": "pt"}); !result.IsError || !strings.Contains(text(result), "available languages: en, es") {
+		t.Errorf("Expected an error for a language that is not loaded, got %+v", result)
+	}
+
+	result := call(service.CountTerms, map[string]any{"terms": "el, Señor, padre, y", "language": "es", "skip_common": true})
+	structured := result.StructuredContent.(TermCountsResult)
+	if len(structured.Counts) != 2 || strings.Join(structured.Skipped, ",") != "el,y" {
+		t.Errorf("Expected the Spanish common words skipped, got %+v", structured)
+	}
+	if result := call(service.CountTerms, map[string]any{"terms": "the, and", "skip_common": true}); !result.IsError {
+		t.Errorf("Expected an error when every term is a common word, got %+v", result)
+	}
+}
@@ -0,0 +1,369 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxLintText caps the size of a document checked by lint_citations
+const maxLintText = 200000
+
+// maxQuoteDistance is how many characters may separate a quotation from the
+// reference that cites it
+const maxQuoteDistance = 100
+
+// Verdicts on a quotation
+const (
+	quoteVerified       = "verified"
+	quoteMisquoted      = "misquoted"
+	quoteWrongReference = "wrong_reference" // The quotation matches other verses of the chapter
+	quoteUnmatched      = "unmatched"       // The quotation does not match the cited passage
+	quoteNotFound       = "not_found"       // The cited passage is not in the loaded data
+)
+
+// citedReferencePattern finds candidate references in prose: a run of
+// capitalized words (possibly numbered, as in "1 Ne.") followed by a chapter
+// and optional verse or verse range. The book words are checked against the
+// loaded books afterwards.
+var citedReferencePattern = regexp.MustCompile(`((?:[1-4]\s*)?[A-Z][\w.&—–-]*(?:\s+(?:of|and|[1-4]|[A-Z][\w.&—–-]*))*)\s+(\d+)(?::(\d+)(?:\s*[-–]\s*(\d+))?)?`)
+
+// quotationPattern finds text in straight or curly double quotes
+var quotationPattern = regexp.MustCompile(`"([^"]+)"|“([^”]+)”`)
+
+// lintWordPattern finds the words of a candidate book name
+var lintWordPattern = regexp.MustCompile(`\S+`)
+
+// ellipsisPattern splits a quotation into the fragments it keeps
+var ellipsisPattern = regexp.MustCompile(`\.\s?\.\s?\.|…`)
+
+// QuotationCheck is the verdict on one quotation found in a document
+type QuotationCheck struct {
+	Line      int           `json:"line"`                // Line of the document the quotation starts on
+	Reference string        `json:"reference"`           // Reference as cited in the document
+	Quote     string        `json:"quote"`               // Quoted text
+	Status    string        `json:"status"`              // verified, misquoted, wrong_reference, unmatched or not_found
+	Suggested string        `json:"suggested,omitempty"` // Verses the quotation matches, for wrong_reference
+	Scripture string        `json:"scripture,omitempty"` // Scripture text the quotation was compared with
+	Diff      []DiffSegment `json:"diff,omitempty"`      // Changes from the scripture text to the quotation
+}
+
+// citedReference is a reference found in a document
+type citedReference struct {
+	start, end int
+	text       string
+	ref        *ScriptureReference
+}
+
+// passageWord is a word of a passage with the verse it belongs to
+type passageWord struct {
+	text  string
+	key   string
+	verse int
+}
+
+// LintCitations finds the scripture quotations in a document and checks each
+// against the text of the reference it cites
+func (s *Service) LintCitations(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	text, ok := arguments["text"].(string)
+	if !ok || strings.TrimSpace(text) == "" {
+		return mcp.NewToolResultError("document text cannot be empty"), nil
+	}
+	if len(text) > maxLintText {
+		return mcp.NewToolResultError(fmt.Sprintf("document too long: %d bytes, the limit is %d", len(text), maxLintText)), nil
+	}
+
+	checks := s.lintCitations(text)
+	structured := CitationLintResult{Quotations: len(checks), Checks: append([]QuotationCheck{}, checks...)}
+	for _, check := range checks {
+		if check.Status == quoteVerified {
+			structured.Verified++
+		}
+	}
+	structured.Problems = structured.Quotations - structured.Verified
+
+	if len(checks) == 0 {
+		response := "No scripture quotations found. A quotation is recognized in double quotes next to the reference it cites, as in \"For God so loved the world\" (John 3:16)."
+		return mcp.NewToolResultStructured(structured, response), nil
+	}
+
+	response := fmt.Sprintf("Checked %d %s: %d verified, %d with problems.\n", structured.Quotations, pluralize(structured.Quotations, "quotation", "quotations"), structured.Verified, structured.Problems)
+	if structured.Problems > 0 {
+		response += "Changes mark [-scripture words missing from the quotation-] and {+quoted words not in the scripture+}.\n"
+	}
+	for _, check := range checks {
+		response += "\n" + check.String()
+	}
+	return mcp.NewToolResultStructured(structured, response), nil
+}
+
+// lintCitations pairs each quotation in a document with the nearest
+// reference in the same paragraph and checks it
+func (s *Service) lintCitations(text string) []QuotationCheck {
+	references := s.citedReferences(text)
+
+	var checks []QuotationCheck
+	for _, match := range quotationPattern.FindAllStringSubmatchIndex(text, -1) {
+		start, end := match[0], match[1]
+		var quote string
+		if match[2] >= 0 {
+			quote = text[match[2]:match[3]]
+		} else {
+			quote = text[match[4]:match[5]]
+		}
+		if strings.Contains(quote, "\n\n") || len(lintWords(quote)) < 3 {
+			continue
+		}
+
+		cited, ok := nearestReference(text, start, end, references)
+		if !ok {
+			continue
+		}
+		check := s.checkQuotation(strings.Join(strings.Fields(quote), " "), cited)
+		check.Line = strings.Count(text[:start], "\n") + 1
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// citedReferences finds the references to loaded books in a document
+func (s *Service) citedReferences(text string) []citedReference {
+	var references []citedReference
+	for _, match := range citedReferencePattern.FindAllStringSubmatchIndex(text, -1) {
+		// The capitalized run may start with words of the sentence, as in
+		// "In 1 Nephi 3:7", so take the longest suffix naming a book
+		bookStart, bookEnd := match[2], match[3]
+		var book string
+		for _, word := range lintWordPattern.FindAllStringIndex(text[bookStart:bookEnd], -1) {
+			if name, ok := s.resolveBook(text[bookStart+word[0] : bookEnd]); ok {
+				book, bookStart = name, bookStart+word[0]
+				break
+			}
+		}
+		if book == "" {
+			continue
+		}
+
+		ref := &ScriptureReference{Book: book, Verse: 1, EndVerse: math.MaxInt}
+		ref.Chapter, _ = strconv.Atoi(text[match[4]:match[5]])
+		if match[6] >= 0 {
+			ref.Verse, _ = strconv.Atoi(text[match[6]:match[7]])
+			ref.EndVerse = ref.Verse
+		}
+		if match[8] >= 0 {
+			ref.EndVerse, _ = strconv.Atoi(text[match[8]:match[9]])
+		}
+		references = append(references, citedReference{start: bookStart, end: match[1], text: text[bookStart:match[1]], ref: ref})
+	}
+	return references
+}
+
+// nearestReference returns the reference closest to a quotation within the
+// same paragraph, preferring one that follows it
+func nearestReference(text string, start, end int, references []citedReference) (citedReference, bool) {
+	var nearest citedReference
+	best := maxQuoteDistance + 1
+	for _, reference := range references {
+		var between string
+		switch {
+		case reference.start >= end:
+			between = text[end:reference.start]
+		case reference.end <= start:
+			between = text[reference.end:start]
+		default:
+			continue // Inside the quotation
+		}
+		distance := len(between)
+		if reference.end <= start {
+			distance++ // A following reference wins a tie
+		}
+		if distance < best && !strings.Contains(between, "\n\n") {
+			nearest, best = reference, distance
+		}
+	}
+	return nearest, best <= maxQuoteDistance
+}
+
+// checkQuotation compares a quotation with the cited verses, and with the rest
+// of the chapter when it does not match them exactly
+func (s *Service) checkQuotation(quote string, cited citedReference) QuotationCheck {
+	check := QuotationCheck{Reference: cited.text, Quote: quote}
+
+	verses := s.getScripturesByReference(cited.ref)
+	if len(verses) == 0 {
+		check.Status = quoteNotFound
+		return check
+	}
+
+	var fragments [][]string
+	quoteLength := 0
+	for _, fragment := range ellipsisPattern.Split(quote, -1) {
+		if words := lintWords(fragment); len(words) > 0 {
+			fragments = append(fragments, words)
+			quoteLength += len(words)
+		}
+	}
+
+	scripture, diff, errors, _, _ := alignQuotation(fragments, passageWords(verses))
+	if errors > 0 && cited.ref.EndVerse != math.MaxInt {
+		// A quotation from the wrong verse usually comes from the same chapter
+		chapterScripture, chapterDiff, chapterErrors, first, last := alignQuotation(fragments, passageWords(s.getChapter(cited.ref.Book, cited.ref.Chapter)))
+		if chapterErrors < errors && 2*chapterErrors <= quoteLength {
+			check.Status = quoteWrongReference
+			check.Suggested = fmt.Sprintf("%s %d:%d", verses[0].Book, cited.ref.Chapter, first)
+			if last != first {
+				check.Suggested += fmt.Sprintf("-%d", last)
+			}
+			check.Scripture = chapterScripture
+			if diffChanged(chapterDiff) {
+				check.Diff = chapterDiff
+			}
+			return check
+		}
+	}
+
+	check.Scripture, check.Diff = scripture, diff
+	switch {
+	case errors == 0:
+		check.Status = quoteVerified
+		check.Diff = nil
+	case 2*errors <= quoteLength:
+		check.Status = quoteMisquoted
+	default:
+		check.Status = quoteUnmatched
+		check.Scripture, check.Diff = "", nil
+	}
+	return check
+}
+
+// alignQuotation aligns each fragment of a quotation with the passage,
+// returning the matched scripture text, the combined diff, the number of
+// word edits and the first and last verses the matches cover
+func alignQuotation(fragments [][]string, passage []passageWord) (string, []DiffSegment, int, int, int) {
+	var scripture []string
+	var diff []DiffSegment
+	errors, first, last := 0, math.MaxInt, 0
+	for i, fragment := range fragments {
+		start, end, edits := alignWords(fragment, passage)
+		errors += edits
+
+		window := make([]string, 0, end-start)
+		for _, word := range passage[start:end] {
+			window = append(window, word.text)
+			first, last = min(first, word.verse), max(last, word.verse)
+		}
+		if i > 0 {
+			scripture = append(scripture, "...")
+			diff = append(diff, DiffSegment{Op: diffEqual, Text: "..."})
+		}
+		scripture = append(scripture, window...)
+		diff = append(diff, wordDiff(strings.Join(window, " "), strings.Join(fragment, " "))...)
+	}
+	if last == 0 {
+		first = 0
+	}
+	return strings.Join(scripture, " "), diff, errors, first, last
+}
+
+// alignWords finds the run of passage words closest to a quotation by word
+// edit distance, where the run may start and end anywhere in the passage.
+// It returns the run's bounds and the number of edits.
+func alignWords(quote []string, passage []passageWord) (int, int, int) {
+	// cost[j] and start[j] describe the best alignment of the quotation so
+	// far ending before passage word j
+	cost := make([]int, len(passage)+1)
+	start := make([]int, len(passage)+1)
+	for j := range start {
+		start[j] = j
+	}
+
+	next := make([]int, len(passage)+1)
+	nextStart := make([]int, len(passage)+1)
+	for i, word := range quote {
+		key := diffKey(word)
+		next[0], nextStart[0] = i+1, 0
+		for j, passageWord := range passage {
+			substitution := cost[j]
+			if passageWord.key != key {
+				substitution++
+			}
+			next[j+1], nextStart[j+1] = substitution, start[j]
+			if cost[j+1]+1 < next[j+1] { // Quoted word not in the passage
+				next[j+1], nextStart[j+1] = cost[j+1]+1, start[j+1]
+			}
+			if next[j]+1 < next[j+1] { // Passage word left out of the quotation
+				next[j+1], nextStart[j+1] = next[j]+1, nextStart[j]
+			}
+		}
+		cost, next = next, cost
+		start, nextStart = nextStart, start
+	}
+
+	// On a tie the longer run wins, so a dropped word at the end of the
+	// quotation shows as left out rather than the run stopping short
+	end := 0
+	for j := range cost {
+		if cost[j] <= cost[end] {
+			end = j
+		}
+	}
+	return start[end], end, cost[end]
+}
+
+// passageWords splits verses into words for alignment
+func passageWords(verses []Scripture) []passageWord {
+	var words []passageWord
+	for _, verse := range verses {
+		for _, word := range lintWords(verse.Text) {
+			words = append(words, passageWord{text: word, key: diffKey(word), verse: verse.Verse})
+		}
+	}
+	return words
+}
+
+// lintWords splits text into words, treating dashes as spaces so "faith—faith"
+// compares as two words, and dropping tokens without letters or digits
+func lintWords(text string) []string {
+	var words []string
+	for _, field := range strings.FieldsFunc(text, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '—' || r == '–'
+	}) {
+		if diffKey(field) != "" {
+			words = append(words, field)
+		}
+	}
+	return words
+}
+
+// String renders a quotation check for text output
+func (c QuotationCheck) String() string {
+	if c.Status == quoteVerified {
+		return fmt.Sprintf("Line %d, %s: verified\n", c.Line, c.Reference)
+	}
+
+	verdict := c.Status
+	switch c.Status {
+	case quoteWrongReference:
+		verdict = "wrong reference, the quotation matches " + c.Suggested
+	case quoteUnmatched:
+		verdict = "the quotation does not match the cited passage"
+	case quoteNotFound:
+		verdict = "reference not found"
+	}
+	response := fmt.Sprintf("Line %d, %s: %s\n  Quote: \"%s\"\n", c.Line, c.Reference, verdict, c.Quote)
+	if c.Scripture != "" {
+		response += fmt.Sprintf("  Scripture: %s\n", c.Scripture)
+	}
+	if c.Diff != nil {
+		response += fmt.Sprintf("  Changes: %s\n", renderDiff(c.Diff))
+	}
+	return response
+}
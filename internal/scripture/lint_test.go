@@ -0,0 +1,119 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestService_CitedReferences(t *testing.T) {
+	service := NewService()
+
+	tests := []struct {
+		text     string
+		expected []string // Cited text, book, chapter, verses
+	}{
+		{text: "In 1 Nephi 3:7 we read", expected: []string{"1 Nephi 3:7"}},
+		{text: "as in (1 Ne. 3:7) and D&C 4:2", expected: []string{"1 Ne. 3:7", "D&C 4:2"}},
+		{text: "Read Moroni 10:4–5 and Alma 32.", expected: []string{"Moroni 10:4–5", "Alma 32"}},
+		{text: "Song of Solomon 2:1", expected: []string{"Song of Solomon 2:1"}},
+		{text: "In 1830 the Chapter 5 of Hezekiah 1:1", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			var got []string
+			for _, reference := range service.citedReferences(tt.text) {
+				got = append(got, reference.text)
+			}
+			if strings.Join(got, "|") != strings.Join(tt.expected, "|") {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestService_LintCitations(t *testing.T) {
+	service := NewService()
+
+	tests := []struct {
+		name      string
+		text      string
+		status    string
+		suggested string
+		diff      string
+	}{
+		{name: "Verified", text: `"For God so loved the world, that he gave his only begotten Son" (John 3:16)`, status: quoteVerified},
+		{name: "Case and punctuation", text: `John 3:16 says, “for God so loved the world that he gave”.`, status: quoteVerified},
+		{name: "Ellipsis", text: `"ask God ... if these things are not true" — Moroni 10:4`, status: quoteVerified},
+		{name: "Misquoted", text: `"For God so loved the world that he gave his only Son" (John 3:16)`, status: quoteMisquoted, diff: "For God so loved the world that he gave his only [-begotten-] Son"},
+		{name: "Typo", text: `"I will go and do the thing which the Lord hath comanded" (1 Nephi 3:7)`, status: quoteMisquoted, diff: "I will go and do the [-things-] {+thing+} which the Lord hath [-commanded,-] {+comanded+}"},
+		{name: "Wrong verse", text: `"Faith is not to have a perfect knowledge of things" (Alma 32:22)`, status: quoteWrongReference, suggested: "Alma 32:21"},
+		{name: "Unmatched", text: `"Be still, and know that I am God" (John 3:16)`, status: quoteUnmatched},
+		{name: "Missing passage", text: `"I will go and do" (1 Nephi 99:1)`, status: quoteNotFound},
+		{name: "Chapter", text: `"Faith is not to have a perfect knowledge of things" (Alma 32)`, status: quoteVerified},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checks := service.lintCitations(tt.text)
+			if len(checks) != 1 {
+				t.Fatalf("Expected 1 quotation, got %+v", checks)
+			}
+			check := checks[0]
+			if check.Status != tt.status || check.Suggested != tt.suggested {
+				t.Errorf("Expected %s %q, got %+v", tt.status, tt.suggested, check)
+			}
+			if got := renderDiff(check.Diff); check.Diff != nil && got != tt.diff {
+				t.Errorf("Expected diff %q, got %q", tt.diff, got)
+			}
+		})
+	}
+
+	// Quotations without a nearby reference, short quotations and references
+	// in another paragraph are not checked
+	text := "He said \"hello there friend\" to me.\n\n\"Ye\" (John 3:16)\n\n\"For God so loved the world\"\n\nJohn 3:16"
+	if checks := service.lintCitations(text); len(checks) != 0 {
+		t.Errorf("Expected no quotations, got %+v", checks)
+	}
+}
+
+func TestService_LintCitations_Tool(t *testing.T) {
+	service := NewService()
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]any{"text": "Our theme is \"I will go and do\" (1 Nephi 3:7).\n\nAnd \"faith is not to have a perfect knowledge\" (Alma 32:22)."}
+	result, err := service.LintCitations(context.Background(), request)
+	if err != nil || result.IsError {
+		t.Fatalf("Unexpected error: %v %+v", err, result)
+	}
+	structured := result.StructuredContent.(CitationLintResult)
+	if structured.Quotations != 2 || structured.Verified != 1 || structured.Problems != 1 {
+		t.Errorf("Expected 2 quotations with 1 problem, got %+v", structured)
+	}
+	text := result.Content[0].(mcp.TextContent).Text
+	for _, want := range []string{"Checked 2 quotations: 1 verified, 1 with problems.", "Line 1, 1 Nephi 3:7: verified", "Line 3, Alma 32:22: wrong reference, the quotation matches Alma 32:21"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected the text to contain %q, got:\n%s", want, text)
+		}
+	}
+
+	// A single quotation is counted in the singular
+	request.Params.Arguments = map[string]any{"text": "Our theme is \"I will go and do\" (1 Nephi 3:7)."}
+	result, err = service.LintCitations(context.Background(), request)
+	if err != nil || result.IsError {
+		t.Fatalf("Unexpected error: %v %+v", err, result)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.HasPrefix(text, "Checked 1 quotation: 1 verified, 0 with problems.") {
+		t.Errorf("Expected one quotation in the summary, got:\n%s", text)
+	}
+
+	for _, arguments := range []map[string]any{{}, {"text": " "}, {"text": strings.Repeat("a", maxLintText+1)}} {
+		request.Params.Arguments = arguments
+		if result, _ := service.LintCitations(context.Background(), request); !result.IsError {
+			t.Errorf("Expected an error for %.20v", arguments)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package scripture
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// manifestName is the checksum manifest stored alongside the data files
+const manifestName = "manifest.json"
+
+// Manifest records the SHA-256 checksum of every data file in an archive
+type Manifest struct {
+	Files map[string]ManifestEntry `json:"files"`
+}
+
+// ManifestEntry is the recorded checksum, size and licensing of one data file
+type ManifestEntry struct {
+	SHA256  string          `json:"sha256"`
+	Size    int             `json:"size"`
+	License *DatasetLicense `json:"license,omitempty"`
+}
+
+// DatasetLicense is where a data file's text comes from and the terms it is
+// distributed under. Every file listed in a manifest must have one.
+type DatasetLicense struct {
+	Source  string `json:"source"`
+	License string `json:"license"`
+	URL     string `json:"url,omitempty"`
+}
+
+// complete reports whether the source and license are both filled in
+func (l *DatasetLicense) complete() bool {
+	return l != nil && strings.TrimSpace(l.Source) != "" && strings.TrimSpace(l.License) != ""
+}
+
+// licensed reports whether a file may be loaded under the manifest: files
+// listed without complete license metadata are refused. Unlisted files are
+// reported by verifyChecksums instead.
+func (m *Manifest) licensed(name string) bool {
+	entry, listed := m.Files[name]
+	return !listed || entry.License.complete()
+}
+
+// IntegrityStatus is the result of checking loaded data files against the manifest
+type IntegrityStatus struct {
+	Source     string   `json:"source"`
+	Manifest   bool     `json:"manifest"`
+	Verified   []string `json:"verified"`
+	Mismatched []string `json:"mismatched,omitempty"` // Checksum differs from the manifest
+	Missing    []string `json:"missing,omitempty"`    // Listed in the manifest but not present
+	Unlisted   []string `json:"unlisted,omitempty"`   // Present but not listed in the manifest
+	Unverified []string `json:"unverified,omitempty"` // Present with no manifest to check against
+	Unlicensed []string `json:"unlicensed,omitempty"` // Listed without source and license metadata, so not loaded
+	Skipped    []string `json:"skipped,omitempty"`    // Present but not loaded or checked, because their collection is disabled
+}
+
+// OK reports whether every data file matched the manifest. Data without a
+// manifest cannot be verified and is not treated as a failure.
+func (st *IntegrityStatus) OK() bool {
+	return len(st.Mismatched) == 0 && len(st.Missing) == 0 && len(st.Unlisted) == 0 && len(st.Unlicensed) == 0
+}
+
+// String summarizes the integrity check in one line per problem
+func (st *IntegrityStatus) String() string {
+	skipped := ""
+	if len(st.Skipped) > 0 {
+		skipped = fmt.Sprintf(", %d skipped (collection disabled)", len(st.Skipped))
+	}
+	if !st.Manifest {
+		return fmt.Sprintf("%s: no checksum manifest, %d data files unverified%s", st.Source, len(st.Unverified), skipped)
+	}
+	if st.OK() {
+		return fmt.Sprintf("%s: %d data files match the manifest%s", st.Source, len(st.Verified), skipped)
+	}
+	return fmt.Sprintf("%s: %s", st.Source, st.problems())
+}
+
+// skip records data files left out because their collection is disabled,
+// which are therefore not missing even when the manifest lists them
+func (st *IntegrityStatus) skip(names []string) {
+	for _, name := range names {
+		st.Missing = slices.DeleteFunc(st.Missing, func(missing string) bool { return missing == name })
+		st.Skipped = append(st.Skipped, name)
+	}
+	sort.Strings(st.Skipped)
+}
+
+// problems lists every mismatched, missing and unlisted file
+func (st *IntegrityStatus) problems() string {
+	var problems []string
+	if len(st.Mismatched) > 0 {
+		problems = append(problems, "checksum mismatch: "+strings.Join(st.Mismatched, ", "))
+	}
+	if len(st.Missing) > 0 {
+		problems = append(problems, "missing: "+strings.Join(st.Missing, ", "))
+	}
+	if len(st.Unlisted) > 0 {
+		problems = append(problems, "not in manifest: "+strings.Join(st.Unlisted, ", "))
+	}
+	if len(st.Unlicensed) > 0 {
+		problems = append(problems, "no license metadata: "+strings.Join(st.Unlicensed, ", "))
+	}
+	return strings.Join(problems, "; ")
+}
+
+// buildManifest checksums every data file and records the license of the
+// known collections
+func buildManifest(files map[string][]byte) Manifest {
+	manifest := Manifest{Files: make(map[string]ManifestEntry, len(files))}
+	for name, data := range files {
+		sum := sha256.Sum256(data)
+		manifest.Files[name] = ManifestEntry{SHA256: hex.EncodeToString(sum[:]), Size: len(data), License: defaultLicense(name)}
+	}
+	return manifest
+}
+
+// parseManifest decodes a manifest file
+func parseManifest(data []byte) (*Manifest, error) {
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", manifestName, err)
+	}
+	return &manifest, nil
+}
+
+// verifyChecksums compares the observed checksum of each data file with the
+// manifest. A nil manifest leaves every file unverified.
+func verifyChecksums(source string, manifest *Manifest, observed map[string]string) IntegrityStatus {
+	status := IntegrityStatus{Source: source, Manifest: manifest != nil}
+
+	names := make([]string, 0, len(observed))
+	for name := range observed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if manifest == nil {
+		status.Unverified = names
+		return status
+	}
+
+	for _, name := range names {
+		entry, listed := manifest.Files[name]
+		switch {
+		case !listed:
+			status.Unlisted = append(status.Unlisted, name)
+		case entry.SHA256 != observed[name]:
+			status.Mismatched = append(status.Mismatched, name)
+		case !entry.License.complete():
+			status.Unlicensed = append(status.Unlicensed, name)
+		default:
+			status.Verified = append(status.Verified, name)
+		}
+	}
+	for name := range manifest.Files {
+		if _, present := observed[name]; !present {
+			status.Missing = append(status.Missing, name)
+		}
+	}
+	sort.Strings(status.Missing)
+	return status
+}
+
+// sha256Reader wraps r for hashing; the returned function drains r and returns its hex SHA-256
+func sha256Reader(r io.Reader) (io.Reader, func() string) {
+	h := sha256.New()
+	tee := io.TeeReader(r, h)
+	return tee, func() string {
+		io.Copy(io.Discard, tee)
+		return hex.EncodeToString(h.Sum(nil))
+	}
+}
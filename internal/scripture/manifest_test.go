@@ -0,0 +1,268 @@
+package scripture
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestVerifyChecksums(t *testing.T) {
+	manifest := buildManifest(map[string][]byte{
+		"book-of-mormon.json": []byte(`{"books": []}`),
+		"old-testament.json":  []byte(`{"books": []}`),
+	})
+	good := manifest.Files["book-of-mormon.json"].SHA256
+
+	tests := []struct {
+		name       string
+		manifest   *Manifest
+		observed   map[string]string
+		expectOK   bool
+		mismatched []string
+		missing    []string
+		unlisted   []string
+		unlicensed []string
+	}{
+		{
+			name:     "All match",
+			manifest: &manifest,
+			observed: map[string]string{"book-of-mormon.json": good, "old-testament.json": good},
+			expectOK: true,
+		},
+		{
+			name:       "Tampered file",
+			manifest:   &manifest,
+			observed:   map[string]string{"book-of-mormon.json": good, "old-testament.json": "deadbeef"},
+			mismatched: []string{"old-testament.json"},
+		},
+		{
+			name:     "Partial sync",
+			manifest: &manifest,
+			observed: map[string]string{"book-of-mormon.json": good},
+			missing:  []string{"old-testament.json"},
+		},
+		{
+			name:     "Unlisted file",
+			manifest: &manifest,
+			observed: map[string]string{"book-of-mormon.json": good, "old-testament.json": good, "extra.json": good},
+			unlisted: []string{"extra.json"},
+		},
+		{
+			name: "Listed without license",
+			manifest: &Manifest{Files: map[string]ManifestEntry{
+				"apocrypha.json": {SHA256: good},
+			}},
+			observed:   map[string]string{"apocrypha.json": good},
+			unlicensed: []string{"apocrypha.json"},
+		},
+		{
+			name:     "No manifest",
+			observed: map[string]string{"book-of-mormon.json": good},
+			expectOK: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := verifyChecksums("test", tt.manifest, tt.observed)
+			if status.OK() != tt.expectOK {
+				t.Errorf("Expected OK() = %v, got %v (%s)", tt.expectOK, status.OK(), status.String())
+			}
+			if !reflect.DeepEqual(status.Mismatched, tt.mismatched) {
+				t.Errorf("Expected mismatched %v, got %v", tt.mismatched, status.Mismatched)
+			}
+			if !reflect.DeepEqual(status.Missing, tt.missing) {
+				t.Errorf("Expected missing %v, got %v", tt.missing, status.Missing)
+			}
+			if !reflect.DeepEqual(status.Unlisted, tt.unlisted) {
+				t.Errorf("Expected unlisted %v, got %v", tt.unlisted, status.Unlisted)
+			}
+			if !reflect.DeepEqual(status.Unlicensed, tt.unlicensed) {
+				t.Errorf("Expected unlicensed %v, got %v", tt.unlicensed, status.Unlicensed)
+			}
+		})
+	}
+}
+
+func TestService_loadFromZipBytes_Integrity(t *testing.T) {
+	original := `{"books": [{"book": "Enos", "chapters": [{"chapter": 1, "verses": [{"verse": 1, "text": "Behold", "reference": "Enos 1:1"}]}]}]}`
+	manifest, err := json.Marshal(buildManifest(map[string][]byte{"book-of-mormon.json": []byte(original)}))
+	if err != nil {
+		t.Fatalf("Failed to marshal manifest: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		files    map[string]string
+		expectOK bool
+	}{
+		{
+			name:     "Matching archive",
+			files:    map[string]string{"book-of-mormon.json": original, manifestName: string(manifest)},
+			expectOK: true,
+		},
+		{
+			name:  "Tampered archive",
+			files: map[string]string{"book-of-mormon.json": strings.Replace(original, "Behold", "Beheld", 1), manifestName: string(manifest)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &Service{
+				scriptures: make(map[string][]Scripture),
+			}
+			if err := service.loadFromZipBytes(buildTestZip(t, tt.files), "test zip"); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			// Data still loads; the mismatch is reported rather than dropping books
+			if len(service.scriptures["Enos"]) != 1 {
+				t.Errorf("Expected Enos to load, got %d verses", len(service.scriptures["Enos"]))
+			}
+			if service.integrity == nil {
+				t.Fatal("Expected integrity status to be recorded")
+			}
+			if service.integrity.OK() != tt.expectOK {
+				t.Errorf("Expected OK() = %v, got %v", tt.expectOK, service.integrity.OK())
+			}
+
+			request := mcp.CallToolRequest{}
+			result, err := service.ServerStatus(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			text := result.Content[0].(mcp.TextContent).Text
+			if strings.Contains(text, "FAILED") == tt.expectOK {
+				t.Errorf("Unexpected integrity line in status:\n%s", text)
+			}
+			if !strings.Contains(text, "- Book of Mormon: 1 books, 1 verses") {
+				t.Errorf("Expected collection counts in status, got:\n%s", text)
+			}
+		})
+	}
+}
+
+func TestService_loadFromZipBytes_DisabledCollections(t *testing.T) {
+	enos := `{"books": [{"book": "Enos", "chapters": [{"chapter": 1, "verses": [{"verse": 1, "text": "Behold", "reference": "Enos 1:1"}]}]}]}`
+	jude := `{"books": [{"book": "Jude", "chapters": [{"chapter": 1, "verses": [{"verse": 1, "text": "Jude", "reference": "Jude 1:1"}]}]}]}`
+	files := map[string][]byte{"book-of-mormon.json": []byte(enos), "new-testament.json": []byte(jude)}
+	manifest, err := json.Marshal(buildManifest(files))
+	if err != nil {
+		t.Fatalf("Failed to marshal manifest: %v", err)
+	}
+	archive := buildTestZip(t, map[string]string{"book-of-mormon.json": enos, "new-testament.json": jude, manifestName: string(manifest)})
+
+	t.Setenv("SCRIPTURES_COLLECTIONS", " book-of-mormon")
+	service := &Service{scriptures: make(map[string][]Scripture)}
+	service.configure()
+	if err := service.loadFromZipBytes(archive, "test zip"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The New Testament entry is neither loaded nor checked, and not missing
+	if _, loaded := service.scriptures["Jude"]; loaded || len(service.scriptures["Enos"]) != 1 {
+		t.Errorf("Expected only the Book of Mormon to load, got %v", service.orderedBooks())
+	}
+	status := service.integrity
+	if !status.OK() || strings.Join(status.Verified, ",") != "book-of-mormon.json" || strings.Join(status.Skipped, ",") != "new-testament.json" {
+		t.Errorf("Expected the New Testament skipped, got %+v", status)
+	}
+	if line := status.String(); !strings.Contains(line, "1 data files match the manifest, 1 skipped (collection disabled)") {
+		t.Errorf("Unexpected integrity line: %s", line)
+	}
+	if check := service.checkCollections(); !check.Passed || !strings.Contains(check.Detail, "SCRIPTURES_COLLECTIONS selection without Old Testament, New Testament") {
+		t.Errorf("Expected the collection check to allow the selection, got %+v", check)
+	}
+
+	// An unknown collection loads every standard work
+	t.Setenv("SCRIPTURES_COLLECTIONS", "Book of Mormon,Hezekiah")
+	service.configure()
+	if service.works != nil || !service.fileEnabled("new-testament.json") {
+		t.Errorf("Expected an invalid selection to be ignored, got %v", service.works)
+	}
+}
+
+func TestService_loadFromZipBytes_License(t *testing.T) {
+	apocrypha := `{"books": [{"book": "Tobit", "chapters": [{"chapter": 1, "verses": [{"verse": 1, "text": "The book of the words of Tobit", "reference": "Tobit 1:1"}]}]}]}`
+	licensed := buildManifest(map[string][]byte{"apocrypha.json": []byte(apocrypha)})
+	unlicensed := buildManifest(map[string][]byte{"apocrypha.json": []byte(apocrypha)})
+	entry := licensed.Files["apocrypha.json"]
+	entry.License = &DatasetLicense{Source: "Test Apocrypha", License: "Public domain", URL: "https://example.com/apocrypha"}
+	licensed.Files["apocrypha.json"] = entry
+
+	tests := []struct {
+		name         string
+		manifest     Manifest
+		expectLoaded bool
+		expectStatus []string
+	}{
+		{
+			name:         "Licensed in manifest",
+			manifest:     licensed,
+			expectLoaded: true,
+			expectStatus: []string{"  Source: Test Apocrypha", "  URL: https://example.com/apocrypha", "  License: Public domain"},
+		},
+		{
+			name:         "No license metadata",
+			manifest:     unlicensed,
+			expectStatus: []string{"no license metadata: apocrypha.json"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest, err := json.Marshal(tt.manifest)
+			if err != nil {
+				t.Fatalf("Failed to marshal manifest: %v", err)
+			}
+			service := &Service{
+				scriptures: make(map[string][]Scripture),
+				optional:   map[string]bool{"apocrypha.json": true},
+			}
+			archive := buildTestZip(t, map[string]string{"apocrypha.json": apocrypha, manifestName: string(manifest)})
+			if err := service.loadFromZipBytes(archive, "test zip"); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if _, loaded := service.scriptures["Tobit"]; loaded != tt.expectLoaded {
+				t.Errorf("Expected Tobit loaded = %v, got %v", tt.expectLoaded, loaded)
+			}
+
+			result, err := service.ServerStatus(context.Background(), mcp.CallToolRequest{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			text := result.Content[0].(mcp.TextContent).Text
+			for _, expected := range tt.expectStatus {
+				if !strings.Contains(text, expected) {
+					t.Errorf("Expected status to contain %q, got:\n%s", expected, text)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildArchive_Manifest(t *testing.T) {
+	archive, err := BuildArchive(map[string][]byte{"book-of-mormon.json": []byte(`{"books": []}`)})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := verifyArchive(archive); err != nil {
+		t.Errorf("Expected freshly built archive to verify, got %v", err)
+	}
+
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	if err := service.loadFromZipBytes(archive, "built"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !service.integrity.Manifest || !service.integrity.OK() {
+		t.Errorf("Expected built archive to match its manifest, got %s", service.integrity.String())
+	}
+}
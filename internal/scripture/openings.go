@@ -0,0 +1,247 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxChapterMatches caps the limit of find_chapter_by_opening
+const maxChapterMatches = 20
+
+// minOpeningWords is the fewest query words that must match a chapter's
+// opening for it to count as an opening match
+const minOpeningWords = 3
+
+// openingSlack is how many words of a chapter's opening may be skipped
+// between two remembered words, as when a phrase is left out
+const openingSlack = 3
+
+// Kinds of chapter match
+const (
+	matchOpening  = "opening"
+	matchKeywords = "keywords"
+)
+
+// ChapterMatch is a chapter found by its opening words or its keywords, with
+// the verse that matched
+type ChapterMatch struct {
+	Chapter      string   `json:"chapter"`
+	Match        string   `json:"match"` // "opening" or "keywords"
+	Score        float64  `json:"score"` // Share of the query's words matched in order for an opening; summed keyword rarity otherwise
+	Reference    string   `json:"reference"`
+	Text         string   `json:"text"`
+	MatchedWords []string `json:"matchedWords,omitempty"` // Keywords found in the chapter
+}
+
+// FindChapterByOpening finds the chapters that begin with the remembered
+// opening words of a passage, such as "I, Nephi, having been born", or
+// else that contain its most distinctive keywords. Opening matches tolerate
+// left-out and misremembered words and come first.
+func (s *Service) FindChapterByOpening(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	query, ok := arguments["query"].(string)
+	if !ok || strings.TrimSpace(query) == "" {
+		return mcp.NewToolResultError("query cannot be empty"), nil
+	}
+	words := s.tokenizer().Words(query)
+	if len(words) == 0 {
+		return mcp.NewToolResultError("query has no words to match"), nil
+	}
+
+	limit := 5
+	if limitVal, ok := arguments["limit"].(float64); ok {
+		limit = min(max(int(limitVal), 1), maxChapterMatches)
+	}
+
+	var scope []*ScriptureReference
+	scopeText, _ := arguments["scope"].(string)
+	if scopeText != "" {
+		scopeRef, err := s.parseSelector(scopeText)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		scope = append(scope, scopeRef)
+	}
+
+	index := s.index
+	if index == nil {
+		index = s.newSearchIndex()
+	}
+	keep := func(verse Scripture) bool { return scope == nil || selectorMatches(scope, verse) }
+	matches := index.openingMatches(words, keep)
+	found := make(map[string]bool, len(matches))
+	for _, match := range matches {
+		found[match.Chapter] = true
+	}
+	for _, match := range index.keywordMatches(ctx, words, keep) {
+		if !found[match.Chapter] {
+			matches = append(matches, match)
+		}
+	}
+	matches = matches[:min(limit, len(matches))]
+
+	structured := ChapterFinderResult{Query: query, Scope: scopeText, Chapters: append([]ChapterMatch{}, matches...)}
+	if len(matches) == 0 {
+		return mcp.NewToolResultStructured(structured, fmt.Sprintf("No chapter found for '%s'.", query)), nil
+	}
+	response := fmt.Sprintf("Chapters for '%s':\n\n", query)
+	for i, match := range matches {
+		switch match.Match {
+		case matchOpening:
+			response += fmt.Sprintf("%d. %s (opening, %.0f%% of words matched)\n", i+1, match.Chapter, match.Score*100)
+		default:
+			response += fmt.Sprintf("%d. %s (keywords: %s)\n", i+1, match.Chapter, strings.Join(match.MatchedWords, ", "))
+		}
+		response += fmt.Sprintf("   %s: %s\n\n", match.Reference, match.Text)
+	}
+	return mcp.NewToolResultStructured(structured, response), nil
+}
+
+// openingMatches returns the chapters whose first verses begin with the
+// words, best first. The words must be found in order near the start of the
+// chapter, allowing a few words of the text to be skipped between them and
+// query words that are not found at all, as long as most are found.
+func (idx *searchIndex) openingMatches(words []string, keep func(Scripture) bool) []ChapterMatch {
+	var matches []ChapterMatch
+	for start := 0; start < len(idx.verses); {
+		verse := idx.verses[start]
+		end := start + 1
+		for end < len(idx.verses) && idx.verses[end].Book == verse.Book && idx.verses[end].Chapter == verse.Chapter {
+			end++
+		}
+		first := start
+		start = end
+		if !keep(verse) {
+			continue
+		}
+
+		// The opening may run past a short first verse
+		var opening []string
+		for i := first; i < end && len(opening) < len(words)+openingSlack*2; i++ {
+			opening = append(opening, idx.tokenizer.Words(idx.verses[i].Text)...)
+		}
+		matched, next := 0, 0
+		for _, word := range words {
+			// The first remembered word must open the chapter
+			from, to := next, min(next+openingSlack, len(opening))
+			if matched == 0 {
+				from, to = 0, min(2, len(opening))
+			}
+			for k := from; k < to; k++ {
+				if opening[k] == word {
+					matched++
+					next = k + 1
+					break
+				}
+			}
+		}
+		if matched < min(minOpeningWords, len(words)) || matched*4 < len(words)*3 {
+			continue
+		}
+		matches = append(matches, ChapterMatch{
+			Chapter:   chapterKey{book: verse.Book, chapter: verse.Chapter}.String(),
+			Match:     matchOpening,
+			Score:     round2(float64(matched) / float64(len(words))),
+			Reference: verse.Reference,
+			Text:      verse.Text,
+		})
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+	return matches
+}
+
+// keywordMatches returns the chapters with the most of the query's
+// keywords, its words other than function words, ranked by how many they
+// have and then by the keywords' rarity across the corpus. A chapter needs
+// at least half of the keywords. Its verse with the most keywords is shown.
+func (idx *searchIndex) keywordMatches(ctx context.Context, words []string, keep func(Scripture) bool) []ChapterMatch {
+	common := make(map[string]bool, len(functionWords))
+	for _, word := range functionWords {
+		common[word] = true
+	}
+	var keywords []string
+	seen := make(map[string]bool)
+	for _, word := range words {
+		if !common[word] && !seen[word] && len(idx.words[word]) > 0 {
+			seen[word] = true
+			keywords = append(keywords, word)
+		}
+	}
+	if len(keywords) == 0 {
+		return nil
+	}
+
+	type chapterScore struct {
+		key   chapterKey
+		words []string
+		score float64
+		best  int32 // Verse with the most keywords
+		first int32 // First verse with a keyword, for canonical order among ties
+	}
+	chapters := make(map[chapterKey]*chapterScore)
+	verseKeywords := make(map[int32]int)
+	for _, word := range keywords {
+		if ctx.Err() != nil {
+			break
+		}
+		idf := idx.idf(word)
+		for _, p := range idx.words[word] {
+			verse := idx.verses[p.id]
+			if !keep(verse) {
+				continue
+			}
+			verseKeywords[p.id]++
+			key := chapterKey{book: verse.Book, chapter: verse.Chapter}
+			chapter, ok := chapters[key]
+			if !ok {
+				chapter = &chapterScore{key: key, best: p.id, first: p.id}
+				chapters[key] = chapter
+			}
+			if n := len(chapter.words); n == 0 || chapter.words[n-1] != word {
+				chapter.words = append(chapter.words, word)
+				chapter.score += idf
+			}
+			if verseKeywords[p.id] > verseKeywords[chapter.best] || (verseKeywords[p.id] == verseKeywords[chapter.best] && p.id < chapter.best) {
+				chapter.best = p.id
+			}
+			chapter.first = min(chapter.first, p.id)
+		}
+	}
+
+	var ranked []*chapterScore
+	for _, chapter := range chapters {
+		if len(chapter.words)*2 >= len(keywords) {
+			ranked = append(ranked, chapter)
+		}
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if len(ranked[i].words) != len(ranked[j].words) {
+			return len(ranked[i].words) > len(ranked[j].words)
+		}
+		if ranked[i].score != ranked[j].score {
+			return ranked[i].score > ranked[j].score
+		}
+		return ranked[i].first < ranked[j].first
+	})
+
+	matches := make([]ChapterMatch, 0, min(len(ranked), maxChapterMatches))
+	for _, chapter := range ranked[:min(len(ranked), maxChapterMatches)] {
+		verse := idx.verses[chapter.best]
+		matches = append(matches, ChapterMatch{
+			Chapter:      chapter.key.String(),
+			Match:        matchKeywords,
+			Score:        round2(chapter.score),
+			Reference:    verse.Reference,
+			Text:         verse.Text,
+			MatchedWords: chapter.words,
+		})
+	}
+	return matches
+}
@@ -0,0 +1,76 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestService_FindChapterByOpening(t *testing.T) {
+	service := NewService()
+	find := func(arguments map[string]any) *mcp.CallToolResult {
+		t.Helper()
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = arguments
+		result, err := service.FindChapterByOpening(context.Background(), request)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	tests := []struct {
+		query   string
+		scope   string
+		chapter string
+		match   string
+	}{
+		{query: "I, Nephi, having been born of goodly parents…", chapter: "1 Nephi 1", match: matchOpening},
+		{query: "I Nephi having been born of good parents", chapter: "1 Nephi 1", match: matchOpening},
+		{query: "in the beginning was the word", chapter: "John 1", match: matchOpening},
+		{query: "And now it came to pass that after Alma", scope: "Alma", chapter: "Alma 6", match: matchOpening},
+		{query: "faith seed swell", chapter: "Alma 32", match: matchKeywords},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			arguments := map[string]any{"query": tt.query}
+			if tt.scope != "" {
+				arguments["scope"] = tt.scope
+			}
+			result := find(arguments)
+			if result.IsError {
+				t.Fatalf("Unexpected error: %+v", result)
+			}
+			chapters := result.StructuredContent.(ChapterFinderResult).Chapters
+			if len(chapters) == 0 || chapters[0].Chapter != tt.chapter || chapters[0].Match != tt.match {
+				t.Fatalf("Expected %s by %s first, got %+v", tt.chapter, tt.match, chapters)
+			}
+		})
+	}
+
+	// Opening matches come before keyword matches
+	chapters := find(map[string]any{"query": "The LORD is my shepherd", "limit": float64(5)}).StructuredContent.(ChapterFinderResult).Chapters
+	if len(chapters) != 5 || chapters[0].Chapter != "Psalms 23" || chapters[0].Score != 1 {
+		t.Fatalf("Expected Psalms 23 first of 5, got %+v", chapters)
+	}
+	for i := 1; i < len(chapters); i++ {
+		if chapters[i-1].Match == matchKeywords && chapters[i].Match == matchOpening {
+			t.Errorf("Expected opening matches first, got %+v", chapters)
+		}
+	}
+
+	if result := find(map[string]any{"query": "xyzzy plugh"}); result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "No chapter found") {
+		t.Errorf("Expected no chapters, got %+v", result)
+	}
+	for _, arguments := range []map[string]any{
+		{},
+		{"query": "—"},
+		{"query": "faith", "scope": "Hezekiah"},
+	} {
+		if result := find(arguments); !result.IsError {
+			t.Errorf("Expected an error for %v", arguments)
+		}
+	}
+}
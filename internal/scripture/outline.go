@@ -0,0 +1,171 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// ChapterSection is a labeled run of verses within a chapter outline
+type ChapterSection struct {
+	Label      string `json:"label"`
+	StartVerse int    `json:"startVerse"`
+	EndVerse   int    `json:"endVerse"`
+}
+
+// Outline segmentation methods
+const (
+	outlineByParagraph = "paragraph"
+	outlineByHeuristic = "heuristic"
+)
+
+// Bounds used by heuristic segmentation when a chapter has no paragraph metadata
+const (
+	minSectionVerses = 4
+	maxSectionVerses = 12
+)
+
+// sectionOpeners are verse openings that commonly begin a new thought
+var sectionOpeners = []string{
+	"and now",
+	"and again",
+	"and it came to pass",
+	"behold",
+	"now",
+	"o ye",
+	"therefore",
+	"verily",
+	"wherefore",
+}
+
+// sectionLabelWords is the number of opening words used to label a section
+const sectionLabelWords = 8
+
+// OutlineChapter splits a chapter into labeled sections with verse ranges
+func (s *Service) OutlineChapter(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	query, ok := arguments["query"].(string)
+	if !ok || query == "" {
+		return mcp.NewToolResultError("chapter reference cannot be empty"), nil
+	}
+
+	ref, err := s.parseChapterReference(query)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid chapter reference: %v", err)), nil
+	}
+
+	scriptures := s.getChapter(ref.Book, ref.Chapter)
+	if len(scriptures) == 0 {
+		structured := OutlineResult{Book: ref.Book, Chapter: ref.Chapter, Sections: []ChapterSection{}}
+		return mcp.NewToolResultStructured(structured, fmt.Sprintf("Chapter '%s' not found.", query)), nil
+	}
+
+	sections, method := outlineSections(scriptures)
+
+	methodLabel := "paragraph breaks"
+	if method == outlineByHeuristic {
+		methodLabel = "heuristic segmentation"
+	}
+	response := fmt.Sprintf("Outline of %s %d (%d verses, %s):\n\n", ref.Book, ref.Chapter, len(scriptures), methodLabel)
+	for i, section := range sections {
+		response += fmt.Sprintf("%d. Verses %d-%d: %s\n", i+1, section.StartVerse, section.EndVerse, section.Label)
+	}
+
+	structured := OutlineResult{Book: ref.Book, Chapter: ref.Chapter, Method: method, Sections: sections}
+	result := mcp.NewToolResultStructured(structured, response)
+	result.Meta = &mcp.Meta{AdditionalFields: map[string]any{
+		"method":   method,
+		"sections": sections,
+	}}
+	return result, nil
+}
+
+// outlineSections segments a chapter's verses, preferring the paragraph
+// markers in the data and falling back to heuristic segmentation
+func outlineSections(verses []Scripture) ([]ChapterSection, string) {
+	hasParagraphs := false
+	for _, verse := range verses[1:] {
+		if verse.Pilcrow {
+			hasParagraphs = true
+			break
+		}
+	}
+
+	var starts []int
+	method := outlineByParagraph
+	if hasParagraphs {
+		for i, verse := range verses {
+			if i == 0 || verse.Pilcrow {
+				starts = append(starts, i)
+			}
+		}
+	} else {
+		method = outlineByHeuristic
+		starts = heuristicSectionStarts(verses)
+	}
+
+	sections := make([]ChapterSection, 0, len(starts))
+	for i, start := range starts {
+		end := len(verses) - 1
+		if i < len(starts)-1 {
+			end = starts[i+1] - 1
+		}
+		sections = append(sections, ChapterSection{
+			Label:      sectionLabel(verses[start].Text),
+			StartVerse: verses[start].Verse,
+			EndVerse:   verses[end].Verse,
+		})
+	}
+	return sections, method
+}
+
+// heuristicSectionStarts picks section starts at verses that open a new
+// thought, keeping sections between minSectionVerses and maxSectionVerses long
+func heuristicSectionStarts(verses []Scripture) []int {
+	starts := []int{0}
+	current := 0
+	for i := 1; i < len(verses); i++ {
+		length := i - current
+		remaining := len(verses) - i
+		if remaining < minSectionVerses {
+			break
+		}
+		if length >= maxSectionVerses || (length >= minSectionVerses && opensSection(verses[i].Text)) {
+			starts = append(starts, i)
+			current = i
+		}
+	}
+	return starts
+}
+
+// opensSection reports whether a verse begins with a common thought-break phrase
+func opensSection(text string) bool {
+	lower := strings.ToLower(strings.TrimSpace(text))
+	for _, opener := range sectionOpeners {
+		if strings.HasPrefix(lower, opener) {
+			rest := lower[len(opener):]
+			if rest == "" || !isLetter(rest[0]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isLetter reports whether an ASCII byte is a letter
+func isLetter(b byte) bool {
+	return (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
+}
+
+// sectionLabel labels a section with the opening words of its first verse
+func sectionLabel(text string) string {
+	words := strings.Fields(text)
+	if len(words) <= sectionLabelWords {
+		return strings.Join(words, " ")
+	}
+	label := strings.Join(words[:sectionLabelWords], " ")
+	return strings.TrimRight(label, ",;:") + "…"
+}
@@ -0,0 +1,159 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// makeVerses builds a chapter of n placeholder verses, with texts overriding individual verses
+func makeVerses(book string, chapter, n int, texts map[int]string) []Scripture {
+	verses := make([]Scripture, 0, n)
+	for i := 1; i <= n; i++ {
+		text, ok := texts[i]
+		if !ok {
+			text = "And they did go forth"
+		}
+		verses = append(verses, Scripture{
+			Book:      book,
+			Chapter:   chapter,
+			Verse:     i,
+			Text:      text,
+			Reference: fmt.Sprintf("%s %d:%d", book, chapter, i),
+		})
+	}
+	return verses
+}
+
+func TestOutlineSections(t *testing.T) {
+	t.Run("Paragraph breaks", func(t *testing.T) {
+		verses := makeVerses("Matthew", 5, 6, map[int]string{1: "And seeing the multitudes, he went up into a mountain", 4: "Blessed are they that mourn"})
+		verses[0].Pilcrow = true
+		verses[3].Pilcrow = true
+
+		sections, method := outlineSections(verses)
+		if method != outlineByParagraph {
+			t.Errorf("Expected paragraph method, got %s", method)
+		}
+		expected := []ChapterSection{
+			{Label: "And seeing the multitudes, he went up into…", StartVerse: 1, EndVerse: 3},
+			{Label: "Blessed are they that mourn", StartVerse: 4, EndVerse: 6},
+		}
+		if !reflect.DeepEqual(sections, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, sections)
+		}
+	})
+
+	t.Run("Heuristic openers", func(t *testing.T) {
+		verses := makeVerses("Alma", 5, 14, map[int]string{6: "And now behold, I say unto you", 3: "Behold, too early"})
+
+		sections, method := outlineSections(verses)
+		if method != outlineByHeuristic {
+			t.Errorf("Expected heuristic method, got %s", method)
+		}
+		if len(sections) != 2 || sections[1].StartVerse != 6 || sections[1].EndVerse != 14 {
+			t.Errorf("Expected a section break at verse 6, got %+v", sections)
+		}
+	})
+
+	t.Run("Heuristic maximum length", func(t *testing.T) {
+		verses := makeVerses("Alma", 5, 30, nil)
+
+		sections, _ := outlineSections(verses)
+		for _, section := range sections {
+			if length := section.EndVerse - section.StartVerse + 1; length > maxSectionVerses+minSectionVerses {
+				t.Errorf("Section %+v is too long", section)
+			}
+		}
+		if sections[len(sections)-1].EndVerse != 30 {
+			t.Errorf("Expected outline to cover the whole chapter, got %+v", sections)
+		}
+	})
+}
+
+func TestOpensSection(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected bool
+	}{
+		{"And now, my brethren", true},
+		{"Behold, I say unto you", true},
+		{"Nowhere did they find it", false},
+		{"For God so loved the world", false},
+	}
+
+	for _, tt := range tests {
+		if got := opensSection(tt.text); got != tt.expected {
+			t.Errorf("opensSection(%q) = %v, expected %v", tt.text, got, tt.expected)
+		}
+	}
+}
+
+func TestService_OutlineChapter(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	service.scriptures["Alma"] = makeVerses("Alma", 5, 20, map[int]string{9: "And now I ask of you, my brethren"})
+
+	tests := []struct {
+		name          string
+		arguments     map[string]interface{}
+		expectError   bool
+		shouldContain string
+	}{
+		{
+			name:          "Valid chapter",
+			arguments:     map[string]interface{}{"query": "Alma 5"},
+			shouldContain: "2. Verses 9-20: And now I ask of you, my brethren",
+		},
+		{
+			name:          "Unknown chapter",
+			arguments:     map[string]interface{}{"query": "Alma 99"},
+			shouldContain: "not found",
+		},
+		{
+			name:        "Invalid reference",
+			arguments:   map[string]interface{}{"query": "Alma 5:1"},
+			expectError: true,
+		},
+		{
+			name:        "Missing query",
+			arguments:   map[string]interface{}{},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+			result, err := service.OutlineChapter(context.Background(), request)
+
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			if tt.expectError {
+				if !result.IsError {
+					t.Error("Expected error result but got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Error("Expected success but got error result")
+			}
+
+			text := result.Content[0].(mcp.TextContent).Text
+			if !strings.Contains(text, tt.shouldContain) {
+				t.Errorf("Expected output to contain %q, got:\n%s", tt.shouldContain, text)
+			}
+		})
+	}
+}
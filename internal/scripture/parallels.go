@@ -0,0 +1,204 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// parallelSpan aligns a run of verses verse-for-verse with the source passage it quotes
+type parallelSpan struct {
+	Set           string
+	Book          string
+	Chapter       int
+	StartVerse    int
+	EndVerse      int
+	Source        string
+	SourceChapter int
+	SourceStart   int
+}
+
+// ParallelPair is one verse aligned with its source verse and the word-level
+// changes from the source to the quoting text
+type ParallelPair struct {
+	Set             string        `json:"set"`
+	SourceReference string        `json:"sourceReference"`
+	SourceText      string        `json:"sourceText"`
+	Reference       string        `json:"reference"`
+	Text            string        `json:"text"`
+	Diff            []DiffSegment `json:"diff"`
+}
+
+// Parallel sets
+const (
+	// isaiahInBookOfMormon is the alignment of the Isaiah chapters quoted in
+	// the Book of Mormon with their Isaiah counterparts
+	isaiahInBookOfMormon = "Isaiah in the Book of Mormon"
+
+	// mosesAndGenesis aligns the Book of Moses with the Genesis chapters it
+	// revises. Verses Moses adds (such as Moses 1 and 7) have no counterpart.
+	mosesAndGenesis = "Book of Moses and Genesis"
+
+	// abrahamAndGenesis aligns the Book of Abraham with the Genesis accounts
+	// of Abraham's journey and the Creation
+	abrahamAndGenesis = "Book of Abraham and Genesis"
+)
+
+// parallelSpans is the alignment dataset behind get_parallel_passages
+var parallelSpans = []parallelSpan{
+	{Set: isaiahInBookOfMormon, Book: "1 Nephi", Chapter: 20, StartVerse: 1, EndVerse: 22, Source: "Isaiah", SourceChapter: 48, SourceStart: 1},
+	{Set: isaiahInBookOfMormon, Book: "1 Nephi", Chapter: 21, StartVerse: 1, EndVerse: 26, Source: "Isaiah", SourceChapter: 49, SourceStart: 1},
+	{Set: isaiahInBookOfMormon, Book: "2 Nephi", Chapter: 7, StartVerse: 1, EndVerse: 11, Source: "Isaiah", SourceChapter: 50, SourceStart: 1},
+	{Set: isaiahInBookOfMormon, Book: "2 Nephi", Chapter: 8, StartVerse: 1, EndVerse: 23, Source: "Isaiah", SourceChapter: 51, SourceStart: 1},
+	{Set: isaiahInBookOfMormon, Book: "2 Nephi", Chapter: 8, StartVerse: 24, EndVerse: 25, Source: "Isaiah", SourceChapter: 52, SourceStart: 1},
+	{Set: isaiahInBookOfMormon, Book: "2 Nephi", Chapter: 12, StartVerse: 1, EndVerse: 22, Source: "Isaiah", SourceChapter: 2, SourceStart: 1},
+	{Set: isaiahInBookOfMormon, Book: "2 Nephi", Chapter: 13, StartVerse: 1, EndVerse: 26, Source: "Isaiah", SourceChapter: 3, SourceStart: 1},
+	{Set: isaiahInBookOfMormon, Book: "2 Nephi", Chapter: 14, StartVerse: 1, EndVerse: 6, Source: "Isaiah", SourceChapter: 4, SourceStart: 1},
+	{Set: isaiahInBookOfMormon, Book: "2 Nephi", Chapter: 15, StartVerse: 1, EndVerse: 30, Source: "Isaiah", SourceChapter: 5, SourceStart: 1},
+	{Set: isaiahInBookOfMormon, Book: "2 Nephi", Chapter: 16, StartVerse: 1, EndVerse: 13, Source: "Isaiah", SourceChapter: 6, SourceStart: 1},
+	{Set: isaiahInBookOfMormon, Book: "2 Nephi", Chapter: 17, StartVerse: 1, EndVerse: 25, Source: "Isaiah", SourceChapter: 7, SourceStart: 1},
+	{Set: isaiahInBookOfMormon, Book: "2 Nephi", Chapter: 18, StartVerse: 1, EndVerse: 22, Source: "Isaiah", SourceChapter: 8, SourceStart: 1},
+	{Set: isaiahInBookOfMormon, Book: "2 Nephi", Chapter: 19, StartVerse: 1, EndVerse: 21, Source: "Isaiah", SourceChapter: 9, SourceStart: 1},
+	{Set: isaiahInBookOfMormon, Book: "2 Nephi", Chapter: 20, StartVerse: 1, EndVerse: 34, Source: "Isaiah", SourceChapter: 10, SourceStart: 1},
+	{Set: isaiahInBookOfMormon, Book: "2 Nephi", Chapter: 21, StartVerse: 1, EndVerse: 16, Source: "Isaiah", SourceChapter: 11, SourceStart: 1},
+	{Set: isaiahInBookOfMormon, Book: "2 Nephi", Chapter: 22, StartVerse: 1, EndVerse: 6, Source: "Isaiah", SourceChapter: 12, SourceStart: 1},
+	{Set: isaiahInBookOfMormon, Book: "2 Nephi", Chapter: 23, StartVerse: 1, EndVerse: 22, Source: "Isaiah", SourceChapter: 13, SourceStart: 1},
+	{Set: isaiahInBookOfMormon, Book: "2 Nephi", Chapter: 24, StartVerse: 1, EndVerse: 32, Source: "Isaiah", SourceChapter: 14, SourceStart: 1},
+	{Set: isaiahInBookOfMormon, Book: "Mosiah", Chapter: 14, StartVerse: 1, EndVerse: 12, Source: "Isaiah", SourceChapter: 53, SourceStart: 1},
+	{Set: isaiahInBookOfMormon, Book: "3 Nephi", Chapter: 22, StartVerse: 1, EndVerse: 17, Source: "Isaiah", SourceChapter: 54, SourceStart: 1},
+
+	{Set: mosesAndGenesis, Book: "Moses", Chapter: 2, StartVerse: 1, EndVerse: 31, Source: "Genesis", SourceChapter: 1, SourceStart: 1},
+	{Set: mosesAndGenesis, Book: "Moses", Chapter: 3, StartVerse: 1, EndVerse: 25, Source: "Genesis", SourceChapter: 2, SourceStart: 1},
+	{Set: mosesAndGenesis, Book: "Moses", Chapter: 4, StartVerse: 7, EndVerse: 29, Source: "Genesis", SourceChapter: 3, SourceStart: 1},
+	{Set: mosesAndGenesis, Book: "Moses", Chapter: 4, StartVerse: 31, EndVerse: 31, Source: "Genesis", SourceChapter: 3, SourceStart: 24},
+	{Set: mosesAndGenesis, Book: "Moses", Chapter: 5, StartVerse: 16, EndVerse: 17, Source: "Genesis", SourceChapter: 4, SourceStart: 1},
+	{Set: mosesAndGenesis, Book: "Moses", Chapter: 5, StartVerse: 19, EndVerse: 23, Source: "Genesis", SourceChapter: 4, SourceStart: 3},
+	{Set: mosesAndGenesis, Book: "Moses", Chapter: 5, StartVerse: 32, EndVerse: 32, Source: "Genesis", SourceChapter: 4, SourceStart: 8},
+	{Set: mosesAndGenesis, Book: "Moses", Chapter: 5, StartVerse: 34, EndVerse: 44, Source: "Genesis", SourceChapter: 4, SourceStart: 9},
+	{Set: mosesAndGenesis, Book: "Moses", Chapter: 5, StartVerse: 45, EndVerse: 48, Source: "Genesis", SourceChapter: 4, SourceStart: 21},
+	{Set: mosesAndGenesis, Book: "Moses", Chapter: 6, StartVerse: 2, EndVerse: 2, Source: "Genesis", SourceChapter: 4, SourceStart: 25},
+	{Set: mosesAndGenesis, Book: "Moses", Chapter: 6, StartVerse: 4, EndVerse: 4, Source: "Genesis", SourceChapter: 4, SourceStart: 26},
+	{Set: mosesAndGenesis, Book: "Moses", Chapter: 6, StartVerse: 8, EndVerse: 14, Source: "Genesis", SourceChapter: 5, SourceStart: 1},
+	{Set: mosesAndGenesis, Book: "Moses", Chapter: 6, StartVerse: 16, EndVerse: 18, Source: "Genesis", SourceChapter: 5, SourceStart: 8},
+	{Set: mosesAndGenesis, Book: "Moses", Chapter: 6, StartVerse: 24, EndVerse: 25, Source: "Genesis", SourceChapter: 5, SourceStart: 20},
+	{Set: mosesAndGenesis, Book: "Moses", Chapter: 8, StartVerse: 5, EndVerse: 12, Source: "Genesis", SourceChapter: 5, SourceStart: 25},
+	{Set: mosesAndGenesis, Book: "Moses", Chapter: 8, StartVerse: 25, EndVerse: 26, Source: "Genesis", SourceChapter: 6, SourceStart: 6},
+	{Set: mosesAndGenesis, Book: "Moses", Chapter: 8, StartVerse: 28, EndVerse: 30, Source: "Genesis", SourceChapter: 6, SourceStart: 11},
+
+	{Set: abrahamAndGenesis, Book: "Abraham", Chapter: 2, StartVerse: 3, EndVerse: 3, Source: "Genesis", SourceChapter: 12, SourceStart: 1},
+	{Set: abrahamAndGenesis, Book: "Abraham", Chapter: 2, StartVerse: 4, EndVerse: 4, Source: "Genesis", SourceChapter: 11, SourceStart: 31},
+	{Set: abrahamAndGenesis, Book: "Abraham", Chapter: 2, StartVerse: 9, EndVerse: 9, Source: "Genesis", SourceChapter: 12, SourceStart: 2},
+	{Set: abrahamAndGenesis, Book: "Abraham", Chapter: 2, StartVerse: 14, EndVerse: 15, Source: "Genesis", SourceChapter: 12, SourceStart: 4},
+	{Set: abrahamAndGenesis, Book: "Abraham", Chapter: 2, StartVerse: 19, EndVerse: 20, Source: "Genesis", SourceChapter: 12, SourceStart: 7},
+	{Set: abrahamAndGenesis, Book: "Abraham", Chapter: 2, StartVerse: 21, EndVerse: 23, Source: "Genesis", SourceChapter: 12, SourceStart: 10},
+	{Set: abrahamAndGenesis, Book: "Abraham", Chapter: 2, StartVerse: 25, EndVerse: 25, Source: "Genesis", SourceChapter: 12, SourceStart: 13},
+	{Set: abrahamAndGenesis, Book: "Abraham", Chapter: 4, StartVerse: 1, EndVerse: 31, Source: "Genesis", SourceChapter: 1, SourceStart: 1},
+	{Set: abrahamAndGenesis, Book: "Abraham", Chapter: 5, StartVerse: 1, EndVerse: 10, Source: "Genesis", SourceChapter: 2, SourceStart: 1},
+	{Set: abrahamAndGenesis, Book: "Abraham", Chapter: 5, StartVerse: 11, EndVerse: 14, Source: "Genesis", SourceChapter: 2, SourceStart: 15},
+	{Set: abrahamAndGenesis, Book: "Abraham", Chapter: 5, StartVerse: 15, EndVerse: 19, Source: "Genesis", SourceChapter: 2, SourceStart: 21},
+	{Set: abrahamAndGenesis, Book: "Abraham", Chapter: 5, StartVerse: 20, EndVerse: 21, Source: "Genesis", SourceChapter: 2, SourceStart: 19},
+}
+
+// GetParallelPassages retrieves the aligned parallel of a passage with a word-level diff
+func (s *Service) GetParallelPassages(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	query, ok := arguments["query"].(string)
+	if !ok || query == "" {
+		return mcp.NewToolResultError("scripture reference cannot be empty"), nil
+	}
+
+	ref, err := s.parseReference(query)
+	if err != nil {
+		// A whole chapter is also accepted
+		chapterRef, chapterErr := s.parseChapterReference(query)
+		if chapterErr != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid scripture reference: %v", err)), nil
+		}
+		ref = chapterRef
+		ref.Verse = 1
+		ref.EndVerse = math.MaxInt
+	}
+
+	pairs := s.parallelPairs(ref)
+	structured := ParallelResult{Reference: query, Pairs: append([]ParallelPair{}, pairs...)}
+	if len(pairs) == 0 {
+		return mcp.NewToolResultStructured(structured, fmt.Sprintf("No parallel passages known for '%s'.", query)), nil
+	}
+
+	response := fmt.Sprintf("Parallel passages for '%s':\n\n", query)
+	for i, pair := range pairs {
+		if i == 0 || pairs[i-1].Set != pair.Set {
+			response += fmt.Sprintf("== %s ==\n", pair.Set)
+		}
+		response += fmt.Sprintf("%s → %s\n", pair.SourceReference, pair.Reference)
+		response += fmt.Sprintf("  %s: %s\n", pair.SourceReference, pair.SourceText)
+		response += fmt.Sprintf("  %s: %s\n", pair.Reference, pair.Text)
+		if diffChanged(pair.Diff) {
+			response += fmt.Sprintf("  Changes: %s\n\n", renderDiff(pair.Diff))
+		} else {
+			response += "  Changes: none\n\n"
+		}
+	}
+
+	result := mcp.NewToolResultStructured(structured, response)
+	result.Meta = &mcp.Meta{AdditionalFields: map[string]any{"pairs": pairs}}
+	return result, nil
+}
+
+// parallelPairs aligns every verse of a reference, from either side of a span, with its parallel
+func (s *Service) parallelPairs(ref *ScriptureReference) []ParallelPair {
+	var pairs []ParallelPair
+	for _, span := range parallelSpans {
+		offset := 0
+		switch {
+		case span.Book == ref.Book && span.Chapter == ref.Chapter:
+			offset = 0
+		case span.Source == ref.Book && span.SourceChapter == ref.Chapter:
+			// Translate the source-side range onto the quoting side
+			offset = span.StartVerse - span.SourceStart
+		default:
+			continue
+		}
+
+		start := max(span.StartVerse, clampedAdd(ref.Verse, offset))
+		end := min(span.EndVerse, clampedAdd(ref.EndVerse, offset))
+		for verse := start; verse <= end; verse++ {
+			sourceVerse := span.SourceStart + (verse - span.StartVerse)
+			quoted := s.findVerse(span.Book, span.Chapter, verse)
+			source := s.findVerse(span.Source, span.SourceChapter, sourceVerse)
+			if quoted == nil || source == nil {
+				continue
+			}
+			pairs = append(pairs, ParallelPair{
+				Set:             span.Set,
+				SourceReference: fmt.Sprintf("%s %d:%d", source.Book, source.Chapter, source.Verse),
+				SourceText:      source.Text,
+				Reference:       fmt.Sprintf("%s %d:%d", quoted.Book, quoted.Chapter, quoted.Verse),
+				Text:            quoted.Text,
+				Diff:            wordDiff(source.Text, quoted.Text),
+			})
+		}
+	}
+	return pairs
+}
+
+// clampedAdd adds an offset to a verse number without overflowing open-ended ranges
+func clampedAdd(verse, offset int) int {
+	if verse == math.MaxInt {
+		return verse
+	}
+	return verse + offset
+}
+
+// findVerse returns a single verse, or nil if it is not loaded
+func (s *Service) findVerse(book string, chapter, verse int) *Scripture {
+	for i, scripture := range s.scriptures[book] {
+		if scripture.Chapter == chapter && scripture.Verse == verse {
+			return &s.scriptures[book][i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestService_parallelPairs(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+
+	// Add test data
+	service.scriptures["Isaiah"] = []Scripture{
+		{Book: "Isaiah", Chapter: 2, Verse: 16, Text: "And upon all the ships of Tarshish", Reference: "Isaiah 2:16"},
+		{Book: "Isaiah", Chapter: 52, Verse: 2, Text: "Shake thyself from the dust; arise, and sit down", Reference: "Isaiah 52:2"},
+	}
+	service.scriptures["2 Nephi"] = []Scripture{
+		{Book: "2 Nephi", Chapter: 8, Verse: 25, Text: "Shake thyself from the dust; arise, sit down", Reference: "2 Nephi 8:25"},
+		{Book: "2 Nephi", Chapter: 12, Verse: 16, Text: "And upon all the ships of the sea, and upon all the ships of Tarshish", Reference: "2 Nephi 12:16"},
+	}
+
+	tests := []struct {
+		name              string
+		reference         *ScriptureReference
+		expectedCount     int
+		expectedReference string
+		expectedSource    string
+	}{
+		{
+			name:              "Quoting side",
+			reference:         &ScriptureReference{Book: "2 Nephi", Chapter: 12, Verse: 16, EndVerse: 16},
+			expectedCount:     1,
+			expectedReference: "2 Nephi 12:16",
+			expectedSource:    "Isaiah 2:16",
+		},
+		{
+			name:              "Source side with offset",
+			reference:         &ScriptureReference{Book: "Isaiah", Chapter: 52, Verse: 1, EndVerse: 3},
+			expectedCount:     1,
+			expectedReference: "2 Nephi 8:25",
+			expectedSource:    "Isaiah 52:2",
+		},
+		{
+			name:          "No parallel",
+			reference:     &ScriptureReference{Book: "Alma", Chapter: 32, Verse: 21, EndVerse: 21},
+			expectedCount: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pairs := service.parallelPairs(tt.reference)
+			if len(pairs) != tt.expectedCount {
+				t.Fatalf("Expected %d pairs, got %d", tt.expectedCount, len(pairs))
+			}
+			if tt.expectedCount == 0 {
+				return
+			}
+			if pairs[0].Reference != tt.expectedReference || pairs[0].SourceReference != tt.expectedSource {
+				t.Errorf("Expected %s → %s, got %s → %s", tt.expectedSource, tt.expectedReference, pairs[0].SourceReference, pairs[0].Reference)
+			}
+		})
+	}
+}
+
+func TestService_GetParallelPassages(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+
+	// Add test data
+	service.scriptures["Isaiah"] = []Scripture{
+		{Book: "Isaiah", Chapter: 2, Verse: 16, Text: "And upon all the ships of Tarshish", Reference: "Isaiah 2:16"},
+	}
+	service.scriptures["2 Nephi"] = []Scripture{
+		{Book: "2 Nephi", Chapter: 12, Verse: 16, Text: "And upon all the ships of the sea, and upon all the ships of Tarshish", Reference: "2 Nephi 12:16"},
+	}
+
+	tests := []struct {
+		name          string
+		arguments     map[string]interface{}
+		expectError   bool
+		shouldContain string
+	}{
+		{
+			name:          "Verse reference",
+			arguments:     map[string]interface{}{"query": "2 Nephi 12:16"},
+			shouldContain: "Changes: And upon all the ships of {+the sea, and upon all the ships of+} Tarshish",
+		},
+		{
+			name:          "Chapter reference",
+			arguments:     map[string]interface{}{"query": "Isaiah 2"},
+			shouldContain: "Isaiah 2:16 → 2 Nephi 12:16",
+		},
+		{
+			name:          "No parallel",
+			arguments:     map[string]interface{}{"query": "Alma 32:21"},
+			shouldContain: "No parallel passages known",
+		},
+		{
+			name:        "Invalid reference",
+			arguments:   map[string]interface{}{"query": "invalid reference"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+			result, err := service.GetParallelPassages(context.Background(), request)
+
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			if tt.expectError {
+				if !result.IsError {
+					t.Error("Expected error result but got success")
+				}
+				return
+			}
+
+			text := result.Content[0].(mcp.TextContent).Text
+			if !strings.Contains(text, tt.shouldContain) {
+				t.Errorf("Expected output to contain %q, got:\n%s", tt.shouldContain, text)
+			}
+		})
+	}
+}
+
+func TestService_parallelPairs_Genesis(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+
+	// Add test data
+	service.scriptures["Genesis"] = []Scripture{
+		{Book: "Genesis", Chapter: 1, Verse: 1, Text: "In the beginning God created the heaven and the earth.", Reference: "Genesis 1:1"},
+	}
+	service.scriptures["Moses"] = []Scripture{
+		{Book: "Moses", Chapter: 2, Verse: 1, Text: "I am the Beginning and the End; by mine Only Begotten I created these things.", Reference: "Moses 2:1"},
+	}
+	service.scriptures["Abraham"] = []Scripture{
+		{Book: "Abraham", Chapter: 4, Verse: 1, Text: "And they, that is the Gods, organized and formed the heavens and the earth.", Reference: "Abraham 4:1"},
+	}
+
+	pairs := service.parallelPairs(&ScriptureReference{Book: "Genesis", Chapter: 1, Verse: 1, EndVerse: 1})
+	if len(pairs) != 2 {
+		t.Fatalf("Expected 2 pairs, got %d", len(pairs))
+	}
+	if pairs[0].Set != mosesAndGenesis || pairs[0].Reference != "Moses 2:1" {
+		t.Errorf("Expected Moses 2:1 in %q first, got %s in %q", mosesAndGenesis, pairs[0].Reference, pairs[0].Set)
+	}
+	if pairs[1].Set != abrahamAndGenesis || pairs[1].Reference != "Abraham 4:1" {
+		t.Errorf("Expected Abraham 4:1 in %q second, got %s in %q", abrahamAndGenesis, pairs[1].Reference, pairs[1].Set)
+	}
+
+	// Verses Moses adds have no counterpart
+	if pairs := service.parallelPairs(&ScriptureReference{Book: "Moses", Chapter: 1, Verse: 1, EndVerse: 42}); len(pairs) != 0 {
+		t.Errorf("Expected no pairs for Moses 1, got %d", len(pairs))
+	}
+}
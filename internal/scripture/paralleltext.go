@@ -0,0 +1,182 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Layouts of parallel_text
+const (
+	layoutInterleaved = "interleaved"
+	layoutAligned     = "aligned"
+)
+
+// LanguageText is a verse's text in one language edition
+type LanguageText struct {
+	Language  string `json:"language"`
+	Reference string `json:"reference"` // In the edition's own book names, e.g. "1 Nefi 3:7"
+	Text      string `json:"text"`
+}
+
+// ParallelVerse is a verse with its text in each language edition that has it
+type ParallelVerse struct {
+	Chapter int            `json:"chapter"`
+	Verse   int            `json:"verse"`
+	Texts   []LanguageText `json:"texts"`
+}
+
+// ParallelText returns a chapter or verse range in two language editions,
+// verse by verse, for language learners and missionaries studying in a new
+// language. The reference may use either edition's book names.
+func (s *Service) ParallelText(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	query, ok := arguments["query"].(string)
+	if !ok || query == "" {
+		return mcp.NewToolResultError("scripture reference cannot be empty"), nil
+	}
+
+	layout := layoutInterleaved
+	if value, _ := arguments["layout"].(string); value != "" {
+		layout = strings.ToLower(strings.TrimSpace(value))
+		if layout != layoutInterleaved && layout != layoutAligned {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid layout '%s'; use interleaved or aligned", value)), nil
+		}
+	}
+
+	if !s.hasDataset(DatasetLanguageEditions) {
+		return datasetUnavailable(DatasetLanguageEditions), nil
+	}
+	// By default this edition and the first other in alphabetical order
+	languages := []string{s.languageCode()}
+	for _, language := range s.languages() {
+		if len(languages) < 2 && language != s.languageCode() {
+			languages = append(languages, language)
+		}
+	}
+	if list, _ := arguments["languages"].(string); strings.TrimSpace(list) != "" {
+		languages = nil
+		for _, language := range strings.Split(list, ",") {
+			languages = append(languages, strings.ToLower(strings.TrimSpace(language)))
+		}
+		if len(languages) != 2 || languages[0] == languages[1] {
+			return mcp.NewToolResultError("languages must name two different language editions, e.g. \"en,es\""), nil
+		}
+	}
+	editions := make([]*Service, len(languages))
+	for i, language := range languages {
+		edition, err := s.edition(map[string]any{"language": language})
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		editions[i] = edition
+	}
+
+	// Resolve the reference in whichever edition knows its book name
+	ref, from := (*ScriptureReference)(nil), -1
+	for i, edition := range editions {
+		if parsed, err := edition.parseSelector(query); err == nil && parsed.Chapter > 0 {
+			ref, from = parsed, i
+			break
+		}
+	}
+	if ref == nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid chapter or verse reference '%s' in the %s editions", query, strings.Join(languages, " and "))), nil
+	}
+	verses := editions[from].getChapter(ref.Book, ref.Chapter)
+	var selected []Scripture
+	for _, verse := range verses {
+		if verse.Verse >= ref.Verse && verse.Verse <= ref.EndVerse {
+			selected = append(selected, verse)
+		}
+	}
+	if len(selected) == 0 {
+		return mcp.NewToolResultError(fmt.Sprintf("no verses found for '%s'", query)), nil
+	}
+
+	books := make([]string, len(editions))
+	for i, edition := range editions {
+		book, err := editions[from].counterpartBook(edition, ref.Book)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		books[i] = book
+	}
+
+	structured := ParallelTextResult{
+		Reference: spanReference(selected, verseSpan{Start: 0, End: len(selected) - 1}),
+		Languages: languages,
+		Layout:    layout,
+	}
+
+	upper := strings.ToUpper(strings.Join(languages, " / "))
+	response := fmt.Sprintf("Parallel text of %s (%s):\n\n", structured.Reference, upper)
+	if layout == layoutAligned {
+		response += fmt.Sprintf("| Verse | %s | %s |\n|---|---|---|\n", strings.ToUpper(languages[0]), strings.ToUpper(languages[1]))
+	}
+	for _, verse := range selected {
+		parallel := ParallelVerse{Chapter: verse.Chapter, Verse: verse.Verse, Texts: []LanguageText{}}
+		columns := make([]string, len(editions))
+		for i, edition := range editions {
+			single := &ScriptureReference{Book: books[i], Chapter: verse.Chapter, Verse: verse.Verse, EndVerse: verse.Verse}
+			found := edition.getScripturesByReference(single)
+			if len(found) == 0 {
+				columns[i] = "(not in this edition)"
+				continue
+			}
+			parallel.Texts = append(parallel.Texts, LanguageText{Language: languages[i], Reference: found[0].Reference, Text: found[0].Text})
+			columns[i] = found[0].Text
+		}
+		structured.Verses = append(structured.Verses, parallel)
+
+		if layout == layoutAligned {
+			response += fmt.Sprintf("| %d | %s | %s |\n", verse.Verse, strings.ReplaceAll(columns[0], "|", "\\|"), strings.ReplaceAll(columns[1], "|", "\\|"))
+			continue
+		}
+		response += fmt.Sprintf("%d\n", verse.Verse)
+		for i, column := range columns {
+			response += fmt.Sprintf("  %s: %s\n", strings.ToUpper(languages[i]), column)
+		}
+		response += "\n"
+	}
+	return mcp.NewToolResultStructured(structured, response), nil
+}
+
+// counterpartBook returns the name in another edition of one of this
+// edition's books. Editions name books in their own language, so a book is
+// matched by name when both use the same one and otherwise by its position
+// within its collection's data file, which needs both editions to have the
+// whole collection. Load order is used because a translated edition's book
+// names are not in the canonical book lists.
+func (s *Service) counterpartBook(other *Service, book string) (string, error) {
+	if other == s {
+		return book, nil
+	}
+	if _, ok := other.scriptures[book]; ok {
+		return book, nil
+	}
+	collection := s.bookCollections[book]
+	var mine, theirs []string
+	for _, name := range s.loadedBooks() {
+		if s.bookCollections[name] == collection {
+			mine = append(mine, name)
+		}
+	}
+	for _, name := range other.loadedBooks() {
+		if other.bookCollections[name] == collection {
+			theirs = append(theirs, name)
+		}
+	}
+	if collection == "" || len(mine) != len(theirs) {
+		return "", fmt.Errorf("cannot match %s across the %s and %s editions: their %s has %d and %d books", book, s.languageCode(), other.languageCode(), collection, len(mine), len(theirs))
+	}
+	for i, name := range mine {
+		if name == book {
+			return theirs[i], nil
+		}
+	}
+	return "", fmt.Errorf("book not found: %s", book)
+}
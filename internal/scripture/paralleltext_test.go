@@ -0,0 +1,120 @@
+package scripture
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// testSpanishBooks are the Spanish names of the Book of Mormon books, in order
+var testSpanishBooks = []string{
+	"1 Nefi", "2 Nefi", "Jacob", "Enós", "Jarom", "Omni", "Palabras de Mormón", "Mosíah",
+	"Alma", "Helamán", "3 Nefi", "4 Nefi", "Mormón", "Éter", "Moroni",
+}
+
+// testPortugueseEdition is a one-verse Portuguese edition of the Book of Mormon
+const testPortugueseEdition = `{"books": [{"book": "1 Néfi", "chapters": [{"chapter": 3, "verses": [
+	{"verse": 7, "reference": "1 Néfi 3:7", "text": "E aconteceu que eu, Néfi, disse a meu pai: Eu irei e cumprirei as ordens do Senhor."}
+]}]}]}`
+
+func TestService_ParallelText(t *testing.T) {
+	state := t.TempDir()
+	t.Setenv("SCRIPTURES_STATE_DIR", state)
+	t.Setenv("SCRIPTURES_DATA_DIR", "")
+
+	// A Spanish Book of Mormon with every book, and a Portuguese one with only 1 Nefi
+	var books []Book
+	for _, name := range testSpanishBooks {
+		book := Book{Book: name, Chapters: []Chapter{{Chapter: 1, Verses: []Verse{{Verse: 1, Reference: name + " 1:1", Text: "Primer versículo de " + name + "."}}}}}
+		if name == "1 Nefi" {
+			book.Chapters = append(book.Chapters, Chapter{Chapter: 3, Verses: []Verse{
+				{Verse: 7, Reference: "1 Nefi 3:7", Text: "Y aconteció que yo, Nefi, dije a mi padre: Iré y haré lo que el Señor ha mandado."},
+			}})
+		}
+		books = append(books, book)
+	}
+	spanish, err := json.Marshal(map[string]any{"books": books})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for language, data := range map[string][]byte{"es": spanish, "pt": []byte(testPortugueseEdition)} {
+		dir := filepath.Join(state, "data", language)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatalf("Failed to create edition dir: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "book-of-mormon.json"), data, 0644); err != nil {
+			t.Fatalf("Failed to write edition: %v", err)
+		}
+	}
+
+	service := NewService()
+	call := func(arguments map[string]any) *mcp.CallToolResult {
+		t.Helper()
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = arguments
+		result, err := service.ParallelText(context.Background(), request)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	result := call(map[string]any{"query": "1 Nephi 3:6-7"})
+	if result.IsError {
+		t.Fatalf("Unexpected error: %+v", result)
+	}
+	structured := result.StructuredContent.(ParallelTextResult)
+	if structured.Reference != "1 Nephi 3:6-7" || strings.Join(structured.Languages, ",") != "en,es" || structured.Layout != layoutInterleaved || len(structured.Verses) != 2 {
+		t.Fatalf("Unexpected parallel text: %+v", structured)
+	}
+	if texts := structured.Verses[1].Texts; len(texts) != 2 || texts[1].Reference != "1 Nefi 3:7" || !strings.Contains(texts[1].Text, "Iré y haré") {
+		t.Errorf("Expected 1 Nephi 3:7 in both editions, got %+v", texts)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "ES: (not in this edition)") || !strings.Contains(text, "EN: And it came to pass that I, Nephi") {
+		t.Errorf("Expected interleaved verses, got %q", text)
+	}
+
+	// Either edition's book names work, and books match by position
+	result = call(map[string]any{"query": "Éter 1", "languages": "es, en", "layout": "aligned"})
+	if result.IsError {
+		t.Fatalf("Unexpected error: %+v", result)
+	}
+	structured = result.StructuredContent.(ParallelTextResult)
+	if structured.Reference != "Éter 1:1" || structured.Verses[0].Texts[1].Reference != "Ether 1:1" {
+		t.Errorf("Expected Éter 1 aligned with Ether 1, got %+v", structured)
+	}
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "| Verse | ES | EN |") || !strings.Contains(text, "| 1 | Primer versículo de Éter. | And now I, Moroni") {
+		t.Errorf("Expected an aligned table, got %q", text)
+	}
+
+	for _, arguments := range []map[string]any{
+		{},
+		{"query": "1 Nephi 3", "layout": "columns"},
+		{"query": "1 Nephi 3", "languages": "en"},
+		{"query": "1 Nephi 3", "languages": "en,fr"},
+		{"query": "Alma"},
+		{"query": "Alma 5", "languages": "en,pt"},
+	} {
+		if result := call(arguments); !result.IsError {
+			t.Errorf("Expected an error for %v", arguments)
+		}
+	}
+}
+
+func TestService_ParallelText_OneLanguage(t *testing.T) {
+	service := &Service{scriptures: map[string][]Scripture{}}
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]any{"query": "John 3"}
+	result, err := service.ParallelText(context.Background(), request)
+	if err != nil || !result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "dataset language_editions not loaded") {
+		t.Errorf("Expected an error naming the missing edition, got %+v %v", result, err)
+	}
+	if unavailable, ok := result.StructuredContent.(CapabilityUnavailableResult); !ok || unavailable.Dataset != DatasetLanguageEditions {
+		t.Errorf("Expected the missing dataset in the structured result, got %+v", result.StructuredContent)
+	}
+}
@@ -0,0 +1,160 @@
+package scripture
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxReferences caps how many references one get_scripture call may request
+const maxReferences = 50
+
+// maxContextVerses caps the context_verses argument of get_scripture
+const maxContextVerses = 10
+
+// Passage is one requested reference of a get_scripture call asking for
+// several
+type Passage struct {
+	Reference string      `json:"reference"`
+	Verses    []Scripture `json:"verses"`
+	Before    []Scripture `json:"before,omitempty"` // Context verses preceding the passage
+	After     []Scripture `json:"after,omitempty"`  // Context verses following the passage
+	Error     string      `json:"error,omitempty"`  // Why the reference returned no verses
+}
+
+// scriptureReferences reads the references requested of get_scripture: a
+// query, which may list several separated by semicolons, and/or a references
+// array
+func scriptureReferences(arguments map[string]any) ([]string, error) {
+	var references []string
+	if query, ok := arguments["query"].(string); ok {
+		for _, reference := range strings.Split(query, ";") {
+			if reference = strings.TrimSpace(reference); reference != "" {
+				references = append(references, reference)
+			}
+		}
+	}
+	if list, ok := arguments["references"]; ok && list != nil {
+		items, ok := list.([]any)
+		if !ok {
+			return nil, fmt.Errorf("references must be an array of scripture references")
+		}
+		for _, item := range items {
+			reference, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("references must be an array of scripture references, got %v", item)
+			}
+			if reference = strings.TrimSpace(reference); reference != "" {
+				references = append(references, reference)
+			}
+		}
+	}
+
+	if len(references) == 0 {
+		return nil, fmt.Errorf("scripture reference cannot be empty")
+	}
+	if len(references) > maxReferences {
+		return nil, fmt.Errorf("too many references (%d); request at most %d per call", len(references), maxReferences)
+	}
+	return references, nil
+}
+
+// getPassages answers a get_scripture call for several references, grouping
+// the verses under each. A reference that is invalid or not found is reported
+// in its group without failing the others.
+func (s *Service) getPassages(references []string, format string, contextVerses int) *mcp.CallToolResult {
+	structured := PassageResult{Reference: strings.Join(references, "; "), Verses: []Scripture{}, Passages: []Passage{}}
+	var sections []string
+	for _, reference := range references {
+		passage := Passage{Reference: reference, Verses: []Scripture{}}
+		if ref, err := s.parseReference(reference); err != nil {
+			passage.Error = fmt.Sprintf("Invalid scripture reference: %v", err)
+		} else if passage.Verses = append(passage.Verses, s.getScripturesByReference(ref)...); len(passage.Verses) == 0 {
+			passage.Error = fmt.Sprintf("Scripture reference '%s' not found", reference)
+		} else {
+			passage.Before, passage.After = s.surroundingVerses(passage.Verses, contextVerses)
+		}
+		structured.Passages = append(structured.Passages, passage)
+		structured.Verses = append(structured.Verses, passage.Verses...)
+
+		if passage.Error != "" {
+			sections = append(sections, fmt.Sprintf("Scripture Reference: %s\n\n%s.", reference, passage.Error))
+			continue
+		}
+		sections = append(sections, passageText(reference, passage.Before, passage.Verses, passage.After, format))
+	}
+
+	response := fmt.Sprintf("Scripture References: %s\n\n", structured.Reference)
+	for _, section := range sections {
+		response += strings.TrimRight(section, "\n") + "\n\n"
+	}
+	result := mcp.NewToolResultStructured(structured, response)
+	if len(structured.Verses) > 0 && format != formatSpeech && format != formatAccessible {
+		meta := map[string]any{"readability": s.verseReadability(structured.Verses)}
+		if lines := poeticLineMetadata(structured.Verses); len(lines) > 0 {
+			meta["poeticLines"] = lines
+		}
+		result.Meta = &mcp.Meta{AdditionalFields: meta}
+	}
+	return result
+}
+
+// parseContextVerses reads the optional context_verses argument: how many
+// verses to return before and after each passage
+func parseContextVerses(arguments map[string]any) (int, error) {
+	value, exists := arguments["context_verses"]
+	if !exists || value == nil {
+		return 0, nil
+	}
+	count, ok := value.(float64)
+	if !ok || count != float64(int(count)) || count < 0 || count > maxContextVerses {
+		return 0, fmt.Errorf("context_verses must be a whole number from 0 to %d", maxContextVerses)
+	}
+	return int(count), nil
+}
+
+// surroundingVerses returns up to n verses of the passage's chapter before
+// and after it. Context stops at the chapter boundaries.
+func (s *Service) surroundingVerses(verses []Scripture, n int) (before, after []Scripture) {
+	if n == 0 || len(verses) == 0 {
+		return nil, nil
+	}
+	first, last := verses[0], verses[len(verses)-1]
+	chapter := s.getChapter(first.Book, first.Chapter)
+	for i, verse := range chapter {
+		switch {
+		case verse.Verse < first.Verse && verse.Verse >= first.Verse-n:
+			before = append(before, chapter[i])
+		case verse.Verse > last.Verse && verse.Verse <= last.Verse+n:
+			after = append(after, chapter[i])
+		}
+	}
+	return before, after
+}
+
+// passageText renders a get_scripture passage in the requested format. In
+// prose and poetry, context verses are marked so they are not mistaken for
+// the passage itself.
+func passageText(reference string, before, verses, after []Scripture, format string) string {
+	all := append(append(append([]Scripture{}, before...), verses...), after...)
+	switch format {
+	case formatSpeech:
+		return speechPassage(spokenReference(all), all)
+	case formatAccessible:
+		return accessiblePassage("Scripture reference: "+reference, all, nil)
+	}
+
+	response := fmt.Sprintf("Scripture Reference: %s\n\n", reference)
+	if len(before)+len(after) > 0 {
+		response = fmt.Sprintf("Scripture Reference: %s (with surrounding verses marked as context)\n\n", reference)
+	}
+	for i, scripture := range all {
+		label := ""
+		if i < len(before) || i >= len(before)+len(verses) {
+			label = " (context)"
+		}
+		response += fmt.Sprintf("%s %d:%d%s - %s\n\n", scripture.Book, scripture.Chapter, scripture.Verse, label, formatVerseText(scripture, format, "    "))
+	}
+	return response
+}
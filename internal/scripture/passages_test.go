@@ -0,0 +1,162 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestScriptureReferences(t *testing.T) {
+	tests := []struct {
+		name        string
+		arguments   map[string]any
+		expected    []string
+		expectError string
+	}{
+		{name: "Single", arguments: map[string]any{"query": "John 3:16"}, expected: []string{"John 3:16"}},
+		{name: "Semicolons", arguments: map[string]any{"query": "John 3:16; Moroni 10:4-5;D&C 4:2;"}, expected: []string{"John 3:16", "Moroni 10:4-5", "D&C 4:2"}},
+		{name: "Array", arguments: map[string]any{"references": []any{"John 3:16", " Moroni 10:4-5 "}}, expected: []string{"John 3:16", "Moroni 10:4-5"}},
+		{name: "Both", arguments: map[string]any{"query": "John 3:16", "references": []any{"D&C 4:2"}}, expected: []string{"John 3:16", "D&C 4:2"}},
+		{name: "Empty", arguments: map[string]any{"query": " ; ", "references": []any{}}, expectError: "cannot be empty"},
+		{name: "Not an array", arguments: map[string]any{"references": "John 3:16"}, expectError: "must be an array"},
+		{name: "Not strings", arguments: map[string]any{"references": []any{316}}, expectError: "must be an array"},
+		{name: "Too many", arguments: map[string]any{"query": strings.Repeat("John 3:16;", maxReferences+1)}, expectError: "too many references"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			references, err := scriptureReferences(tt.arguments)
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if strings.Join(references, "|") != strings.Join(tt.expected, "|") {
+				t.Errorf("Expected %q, got %q", tt.expected, references)
+			}
+		})
+	}
+}
+
+func TestService_GetScripture_MultipleReferences(t *testing.T) {
+	service := NewService()
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]any{"query": "John 3:16; Moroni 10:4-5; Hezekiah 1:1; John 3", "references": []any{"D&C 4:2"}}
+	result, err := service.GetScripture(context.Background(), request)
+	if err != nil || result.IsError {
+		t.Fatalf("Unexpected error: %v %+v", err, result)
+	}
+
+	structured, ok := result.StructuredContent.(PassageResult)
+	if !ok {
+		t.Fatalf("Expected a PassageResult, got %T", result.StructuredContent)
+	}
+	expected := []struct {
+		reference string
+		verses    int
+		error     string
+	}{
+		{reference: "John 3:16", verses: 1},
+		{reference: "Moroni 10:4-5", verses: 2},
+		{reference: "Hezekiah 1:1", error: "not found"},
+		{reference: "John 3", error: "Invalid scripture reference"},
+		{reference: "D&C 4:2", verses: 1},
+	}
+	if len(structured.Passages) != len(expected) {
+		t.Fatalf("Expected %d passages, got %+v", len(expected), structured.Passages)
+	}
+	for i, passage := range structured.Passages {
+		if passage.Reference != expected[i].reference || len(passage.Verses) != expected[i].verses || !strings.Contains(passage.Error, expected[i].error) {
+			t.Errorf("Expected passage %+v, got %+v", expected[i], passage)
+		}
+	}
+	if len(structured.Verses) != 4 {
+		t.Errorf("Expected the 4 verses found, got %d", len(structured.Verses))
+	}
+
+	text := result.Content[0].(mcp.TextContent).Text
+	for _, want := range []string{"Scripture Reference: Moroni 10:4-5\n\nMoroni 10:4 - ", "Scripture reference 'Hezekiah 1:1' not found.", "Doctrine and Covenants 4:2 - "} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected the text to contain %q, got:\n%s", want, text)
+		}
+	}
+
+	// A single reference keeps the single-passage result
+	request.Params.Arguments = map[string]any{"references": []any{"John 3:16"}}
+	result, _ = service.GetScripture(context.Background(), request)
+	if structured := result.StructuredContent.(PassageResult); structured.Passages != nil || len(structured.Verses) != 1 {
+		t.Errorf("Expected a single passage without groups, got %+v", structured)
+	}
+}
+
+func TestService_GetScripture_ContextVerses(t *testing.T) {
+	service := NewService()
+
+	tests := []struct {
+		name        string
+		arguments   map[string]any
+		before      []int
+		after       []int
+		expectError string
+	}{
+		{name: "None by default", arguments: map[string]any{"query": "John 3:16"}},
+		{name: "Both sides", arguments: map[string]any{"query": "John 3:16", "context_verses": float64(2)}, before: []int{14, 15}, after: []int{17, 18}},
+		{name: "Around a range", arguments: map[string]any{"query": "John 3:16-17", "context_verses": float64(1)}, before: []int{15}, after: []int{18}},
+		{name: "Chapter start", arguments: map[string]any{"query": "Moroni 10:1", "context_verses": float64(2)}, after: []int{2, 3}},
+		{name: "Chapter end", arguments: map[string]any{"query": "John 3:36", "context_verses": float64(2)}, before: []int{34, 35}},
+		{name: "Negative", arguments: map[string]any{"query": "John 3:16", "context_verses": float64(-1)}, expectError: "context_verses"},
+		{name: "Fractional", arguments: map[string]any{"query": "John 3:16", "context_verses": 1.5}, expectError: "context_verses"},
+		{name: "Too many", arguments: map[string]any{"query": "John 3:16", "context_verses": float64(maxContextVerses + 1)}, expectError: "context_verses"},
+	}
+
+	verseNumbers := func(verses []Scripture) []int {
+		var numbers []int
+		for _, verse := range verses {
+			numbers = append(numbers, verse.Verse)
+		}
+		return numbers
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = tt.arguments
+			result, err := service.GetScripture(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if tt.expectError != "" {
+				if !result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, tt.expectError) {
+					t.Errorf("Expected error containing %q, got %+v", tt.expectError, result)
+				}
+				return
+			}
+
+			structured := result.StructuredContent.(PassageResult)
+			if fmt.Sprint(verseNumbers(structured.Before)) != fmt.Sprint(tt.before) || fmt.Sprint(verseNumbers(structured.After)) != fmt.Sprint(tt.after) {
+				t.Errorf("Expected context %v / %v, got %v / %v", tt.before, tt.after, verseNumbers(structured.Before), verseNumbers(structured.After))
+			}
+			text := result.Content[0].(mcp.TextContent).Text
+			if contexts := strings.Count(text, "(context) - "); contexts != len(tt.before)+len(tt.after) {
+				t.Errorf("Expected %d verses marked as context, got %d:\n%s", len(tt.before)+len(tt.after), contexts, text)
+			}
+		})
+	}
+
+	// Each of several passages gets its own context
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]any{"query": "John 3:16; Moroni 10:4", "context_verses": float64(1)}
+	result, _ := service.GetScripture(context.Background(), request)
+	for _, passage := range result.StructuredContent.(PassageResult).Passages {
+		if len(passage.Before) != 1 || len(passage.After) != 1 {
+			t.Errorf("Expected one context verse either side of %s, got %+v", passage.Reference, passage)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Search strategies a plan can choose
+const (
+	strategyIndex       = "index"           // Candidate verses from the inverted index, checked against their text
+	strategyScan        = "scan"            // Every verse checked against the query
+	strategyTermLookups = "term lookups"    // Each term of a multi-term query looked up on its own
+	strategyMultiScan   = "multi-term scan" // Every verse scanned once for all terms together
+	strategyRanked      = "bm25"            // Every verse with a query word, ranked by relevance
+)
+
+// multiTermScan is the number of distinct terms from which a multi-term
+// query is evaluated by scanning every verse once for all its terms, rather
+// than looking each term up in the index
+const multiTermScan = 4
+
+// SearchPlan is how a search finds its matches: the strategy chosen from the
+// shape of the query and what is loaded, and why
+type SearchPlan struct {
+	Query    string   `json:"query"`
+	Strategy string   `json:"strategy"`
+	Terms    []string `json:"terms,omitempty"` // Distinct terms of a multi-term query
+	Reason   string   `json:"reason"`
+
+	node queryNode // Parsed multi-term query, if any
+}
+
+// String renders the plan as one line of text output
+func (p SearchPlan) String() string {
+	if len(p.Terms) > 0 {
+		return fmt.Sprintf("Plan: %s for %s (%s)", p.Strategy, quoteTerms(p.Terms), p.Reason)
+	}
+	return fmt.Sprintf("Plan: %s for '%s' (%s)", p.Strategy, p.Query, p.Reason)
+}
+
+// quoteTerms lists terms as 'a', 'b', 'c'
+func quoteTerms(terms []string) string {
+	quoted := make([]string, len(terms))
+	for i, term := range terms {
+		quoted[i] = "'" + term + "'"
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// planSearch chooses how to find the verses matching a query under a search
+// mode. A phrase query with AND, OR or NOT, or a word-mode query of two or
+// more words, is a multi-term query; anything else is matched as one piece
+// of text.
+func (s *Service) planSearch(query, mode string) SearchPlan {
+	plan := SearchPlan{Query: query}
+	if mode == modePhrase {
+		if node, err := parseBooleanQuery(query); err == nil {
+			plan.node = node
+		}
+	} else if words := s.tokenizer().Words(query); len(words) >= 2 {
+		var node queryNode = termNode{words[0]}
+		for _, word := range words[1:] {
+			if mode == modeAllWords {
+				node = andNode{node, termNode{word}}
+			} else {
+				node = orNode{node, termNode{word}}
+			}
+		}
+		plan.node = node
+	}
+
+	if plan.node != nil {
+		plan.Terms = slices.Compact(slices.Sorted(slices.Values(plan.node.terms())))
+		switch {
+		case s.index == nil:
+			plan.Strategy, plan.Reason = strategyMultiScan, "no search index is built"
+		case len(plan.Terms) >= multiTermScan:
+			plan.Strategy = strategyMultiScan
+			plan.Reason = fmt.Sprintf("%d terms are cheaper to find in one pass than to look up one by one", len(plan.Terms))
+		default:
+			plan.Strategy = strategyTermLookups
+			plan.Reason = fmt.Sprintf("%d %s, each looked up in the index", len(plan.Terms), pluralize(len(plan.Terms), "term", "terms"))
+		}
+		return plan
+	}
+
+	switch {
+	case s.index == nil:
+		plan.Strategy, plan.Reason = strategyScan, "no search index is built"
+	case len(s.tokenizer().Words(query)) == 0:
+		plan.Strategy, plan.Reason = strategyScan, "the query has no words to look up"
+	default:
+		plan.Strategy, plan.Reason = strategyIndex, "candidate verses containing every query word, checked for the exact text"
+	}
+	return plan
+}
+
+// relevancePlan describes a search sorted by relevance, which always ranks
+// from the index
+func relevancePlan(query string) SearchPlan {
+	return SearchPlan{Query: query, Strategy: strategyRanked, Reason: "sort=relevance scores every verse containing a query word"}
+}
+
+// run returns every verse matching the planned query in canonical book order
+func (s *Service) run(ctx context.Context, plan SearchPlan) []Scripture {
+	switch plan.Strategy {
+	case strategyTermLookups:
+		return s.booleanMatches(ctx, plan.node)
+	case strategyMultiScan:
+		return s.scanBooleanMatches(ctx, plan.node, plan.Terms)
+	default:
+		return s.searchMatches(ctx, strings.ToLower(plan.Query))
+	}
+}
@@ -0,0 +1,127 @@
+package scripture
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestService_planSearch(t *testing.T) {
+	indexed := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	indexed.recordBook("Moroni", "Book of Mormon")
+	indexed.scriptures["Moroni"] = []Scripture{
+		{Book: "Moroni", Chapter: 7, Verse: 47, Text: "But charity is the pure love of Christ", Reference: "Moroni 7:47"},
+	}
+	unindexed := &Service{scriptures: indexed.scriptures, bookOrder: indexed.bookOrder, bookCollections: indexed.bookCollections}
+	indexed.buildIndex()
+
+	tests := []struct {
+		name     string
+		service  *Service
+		query    string
+		mode     string
+		strategy string
+		terms    []string
+	}{
+		{name: "Phrase", service: indexed, query: "pure love", mode: modePhrase, strategy: strategyIndex},
+		{name: "No words", service: indexed, query: "—", mode: modePhrase, strategy: strategyScan},
+		{name: "No index", service: unindexed, query: "pure love", mode: modePhrase, strategy: strategyScan},
+		{name: "Few boolean terms", service: indexed, query: "charity OR love", mode: modePhrase, strategy: strategyTermLookups, terms: []string{"charity", "love"}},
+		{name: "Repeated terms count once", service: indexed, query: "love AND (love OR christ)", mode: modePhrase, strategy: strategyTermLookups, terms: []string{"christ", "love"}},
+		{name: "Many boolean terms", service: indexed, query: "a OR b OR c OR d", mode: modePhrase, strategy: strategyMultiScan, terms: []string{"a", "b", "c", "d"}},
+		{name: "Boolean without index", service: unindexed, query: "charity OR love", mode: modePhrase, strategy: strategyMultiScan, terms: []string{"charity", "love"}},
+		{name: "Word mode", service: indexed, query: "pure love", mode: modeAllWords, strategy: strategyTermLookups, terms: []string{"love", "pure"}},
+		{name: "Word mode with one word", service: indexed, query: "love", mode: modeAnyWord, strategy: strategyIndex},
+		{name: "Malformed boolean query as text", service: indexed, query: "love AND", mode: modePhrase, strategy: strategyIndex},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := tt.service.planSearch(tt.query, tt.mode)
+			if plan.Strategy != tt.strategy {
+				t.Errorf("Expected strategy %q, got %q (%s)", tt.strategy, plan.Strategy, plan.Reason)
+			}
+			if !reflect.DeepEqual(plan.Terms, tt.terms) {
+				t.Errorf("Expected terms %v, got %v", tt.terms, plan.Terms)
+			}
+			if plan.Reason == "" {
+				t.Error("Expected a reason for the plan")
+			}
+		})
+	}
+}
+
+func TestService_SearchScriptures_Explain(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	service.recordBook("Moroni", "Book of Mormon")
+	service.scriptures["Moroni"] = []Scripture{
+		{Book: "Moroni", Chapter: 7, Verse: 47, Text: "But charity is the pure love of Christ", Reference: "Moroni 7:47"},
+	}
+	service.buildIndex()
+
+	tests := []struct {
+		name          string
+		arguments     map[string]interface{}
+		strategy      string
+		shouldContain string
+	}{
+		{
+			name:          "Index lookup",
+			arguments:     map[string]interface{}{"query": "pure love", "explain": true},
+			strategy:      strategyIndex,
+			shouldContain: "Plan: index for 'pure love' (",
+		},
+		{
+			name:          "Relevance",
+			arguments:     map[string]interface{}{"query": "pure love", "sort": "relevance", "explain": true},
+			strategy:      strategyRanked,
+			shouldContain: "Plan: bm25 for 'pure love' (",
+		},
+		{
+			name:          "Explained even without matches",
+			arguments:     map[string]interface{}{"query": "mercy OR grace", "explain": true},
+			strategy:      strategyTermLookups,
+			shouldContain: "Plan: term lookups for 'grace', 'mercy' (2 terms, each looked up in the index)",
+		},
+		{
+			name:      "Off by default",
+			arguments: map[string]interface{}{"query": "pure love"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+			result, err := service.SearchScriptures(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			text := result.Content[0].(mcp.TextContent).Text
+
+			if tt.strategy == "" {
+				if strings.Contains(text, "Plan:") || result.Meta.AdditionalFields["plan"] != nil {
+					t.Errorf("Expected no plan, got:\n%s", text)
+				}
+				return
+			}
+			if !strings.Contains(text, tt.shouldContain) {
+				t.Errorf("Expected output to contain %q, got:\n%s", tt.shouldContain, text)
+			}
+			plan, ok := result.Meta.AdditionalFields["plan"].(*SearchPlan)
+			if !ok || plan.Strategy != tt.strategy {
+				t.Errorf("Expected plan with strategy %q under _meta, got %v", tt.strategy, result.Meta.AdditionalFields["plan"])
+			}
+		})
+	}
+}
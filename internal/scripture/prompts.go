@@ -0,0 +1,257 @@
+package scripture
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Study workflows are offered as MCP prompts, so a client can start one
+// with a click. A prompt's message names the tool calls to make, with
+// their arguments filled in, and how to write up the result; passages the
+// prompt is about are embedded as resources.
+
+// promptAudiences are the audiences a prompt can be written for, with the
+// reading level of passages_for_reading_level that suits each
+var promptAudiences = map[string]string{
+	"children": "easy",
+	"youth":    "moderate",
+	"family":   "moderate",
+	"adults":   "advanced",
+}
+
+// defaultAudience is the audience of a prompt that names none
+const defaultAudience = "adults"
+
+// Prompts returns the study workflow prompts
+func (s *Service) Prompts() []server.ServerPrompt {
+	devotional := mcp.NewPrompt("devotional_on_topic",
+		mcp.WithPromptDescription("Prepare a short devotional on a topic: find passages at the audience's reading level, choose one and write a message with discussion questions"),
+		mcp.WithArgument("topic",
+			mcp.ArgumentDescription("Topic of the devotional (e.g., \"hope\", \"forgiveness\")"),
+			mcp.RequiredArgument(),
+		),
+		mcp.WithArgument("audience",
+			mcp.ArgumentDescription("Who it is for: children, youth, family or adults (default: adults)"),
+		),
+		mcp.WithArgument("scope",
+			mcp.ArgumentDescription("Only draw passages from this collection, book or chapter (e.g., \"Book of Mormon\")"),
+		),
+	)
+	compare := mcp.NewPrompt("compare_passages",
+		mcp.WithPromptDescription("Compare two passages: their setting, shared wording and ideas, differences and what each adds"),
+		mcp.WithArgument("first",
+			mcp.ArgumentDescription("First chapter or verse range (e.g., \"Matthew 5:3-12\")"),
+			mcp.RequiredArgument(),
+		),
+		mcp.WithArgument("second",
+			mcp.ArgumentDescription("Second chapter or verse range (e.g., \"3 Nephi 12:3-12\")"),
+			mcp.RequiredArgument(),
+		),
+	)
+	guide := mcp.NewPrompt("chapter_study_guide",
+		mcp.WithPromptDescription("Write a study guide for a chapter: overview, outline, key verses, cross-references and questions"),
+		mcp.WithArgument("book",
+			mcp.ArgumentDescription("Book name (e.g., \"Alma\", \"John\")"),
+			mcp.RequiredArgument(),
+		),
+		mcp.WithArgument("chapter",
+			mcp.ArgumentDescription("Chapter number"),
+			mcp.RequiredArgument(),
+		),
+		mcp.WithArgument("audience",
+			mcp.ArgumentDescription("Who it is for: children, youth, family or adults (default: adults)"),
+		),
+	)
+	return []server.ServerPrompt{
+		{Prompt: devotional, Handler: s.devotionalPrompt},
+		{Prompt: compare, Handler: s.comparePassagesPrompt},
+		{Prompt: guide, Handler: s.chapterStudyGuidePrompt},
+	}
+}
+
+// devotionalPrompt is the handler of devotional_on_topic
+func (s *Service) devotionalPrompt(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	arguments := request.Params.Arguments
+
+	topic := strings.TrimSpace(arguments["topic"])
+	if topic == "" {
+		return nil, fmt.Errorf("topic cannot be empty")
+	}
+	audience, err := promptAudience(arguments["audience"])
+	if err != nil {
+		return nil, err
+	}
+	scope := strings.TrimSpace(arguments["scope"])
+	if _, err := s.scopeFilter(scope); err != nil {
+		return nil, err
+	}
+
+	find := map[string]any{"topic": topic, "level": promptAudiences[audience], "limit": 8}
+	where := ""
+	if scope != "" {
+		find["scope"] = scope
+		where = " from " + scope
+	}
+	steps := []string{
+		"Find passages: " + toolCall("passages_for_reading_level", find),
+	}
+	if tag, ok := findTheme(topic); ok {
+		themeCall := map[string]any{"theme": tag.Theme}
+		steps = append(steps, "See the curated passages on the theme too: "+toolCall("search_by_theme", themeCall))
+	}
+	steps = append(steps,
+		"Choose the one passage that speaks most directly to the topic and read it in context: "+toolCall("get_scripture", map[string]any{"query": "<the passage>", "context_verses": 3}),
+		fmt.Sprintf("Write a devotional of about five minutes for %s: a short opening, the passage quoted exactly with its reference, what it teaches about %s, how to live it this week, and two or three discussion questions.", audience, topic),
+		"Check every quotation before presenting it: "+toolCall("lint_citations", map[string]any{"text": "<the devotional>"}),
+	)
+
+	text := fmt.Sprintf("Prepare a devotional on \"%s\" for %s, drawing on the scriptures%s.\n\n%s\n\nQuote only verses returned by these tools, and do not cite references you have not looked up.", topic, audience, where, numberedSteps(steps))
+	return mcp.NewGetPromptResult(
+		fmt.Sprintf("Devotional on %s for %s", topic, audience),
+		[]mcp.PromptMessage{mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(text))},
+	), nil
+}
+
+// comparePassagesPrompt is the handler of compare_passages
+func (s *Service) comparePassagesPrompt(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	arguments := request.Params.Arguments
+
+	var references []string
+	var messages []mcp.PromptMessage
+	for _, name := range []string{"first", "second"} {
+		reference, embedded, err := s.promptPassage(arguments[name])
+		if err != nil {
+			return nil, fmt.Errorf("%s passage: %w", name, err)
+		}
+		references = append(references, reference)
+		messages = append(messages, mcp.NewPromptMessage(mcp.RoleUser, embedded))
+	}
+
+	steps := []string{
+		fmt.Sprintf("Look for wording the passages share: %s and %s", toolCall("get_parallel_passages", map[string]any{"query": references[0]}), toolCall("find_similar_verses", map[string]any{"query": references[0], "limit": 10})),
+		"Read the footnotes of the verses that stand out: " + toolCall("get_cross_references", map[string]any{"query": "<a verse>"}),
+		"Compare the setting and speaker of each passage, the ideas and wording they share, how they differ, and what each adds to the other.",
+	}
+	text := fmt.Sprintf("Compare %s with %s. Both passages are attached.\n\n%s\n\nQuote only verses returned by these tools.", references[0], references[1], numberedSteps(steps))
+	messages = append(messages, mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(text)))
+	return mcp.NewGetPromptResult(fmt.Sprintf("Compare %s and %s", references[0], references[1]), messages), nil
+}
+
+// chapterStudyGuidePrompt is the handler of chapter_study_guide
+func (s *Service) chapterStudyGuidePrompt(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	arguments := request.Params.Arguments
+
+	audience, err := promptAudience(arguments["audience"])
+	if err != nil {
+		return nil, err
+	}
+	chapter := strings.TrimSpace(arguments["book"]) + " " + strings.TrimSpace(arguments["chapter"])
+	if _, err := strconv.Atoi(strings.TrimSpace(arguments["chapter"])); err != nil {
+		return nil, fmt.Errorf("invalid chapter number '%s'", arguments["chapter"])
+	}
+	reference, embedded, err := s.promptPassage(chapter)
+	if err != nil {
+		return nil, err
+	}
+
+	query := map[string]any{"query": reference}
+	steps := []string{
+		"Read the chapter's heading: " + toolCall("get_chapter_summary", query),
+		"Divide it into sections: " + toolCall("outline_chapter", query),
+		"Gather study questions: " + toolCall("get_chapter_questions", query),
+		"Pick three to five key verses and read their footnotes: " + toolCall("get_cross_references", map[string]any{"query": reference + ":<verse>"}),
+		fmt.Sprintf("Write a study guide for %s: an overview of the chapter's setting, an outline by section with verse ranges, the key verses quoted exactly with what they teach, related passages from the cross-references, and questions for discussion and personal reflection.", audience),
+	}
+	text := fmt.Sprintf("Write a study guide for %s. The chapter is attached.\n\n%s\n\nQuote only verses returned by these tools.", reference, numberedSteps(steps))
+	return mcp.NewGetPromptResult(
+		fmt.Sprintf("Study guide for %s", reference),
+		[]mcp.PromptMessage{
+			mcp.NewPromptMessage(mcp.RoleUser, embedded),
+			mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(text)),
+		},
+	), nil
+}
+
+// promptAudience validates a prompt's audience, defaulting to adults
+func promptAudience(value string) (string, error) {
+	audience := strings.ToLower(strings.TrimSpace(value))
+	if audience == "" {
+		return defaultAudience, nil
+	}
+	if _, ok := promptAudiences[audience]; !ok {
+		return "", fmt.Errorf("invalid audience '%s'; use children, youth, family or adults", value)
+	}
+	return audience, nil
+}
+
+// promptPassage looks up a chapter or verse range for a prompt, returning
+// its canonical reference and its text as an embedded scripture resource
+func (s *Service) promptPassage(reference string) (string, mcp.EmbeddedResource, error) {
+	reference = strings.TrimSpace(reference)
+	if reference == "" {
+		return "", mcp.EmbeddedResource{}, fmt.Errorf("scripture reference cannot be empty")
+	}
+
+	var verses []Scripture
+	if ref, err := s.parseReference(reference); err == nil {
+		verses = s.getScripturesByReference(ref)
+	} else if ref, err := s.parseChapterReference(reference); err == nil {
+		verses = s.getChapter(ref.Book, ref.Chapter)
+	} else {
+		return "", mcp.EmbeddedResource{}, fmt.Errorf("invalid chapter or verse reference '%s'", reference)
+	}
+	if len(verses) == 0 {
+		return "", mcp.EmbeddedResource{}, fmt.Errorf("scripture reference '%s' not found", reference)
+	}
+
+	first, last := verses[0], verses[len(verses)-1]
+	if first.Book != last.Book || first.Chapter != last.Chapter {
+		return "", mcp.EmbeddedResource{}, fmt.Errorf("passage '%s' must lie within one chapter", reference)
+	}
+	uri, ok := s.resourceURI(first.Book, first.Chapter)
+	if !ok {
+		return "", mcp.EmbeddedResource{}, fmt.Errorf("no resource for '%s'", reference)
+	}
+	canonical := chapterKey{book: first.Book, chapter: first.Chapter}.String()
+	if len(verses) < len(s.getChapter(first.Book, first.Chapter)) {
+		canonical = spanReference(verses, verseSpan{Start: 0, End: len(verses) - 1})
+		uri += fmt.Sprintf("/%d", first.Verse)
+		if last.Verse != first.Verse {
+			uri += fmt.Sprintf("-%d", last.Verse)
+		}
+	}
+
+	contents, err := s.readResource(uri)
+	if err != nil {
+		return "", mcp.EmbeddedResource{}, err
+	}
+	return canonical, mcp.NewEmbeddedResource(contents[0]), nil
+}
+
+// toolCall formats a tool call for a prompt, as the tool's name and its
+// arguments in JSON
+func toolCall(tool string, arguments map[string]any) string {
+	encoded, _ := json.Marshal(arguments)
+	return fmt.Sprintf("call `%s` with `%s`", tool, encoded)
+}
+
+// numberedSteps numbers a prompt's steps one per line
+func numberedSteps(steps []string) string {
+	lines := make([]string, len(steps))
+	for i, step := range steps {
+		lines[i] = fmt.Sprintf("%d. %s", i+1, step)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,119 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestService_Prompts(t *testing.T) {
+	service := NewService()
+	prompts := make(map[string]func(context.Context, mcp.GetPromptRequest) (*mcp.GetPromptResult, error))
+	for _, prompt := range service.Prompts() {
+		prompts[prompt.Prompt.Name] = prompt.Handler
+	}
+	get := func(name string, arguments map[string]string) (*mcp.GetPromptResult, error) {
+		t.Helper()
+		handler, ok := prompts[name]
+		if !ok {
+			t.Fatalf("Prompt %s not registered", name)
+		}
+		request := mcp.GetPromptRequest{}
+		request.Params.Name = name
+		request.Params.Arguments = arguments
+		return handler(context.Background(), request)
+	}
+	text := func(result *mcp.GetPromptResult) string {
+		t.Helper()
+		content, ok := result.Messages[len(result.Messages)-1].Content.(mcp.TextContent)
+		if !ok {
+			t.Fatalf("Expected the last message to be text, got %+v", result.Messages)
+		}
+		return content.Text
+	}
+	embedded := func(result *mcp.GetPromptResult, i int) mcp.TextResourceContents {
+		t.Helper()
+		content, ok := result.Messages[i].Content.(mcp.EmbeddedResource)
+		if !ok {
+			t.Fatalf("Expected message %d to embed a resource, got %+v", i, result.Messages[i])
+		}
+		return content.Resource.(mcp.TextResourceContents)
+	}
+
+	t.Run("Devotional", func(t *testing.T) {
+		result, err := get("devotional_on_topic", map[string]string{"topic": "hope", "audience": "Children", "scope": "Book of Mormon"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		prompt := text(result)
+		for _, expected := range []string{
+			"`passages_for_reading_level` with `{\"level\":\"easy\",\"limit\":8,\"scope\":\"Book of Mormon\",\"topic\":\"hope\"}`",
+			"`search_by_theme` with `{\"theme\":\"hope\"}`",
+			"`lint_citations`",
+			"for children",
+		} {
+			if !strings.Contains(prompt, expected) {
+				t.Errorf("Expected the prompt to contain %q, got:\n%s", expected, prompt)
+			}
+		}
+	})
+
+	t.Run("Compare passages", func(t *testing.T) {
+		result, err := get("compare_passages", map[string]string{"first": "Matthew 5:3-12", "second": "3 Nephi 12"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(result.Messages) != 3 {
+			t.Fatalf("Expected two passages and the instructions, got %d messages", len(result.Messages))
+		}
+		first, second := embedded(result, 0), embedded(result, 1)
+		if first.URI != "scripture://new-testament/matthew/5/3-12" || !strings.HasPrefix(first.Text, "Matthew 5:3 - Blessed are the poor in spirit") {
+			t.Errorf("Unexpected first passage %s: %.60q", first.URI, first.Text)
+		}
+		if second.URI != "scripture://book-of-mormon/3-nephi/12" || !strings.HasPrefix(second.Text, "3 Nephi Chapter 12") {
+			t.Errorf("Unexpected second passage %s: %.60q", second.URI, second.Text)
+		}
+		if prompt := text(result); !strings.Contains(prompt, "Compare Matthew 5:3-12 with 3 Nephi 12.") {
+			t.Errorf("Expected canonical references in the prompt, got:\n%s", prompt)
+		}
+	})
+
+	t.Run("Chapter study guide", func(t *testing.T) {
+		result, err := get("chapter_study_guide", map[string]string{"book": "1 Ne", "chapter": "3"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if chapter := embedded(result, 0); chapter.URI != "scripture://book-of-mormon/1-nephi/3" {
+			t.Errorf("Expected 1 Nephi 3 to be embedded, got %s", chapter.URI)
+		}
+		prompt := text(result)
+		for _, tool := range []string{"get_chapter_summary", "outline_chapter", "get_chapter_questions", "get_cross_references"} {
+			if !strings.Contains(prompt, "`"+tool+"` with `{\"query\":\"1 Nephi 3") {
+				t.Errorf("Expected a %s call on 1 Nephi 3, got:\n%s", tool, prompt)
+			}
+		}
+	})
+
+	errors := []struct {
+		name      string
+		prompt    string
+		arguments map[string]string
+	}{
+		{"Empty topic", "devotional_on_topic", map[string]string{"topic": " "}},
+		{"Unknown audience", "devotional_on_topic", map[string]string{"topic": "hope", "audience": "pets"}},
+		{"Unknown scope", "devotional_on_topic", map[string]string{"topic": "hope", "scope": "Hezekiah"}},
+		{"Missing passage", "compare_passages", map[string]string{"first": "John 3:16"}},
+		{"Passage across chapters", "compare_passages", map[string]string{"first": "John 3:16", "second": "Alma 32:43-33:1"}},
+		{"Unknown chapter", "chapter_study_guide", map[string]string{"book": "1 Nephi", "chapter": "99"}},
+		{"Invalid chapter", "chapter_study_guide", map[string]string{"book": "1 Nephi", "chapter": "three"}},
+	}
+	for _, tt := range errors {
+		t.Run(tt.name, func(t *testing.T) {
+			if result, err := get(tt.prompt, tt.arguments); err == nil {
+				t.Errorf("Expected an error, got %+v", result)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package scripture
+
+import "path/filepath"
+
+// Provenance identifies exactly which edition a verse's text comes from, so
+// systems caching or republishing it can attribute it. The verses of one
+// data file share one.
+type Provenance struct {
+	Dataset  string `json:"dataset"`           // Data file the verse was loaded from, e.g. "book-of-mormon.json"
+	Source   string `json:"source,omitempty"`  // Published source of the data file, when its licensing is known
+	Edition  string `json:"edition,omitempty"` // Bible translation of Old and New Testament verses, e.g. "kjv"
+	Language string `json:"language"`          // Language code of the edition, e.g. "en"
+	Version  string `json:"version,omitempty"` // First 12 hex digits of the data file's SHA-256, when it was checksummed
+}
+
+// versionDigits is how much of a data file's SHA-256 names its version
+const versionDigits = 12
+
+// newProvenance starts the provenance of a data file's verses; the rest is
+// filled in by finishLoading
+func (s *Service) newProvenance(label string) *Provenance {
+	provenance := &Provenance{Dataset: filepath.Base(label)}
+	s.provenance = append(s.provenance, provenance)
+	return provenance
+}
+
+// finishLoading fills in the provenance of the loaded verses with the
+// service's language, translation and licensing, then builds the search
+// index. Language editions and translations call it once their language or
+// translation is set.
+func (s *Service) finishLoading() {
+	for _, provenance := range s.provenance {
+		collection := collectionForFile(provenance.Dataset)
+		provenance.Language = s.languageCode()
+		if collection == "Old Testament" || collection == "New Testament" {
+			provenance.Edition = s.translationCode()
+		}
+		if license := s.licenses[collection]; license != nil {
+			provenance.Source = license.Source
+		}
+	}
+	s.buildIndex()
+}
@@ -0,0 +1,60 @@
+package scripture
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestService_Provenance(t *testing.T) {
+	service := NewService()
+
+	tests := []struct {
+		reference string
+		dataset   string
+		edition   string
+	}{
+		{reference: "Genesis 1:1", dataset: "old-testament.json", edition: "kjv"},
+		{reference: "John 3:16", dataset: "new-testament.json", edition: "kjv"},
+		{reference: "Alma 32:21", dataset: "book-of-mormon.json"},
+		{reference: "D&C 4:2", dataset: "doctrine-and-covenants.json"},
+	}
+	for _, tt := range tests {
+		ref, err := service.parseReference(tt.reference)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		verses := service.getScripturesByReference(ref)
+		if len(verses) != 1 {
+			t.Fatalf("Expected %s, got %v", tt.reference, verses)
+		}
+		provenance := verses[0].Provenance
+		if provenance == nil {
+			t.Fatalf("Expected provenance on %s", tt.reference)
+		}
+		if provenance.Dataset != tt.dataset || provenance.Edition != tt.edition || provenance.Language != "en" {
+			t.Errorf("Unexpected provenance of %s: %+v", tt.reference, provenance)
+		}
+		if len(provenance.Version) != versionDigits || provenance.Source == "" {
+			t.Errorf("Expected the data version and source of %s, got %+v", tt.reference, provenance)
+		}
+	}
+
+	// Every returned verse carries it in the structured content
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]any{"query": "faith", "limit": float64(3)}
+	result, err := service.SearchScriptures(context.Background(), request)
+	if err != nil || result.IsError {
+		t.Fatalf("Unexpected error: %+v %v", result, err)
+	}
+	encoded, err := json.Marshal(result.StructuredContent)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if strings.Count(string(encoded), `"provenance":{"dataset":`) != 3 {
+		t.Errorf("Expected provenance on each of 3 verses, got %s", encoded)
+	}
+}
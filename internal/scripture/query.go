@@ -0,0 +1,111 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// jsonKeyPattern matches a JSON key pasted in front of a quoted value, as in `"query": "faith"`
+var jsonKeyPattern = regexp.MustCompile(`^"?[A-Za-z_]+"?\s*:\s*"`)
+
+// RepairQuery wraps a tool handler so its query argument is repaired with
+// repairQuery before the handler sees it. When anything was repaired, the
+// response starts with a note saying what changed and lists the repairs under
+// _meta.queryRepairs.
+func RepairQuery(handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		arguments := request.GetArguments()
+		query, ok := arguments["query"].(string)
+		if !ok {
+			return handler(ctx, request)
+		}
+		repaired, repairs := repairQuery(query)
+		if len(repairs) == 0 {
+			return handler(ctx, request)
+		}
+
+		arguments = maps.Clone(arguments)
+		arguments["query"] = repaired
+		request.Params.Arguments = arguments
+
+		result, err := handler(ctx, request)
+		if err != nil || result == nil {
+			return result, err
+		}
+
+		note := fmt.Sprintf("Note: repaired query to '%s' (%s).\n\n", repaired, strings.Join(repairs, ", "))
+		if len(result.Content) > 0 {
+			if text, ok := result.Content[0].(mcp.TextContent); ok {
+				text.Text = note + text.Text
+				result.Content[0] = text
+			}
+		}
+		if result.Meta == nil {
+			result.Meta = &mcp.Meta{}
+		}
+		if result.Meta.AdditionalFields == nil {
+			result.Meta.AdditionalFields = make(map[string]any)
+		}
+		result.Meta.AdditionalFields["queryRepairs"] = repairs
+		return result, nil
+	}
+}
+
+// repairQuery fixes mistakes clients commonly make when building a query
+// string: escaped or stray backslashes, a JSON fragment pasted with its key
+// and trailing comma, doubled quotes and quotes around a query that is one
+// quoted phrase (searches match text directly, so those quotes are never
+// needed). Quotes around a phrase of a boolean query, as in
+// `"faith" AND "hope"`, are kept. It returns the repaired query and a
+// description of each repair made.
+func repairQuery(query string) (string, []string) {
+	var repairs []string
+	repaired := strings.TrimSpace(query)
+
+	if strings.Contains(repaired, `\`) {
+		repaired = strings.NewReplacer(`\"`, `"`, `\'`, `'`, `\`, "").Replace(repaired)
+		repairs = append(repairs, "removed stray backslashes")
+	}
+
+	if key := jsonKeyPattern.FindString(repaired); key != "" {
+		repaired = `"` + repaired[len(key):]
+		repairs = append(repairs, "removed pasted JSON key")
+	}
+
+	if repaired = strings.TrimSpace(repaired); strings.HasSuffix(repaired, ",") {
+		repaired = strings.TrimRight(repaired, ", ")
+		repairs = append(repairs, "removed trailing comma")
+	}
+
+	if strings.Contains(repaired, `""`) {
+		for strings.Contains(repaired, `""`) {
+			repaired = strings.ReplaceAll(repaired, `""`, `"`)
+		}
+		repairs = append(repairs, "collapsed doubled quotes")
+	}
+
+	unquoted := false
+	for len(repaired) >= 2 && isQuotePair(repaired[0], repaired[len(repaired)-1]) && !strings.ContainsRune(repaired[1:len(repaired)-1], rune(repaired[0])) {
+		repaired = strings.TrimSpace(repaired[1 : len(repaired)-1])
+		unquoted = true
+	}
+	if unquoted {
+		repairs = append(repairs, "removed surrounding quotes")
+	}
+
+	if strings.Trim(repaired, `"' `) == "" {
+		return query, nil // Nothing usable left; let the handler report the original
+	}
+	return repaired, repairs
+}
+
+// isQuotePair reports whether two bytes are matching straight quotes
+func isQuotePair(open, close byte) bool {
+	return (open == '"' || open == '\'') && open == close
+}
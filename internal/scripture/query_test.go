@@ -0,0 +1,134 @@
+package scripture
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestRepairQuery(t *testing.T) {
+	tests := []struct {
+		name            string
+		query           string
+		expected        string
+		expectedRepairs []string
+	}{
+		{name: "Clean query", query: "faith", expected: "faith"},
+		{name: "Reference is untouched", query: "D&C 1:1", expected: "D&C 1:1"},
+		{name: "Inner quotes are kept", query: `the "Lord" of hosts`, expected: `the "Lord" of hosts`},
+		{name: "Surrounding quotes", query: `"still small voice"`, expected: "still small voice", expectedRepairs: []string{"removed surrounding quotes"}},
+		{name: "Doubled quotes", query: `""charity""`, expected: "charity", expectedRepairs: []string{"collapsed doubled quotes", "removed surrounding quotes"}},
+		{name: "Escaped quotes", query: `\"John 3:16\"`, expected: "John 3:16", expectedRepairs: []string{"removed stray backslashes", "removed surrounding quotes"}},
+		{name: "Stray backslash", query: `Alma 32:21\`, expected: "Alma 32:21", expectedRepairs: []string{"removed stray backslashes"}},
+		{name: "Trailing comma", query: "Moroni 10:4, ", expected: "Moroni 10:4", expectedRepairs: []string{"removed trailing comma"}},
+		{
+			name:            "Pasted JSON fragment",
+			query:           `"query": "John 3:16",`,
+			expected:        "John 3:16",
+			expectedRepairs: []string{"removed pasted JSON key", "removed trailing comma", "removed surrounding quotes"},
+		},
+		{name: "Quoted phrases of a boolean query", query: `"still small voice" OR "faith"`, expected: `"still small voice" OR "faith"`},
+		{name: "Quoted words of a boolean query", query: `"faith" AND "hope"`, expected: `"faith" AND "hope"`},
+		{name: "Nested quotes", query: `"'charity'"`, expected: "charity", expectedRepairs: []string{"removed surrounding quotes"}},
+		{name: "Nothing left", query: `""`, expected: `""`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repaired, repairs := repairQuery(tt.query)
+			if repaired != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, repaired)
+			}
+			if !slices.Equal(repairs, tt.expectedRepairs) {
+				t.Errorf("Expected repairs %v, got %v", tt.expectedRepairs, repairs)
+			}
+		})
+	}
+}
+
+func TestRepairQuery_Handler(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	service.recordBook("John", "New Testament")
+	service.scriptures["John"] = []Scripture{
+		{Book: "John", Chapter: 3, Verse: 16, Text: "For God so loved the world", Reference: "John 3:16"},
+	}
+	handler := RepairQuery(service.GetScripture)
+
+	arguments := map[string]interface{}{"query": `"John 3:16",`}
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: arguments,
+		},
+	}
+	result, err := handler(context.Background(), request)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected repaired query to succeed, got: %v", result.Content)
+	}
+
+	text := result.Content[0].(mcp.TextContent).Text
+	if !strings.HasPrefix(text, "Note: repaired query to 'John 3:16' (removed trailing comma, removed surrounding quotes).") {
+		t.Errorf("Expected repair note, got:\n%s", text)
+	}
+	if !strings.Contains(text, "For God so loved the world") {
+		t.Errorf("Expected verse text, got:\n%s", text)
+	}
+	if _, ok := result.Meta.AdditionalFields["queryRepairs"]; !ok {
+		t.Errorf("Expected queryRepairs in _meta, got %v", result.Meta.AdditionalFields)
+	}
+	if arguments["query"] != `"John 3:16",` {
+		t.Errorf("Expected caller's arguments to be left alone, got %v", arguments["query"])
+	}
+
+	// A clean query gets no note
+	request.Params.Arguments = map[string]interface{}{"query": "John 3:16"}
+	result, _ = handler(context.Background(), request)
+	if text := result.Content[0].(mcp.TextContent).Text; strings.Contains(text, "Note:") {
+		t.Errorf("Expected no repair note, got:\n%s", text)
+	}
+}
+
+func TestRepairQuery_BooleanSearch(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	service.recordBook("1 Kings", "Old Testament")
+	service.recordBook("Moroni", "Book of Mormon")
+	service.scriptures["1 Kings"] = []Scripture{
+		{Book: "1 Kings", Chapter: 19, Verse: 12, Text: "And after the earthquake a fire; and after the fire a still small voice.", Reference: "1 Kings 19:12"},
+	}
+	service.scriptures["Moroni"] = []Scripture{
+		{Book: "Moroni", Chapter: 7, Verse: 42, Text: "Wherefore, if a man have faith he must needs have hope", Reference: "Moroni 7:42"},
+	}
+	handler := RepairQuery(service.SearchScriptures)
+
+	tests := []struct {
+		query    string
+		expected []string
+	}{
+		{`"still small voice" OR "faith"`, []string{"1 Kings 19:12", "Moroni 7:42"}},
+		{`"faith" AND "hope"`, []string{"Moroni 7:42"}},
+		{`"still small voice"`, []string{"1 Kings 19:12"}},
+	}
+	for _, tt := range tests {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]any{"query": tt.query}
+		result, err := handler(context.Background(), request)
+		if err != nil || result.IsError {
+			t.Fatalf("%s: unexpected error: %v %+v", tt.query, err, result)
+		}
+		text := result.Content[0].(mcp.TextContent).Text
+		for _, reference := range tt.expected {
+			if !strings.Contains(text, reference) {
+				t.Errorf("%s: expected %s, got:\n%s", tt.query, reference, text)
+			}
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package scripture
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// questionsFile is the optional question bank of study and discussion
+// questions per chapter, read from a data directory. Curated questions come
+// from lesson manuals that are not redistributable, so none are embedded.
+const questionsFile = "chapter-questions.json"
+
+// maxFillInQuestions caps the number of generated fill-in questions per call
+const maxFillInQuestions = 20
+
+// minBlankLength is the shortest word a fill-in question blanks out
+const minBlankLength = 4
+
+// Question kinds
+const (
+	questionDiscussion = "discussion" // From the question bank
+	questionFillIn     = "fill_in"    // Generated from the text
+)
+
+// ChapterQuestion is a study or discussion question about a chapter
+type ChapterQuestion struct {
+	Kind      string `json:"kind"` // "discussion" from the question bank or "fill_in" generated from the text
+	Question  string `json:"question"`
+	Answer    string `json:"answer,omitempty"`
+	Reference string `json:"reference,omitempty"` // The verses the question is about, when known
+}
+
+// questionSet is a question bank entry: the questions for one chapter
+type questionSet struct {
+	Chapter   string            `json:"chapter"` // Chapter reference, e.g. "Alma 32"
+	Questions []ChapterQuestion `json:"questions"`
+}
+
+// questionsData is the structure of the question bank file
+type questionsData struct {
+	Chapters []questionSet `json:"chapters"`
+}
+
+// loadQuestions reads the optional question bank from SCRIPTURES_DATA_DIR or
+// the state data directory, if present
+func (s *Service) loadQuestions() {
+	data, _, err := readDataFile(questionsFile)
+	if err != nil {
+		return
+	}
+	questions, err := s.parseQuestions(data)
+	if err != nil {
+		slog.Warn("Could not parse question bank", "file", questionsFile, "error", err)
+		return
+	}
+	s.questions = questions
+}
+
+// parseQuestions decodes a question bank, keeping only the chapters whose
+// reference parses and the questions that have text
+func (s *Service) parseQuestions(data []byte) (map[chapterKey][]ChapterQuestion, error) {
+	var parsed questionsData
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return nil, err
+	}
+
+	questions := make(map[chapterKey][]ChapterQuestion)
+	for _, set := range parsed.Chapters {
+		ref, err := s.parseChapterReference(set.Chapter)
+		if err != nil {
+			slog.Warn("Skipping question set: invalid chapter reference", "chapter", set.Chapter, "file", questionsFile)
+			continue
+		}
+		key := chapterKey{book: ref.Book, chapter: ref.Chapter}
+		for _, question := range set.Questions {
+			if strings.TrimSpace(question.Question) == "" {
+				slog.Warn("Skipping question without text", "chapter", set.Chapter, "file", questionsFile)
+				continue
+			}
+			question.Kind = questionDiscussion
+			questions[key] = append(questions[key], question)
+		}
+	}
+	return questions, nil
+}
+
+// GetChapterQuestions returns the study questions for a chapter: its
+// discussion questions from the optional question bank, followed by
+// fill-in-the-blank questions generated from the text
+func (s *Service) GetChapterQuestions(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	query, ok := arguments["query"].(string)
+	if !ok || query == "" {
+		return mcp.NewToolResultError("chapter reference cannot be empty"), nil
+	}
+
+	// A verse reference is narrowed to its chapter
+	ref, err := s.parseReference(query)
+	if err != nil {
+		ref, err = s.parseChapterReference(query)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid chapter reference: %v", err)), nil
+		}
+	}
+	verses := s.getChapter(ref.Book, ref.Chapter)
+	if len(verses) == 0 {
+		return mcp.NewToolResultError(fmt.Sprintf("chapter not found: %s %d", ref.Book, ref.Chapter)), nil
+	}
+
+	fillIns := 5
+	if fillInVal, ok := arguments["fill_in"].(float64); ok {
+		fillIns = min(max(int(fillInVal), 0), maxFillInQuestions)
+	}
+
+	chapter := chapterKey{book: ref.Book, chapter: ref.Chapter}
+	questions := append([]ChapterQuestion{}, s.questions[chapter]...)
+	curated := len(questions)
+	questions = append(questions, s.fillInQuestions(verses, fillIns)...)
+	structured := ChapterQuestionsResult{Chapter: chapter.String(), Questions: questions}
+	if len(questions) == 0 {
+		return mcp.NewToolResultStructured(structured, fmt.Sprintf("No questions for %s.", chapter)), nil
+	}
+
+	response := fmt.Sprintf("Study Questions for %s:\n", chapter)
+	for i, question := range questions {
+		switch {
+		case i == 0 && curated > 0:
+			response += "\nDiscussion:\n"
+		case i == curated:
+			response += "\nFill in the blank:\n"
+		}
+		response += fmt.Sprintf("%d. %s", i+1, question.Question)
+		if question.Reference != "" {
+			response += fmt.Sprintf(" (%s)", question.Reference)
+		}
+		response += "\n"
+		if question.Answer != "" {
+			response += fmt.Sprintf("   Answer: %s\n", question.Answer)
+		}
+	}
+	if len(s.questions) == 0 {
+		response += fmt.Sprintf("\nNo question bank loaded; place %s in SCRIPTURES_DATA_DIR or the state data directory to include discussion questions.\n", questionsFile)
+	}
+	return mcp.NewToolResultStructured(structured, response), nil
+}
+
+// fillInQuestions generates up to limit fill-in-the-blank questions from a
+// chapter's verses. Each blanks out the verse's most distinctive word: the
+// one in the fewest verses of the corpus, leaving out short and common words
+// and words found nowhere else. The verses with the most distinctive words
+// are chosen, in verse order.
+func (s *Service) fillInQuestions(verses []Scripture, limit int) []ChapterQuestion {
+	index := s.index
+	if index == nil {
+		index = s.newSearchIndex()
+	}
+	common := make(map[string]bool, len(functionWords))
+	for _, word := range functionWords {
+		common[word] = true
+	}
+
+	type blank struct {
+		verse int
+		word  string
+		df    int // Number of verses with the word
+	}
+	var blanks []blank
+	for i, verse := range verses {
+		best := blank{verse: i}
+		for _, field := range strings.Fields(verse.Text) {
+			word := diffKey(field)
+			df := len(index.words[word])
+			if len([]rune(word)) < minBlankLength || common[word] || df < 2 || strings.IndexFunc(word, unicode.IsDigit) >= 0 {
+				continue
+			}
+			if best.word == "" || df < best.df {
+				best.word, best.df = word, df
+			}
+		}
+		if best.word != "" {
+			blanks = append(blanks, best)
+		}
+	}
+	sort.SliceStable(blanks, func(i, j int) bool {
+		return blanks[i].df < blanks[j].df
+	})
+	blanks = blanks[:min(limit, len(blanks))]
+	slices.SortFunc(blanks, func(a, b blank) int {
+		return a.verse - b.verse
+	})
+
+	questions := make([]ChapterQuestion, 0, len(blanks))
+	for _, b := range blanks {
+		verse := verses[b.verse]
+		fields := strings.Fields(verse.Text)
+		answer := ""
+		for k, field := range fields {
+			if diffKey(field) != b.word {
+				continue
+			}
+			start := strings.IndexFunc(field, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) })
+			end := strings.LastIndexFunc(field, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) })
+			_, size := utf8.DecodeRuneInString(field[end:])
+			end += size
+			if answer == "" {
+				answer = field[start:end]
+			}
+			fields[k] = field[:start] + "_____" + field[end:]
+		}
+		questions = append(questions, ChapterQuestion{
+			Kind:      questionFillIn,
+			Question:  strings.Join(fields, " "),
+			Answer:    answer,
+			Reference: verse.Reference,
+		})
+	}
+	return questions
+}
@@ -0,0 +1,107 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const testQuestions = `{"chapters": [
+	{"chapter": "Alma 32", "questions": [
+		{"question": "What does Alma compare the word to?", "answer": "A seed", "reference": "Alma 32:28"},
+		{"question": "  "}
+	]},
+	{"chapter": "Alma", "questions": [{"question": "Skipped"}]}
+]}`
+
+func TestFillInQuestions(t *testing.T) {
+	service := &Service{scriptures: map[string][]Scripture{
+		"Alma": {
+			{Book: "Alma", Chapter: 32, Verse: 1, Reference: "Alma 32:1", Text: "And the seed did swell, and the seed did grow."},
+			{Book: "Alma", Chapter: 32, Verse: 2, Reference: "Alma 32:2", Text: "Behold, the tree groweth; the tree is good."},
+			{Book: "Alma", Chapter: 32, Verse: 3, Reference: "Alma 32:3", Text: "And the seed was a good seed, and the tree did grow."},
+			{Book: "Alma", Chapter: 32, Verse: 4, Reference: "Alma 32:4", Text: "And it was so."},
+		},
+	}}
+
+	questions := service.fillInQuestions(service.scriptures["Alma"], 10)
+	if len(questions) != 3 {
+		t.Fatalf("Expected a question for each verse with a distinctive word, got %+v", questions)
+	}
+	// "tree" is in two verses and "seed" in two; short, common and unique words are never blanked
+	expected := []string{
+		"And the _____ did swell, and the _____ did grow.",
+		"Behold, the _____ groweth; the _____ is good.",
+		"And the _____ was a good _____, and the tree did grow.",
+	}
+	for i, question := range questions {
+		if question.Kind != questionFillIn || question.Question != expected[i] || question.Reference != service.scriptures["Alma"][i].Reference {
+			t.Errorf("Expected %q, got %+v", expected[i], question)
+		}
+	}
+	if questions[0].Answer != "seed" || questions[1].Answer != "tree" {
+		t.Errorf("Unexpected answers: %+v", questions)
+	}
+
+	if limited := service.fillInQuestions(service.scriptures["Alma"], 1); len(limited) != 1 {
+		t.Errorf("Expected the limit to apply, got %d questions", len(limited))
+	}
+}
+
+func TestService_GetChapterQuestions(t *testing.T) {
+	service := NewService()
+	questions, err := service.parseQuestions([]byte(testQuestions))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(questions) != 1 || len(questions[chapterKey{book: "Alma", chapter: 32}]) != 1 {
+		t.Fatalf("Expected one valid question, got %+v", questions)
+	}
+	service.questions = questions
+
+	tests := []struct {
+		name      string
+		arguments map[string]any
+		questions int
+		curated   bool
+		isError   bool
+	}{
+		{name: "Chapter with a question bank entry", arguments: map[string]any{"query": "Alma 32"}, questions: 6, curated: true},
+		{name: "Verse narrows to chapter", arguments: map[string]any{"query": "Alma 32:21", "fill_in": float64(0)}, questions: 1, curated: true},
+		{name: "Generated only", arguments: map[string]any{"query": "Moroni 10", "fill_in": float64(3)}, questions: 3},
+		{name: "Empty", arguments: map[string]any{}, isError: true},
+		{name: "Missing chapter", arguments: map[string]any{"query": "Alma 99"}, isError: true},
+		{name: "Invalid reference", arguments: map[string]any{"query": "nowhere"}, isError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = tt.arguments
+			result, err := service.GetChapterQuestions(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.IsError != tt.isError {
+				t.Fatalf("Expected IsError %v, got %+v", tt.isError, result)
+			}
+			if tt.isError {
+				return
+			}
+
+			structured := result.StructuredContent.(ChapterQuestionsResult)
+			if len(structured.Questions) != tt.questions {
+				t.Fatalf("Expected %d questions, got %+v", tt.questions, structured.Questions)
+			}
+			if first := structured.Questions[0]; (first.Kind == questionDiscussion) != tt.curated {
+				t.Errorf("Expected the question bank first: %v, got %+v", tt.curated, first)
+			}
+			text := result.Content[0].(mcp.TextContent).Text
+			if tt.curated && !strings.Contains(text, "Discussion:\n1. What does Alma compare the word to? (Alma 32:28)\n   Answer: A seed") {
+				t.Errorf("Expected the discussion question in the text, got:\n%s", text)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package scripture
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Readability measures how hard a passage is to read. Score is the average
+// sentence length in words plus one point per percent of archaic words, so
+// higher scores are harder.
+type Readability struct {
+	Words            int     `json:"words"`
+	Sentences        int     `json:"sentences"`
+	WordsPerSentence float64 `json:"wordsPerSentence"`
+	ArchaicWords     int     `json:"archaicWords"`
+	ArchaicDensity   float64 `json:"archaicDensity"`
+	Score            float64 `json:"score"`
+}
+
+// archaicWords are Early Modern English forms unfamiliar to young and ESL readers
+var archaicWords = map[string]bool{
+	"thee": true, "thou": true, "thy": true, "thine": true, "ye": true,
+	"hath": true, "hast": true, "doth": true, "dost": true, "didst": true,
+	"art": true, "shalt": true, "wilt": true, "canst": true, "wast": true,
+	"saith": true, "spake": true, "begat": true, "wist": true, "knowest": true,
+	"unto": true, "yea": true, "nay": true, "verily": true, "lo": true,
+	"behold": true, "wherefore": true, "whither": true, "hither": true, "thither": true,
+	"thence": true, "whence": true, "ere": true, "wherein": true, "thereof": true,
+}
+
+// ethExceptions are ordinary words ending in -eth that are not archaic verb forms
+var ethExceptions = map[string]bool{
+	"beneath": true, "teeth": true, "seth": true, "heth": true, "japheth": true,
+	"nazareth": true, "elizabeth": true, "shibboleth": true, "jether": true,
+}
+
+// isArchaic reports whether a lowercase word is an archaic form, including
+// third-person verbs such as "loveth" (but not ordinals like "twentieth")
+func isArchaic(word string) bool {
+	if archaicWords[word] {
+		return true
+	}
+	return len(word) > 4 && strings.HasSuffix(word, "eth") && !strings.HasSuffix(word, "ieth") && !ethExceptions[word]
+}
+
+// measureReadability computes readability metrics over one or more texts,
+// splitting them into words with the tokenizer and counting those with a
+// letter
+func measureReadability(tokenizer Tokenizer, texts ...string) Readability {
+	var r Readability
+	for _, text := range texts {
+		sentences := 0
+		for _, field := range strings.Fields(text) {
+			words := letterWords(tokenizer, field)
+			if len(words) == 0 {
+				continue
+			}
+			r.Words += len(words)
+			for _, word := range words {
+				if isArchaic(word) {
+					r.ArchaicWords++
+				}
+			}
+			if strings.ContainsAny(field, ".?!") {
+				sentences++
+			}
+		}
+		// A verse that ends mid-sentence still counts as one
+		r.Sentences += max(sentences, 1)
+	}
+
+	if r.Words > 0 {
+		r.WordsPerSentence = round1(float64(r.Words) / float64(r.Sentences))
+		r.ArchaicDensity = round1(100*float64(r.ArchaicWords)/float64(r.Words)) / 100
+		r.Score = round1(r.WordsPerSentence + 100*r.ArchaicDensity)
+	}
+	return r
+}
+
+// round1 rounds to one decimal place
+func round1(x float64) float64 {
+	return math.Round(x*10) / 10
+}
+
+// verseReadability measures each verse, keyed by reference
+func (s *Service) verseReadability(scriptures []Scripture) map[string]Readability {
+	metrics := make(map[string]Readability, len(scriptures))
+	for _, scripture := range scriptures {
+		metrics[scripture.Reference] = measureReadability(s.tokenizer(), scripture.Text)
+	}
+	return metrics
+}
+
+// chapterReadability measures a chapter as a whole and verse by verse
+func (s *Service) chapterReadability(scriptures []Scripture) map[string]any {
+	texts := make([]string, len(scriptures))
+	for i, scripture := range scriptures {
+		texts[i] = scripture.Text
+	}
+	return map[string]any{
+		"chapter": measureReadability(s.tokenizer(), texts...),
+		"verses":  s.verseReadability(scriptures),
+	}
+}
+
+// simplestPage returns a page of keyword matches ordered from easiest to
+// hardest to read, keeping canonical order among equal scores
+func (s *Service) simplestPage(ctx context.Context, query, mode string, offset, limit int) ([]Scripture, int) {
+	matches, total := s.searchPage(ctx, query, mode, 0, math.MaxInt)
+
+	scores := make(map[string]float64, len(matches))
+	for _, match := range matches {
+		scores[match.Reference] = measureReadability(s.tokenizer(), match.Text).Score
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return scores[matches[i].Reference] < scores[matches[j].Reference]
+	})
+
+	if offset >= len(matches) {
+		return nil, total
+	}
+	return matches[offset:min(offset+limit, len(matches))], total
+}
@@ -0,0 +1,100 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestIsArchaic(t *testing.T) {
+	tests := []struct {
+		word     string
+		expected bool
+	}{
+		{"thou", true},
+		{"loveth", true},
+		{"saith", true},
+		{"beneath", false},
+		{"twentieth", false},
+		{"love", false},
+		{"the", false},
+	}
+
+	for _, tt := range tests {
+		if got := isArchaic(tt.word); got != tt.expected {
+			t.Errorf("isArchaic(%q) = %v, expected %v", tt.word, got, tt.expected)
+		}
+	}
+}
+
+func TestMeasureReadability(t *testing.T) {
+	r := measureReadability(archaicEnglishTokenizer{}, "Jesus wept.")
+	if r.Words != 2 || r.Sentences != 1 || r.ArchaicWords != 0 || r.Score != 2 {
+		t.Errorf("Unexpected metrics for 'Jesus wept.': %+v", r)
+	}
+
+	// Two sentences of five words, four archaic words out of ten
+	r = measureReadability(archaicEnglishTokenizer{}, "Thou shalt not steal today. Thou art my own son.")
+	if r.Sentences != 2 || r.WordsPerSentence != 5 || r.ArchaicWords != 4 {
+		t.Errorf("Unexpected metrics: %+v", r)
+	}
+	if r.ArchaicDensity != 0.4 || r.Score != 45 {
+		t.Errorf("Expected density 0.4 and score 45, got %+v", r)
+	}
+
+	// A verse ending mid-sentence counts as one sentence
+	if r := measureReadability(archaicEnglishTokenizer{}, "And it came to pass that"); r.Sentences != 1 {
+		t.Errorf("Expected 1 sentence, got %d", r.Sentences)
+	}
+
+	// Numbers are not words
+	if r := measureReadability(archaicEnglishTokenizer{}, "And 3 and 4 score years. 5"); r.Words != 4 || r.Sentences != 1 {
+		t.Errorf("Expected 4 words in 1 sentence, got %+v", r)
+	}
+}
+
+func TestService_SearchScriptures_PreferSimple(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+
+	// Add test data
+	service.scriptures["John"] = []Scripture{
+		{Book: "John", Chapter: 1, Verse: 1, Text: "In the beginning was the Word, and the Word was with God, and the Word was God.", Reference: "John 1:1"},
+		{Book: "John", Chapter: 11, Verse: 35, Text: "Jesus wept, and the Word was true.", Reference: "John 11:35"},
+	}
+
+	tests := []struct {
+		name         string
+		preferSimple bool
+		expectFirst  string
+	}{
+		{name: "Canonical order", preferSimple: false, expectFirst: "1. John 1:1"},
+		{name: "Simplest first", preferSimple: true, expectFirst: "1. John 11:35"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: map[string]interface{}{"query": "Word", "prefer_simple": tt.preferSimple},
+				},
+			}
+			result, err := service.SearchScriptures(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			text := result.Content[0].(mcp.TextContent).Text
+			if !strings.Contains(text, tt.expectFirst) {
+				t.Errorf("Expected %q, got:\n%s", tt.expectFirst, text)
+			}
+			metrics, ok := result.Meta.AdditionalFields["readability"].(map[string]Readability)
+			if !ok || len(metrics) != 2 {
+				t.Errorf("Expected readability for both results, got %v", result.Meta.AdditionalFields["readability"])
+			}
+		})
+	}
+}
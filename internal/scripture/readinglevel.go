@@ -0,0 +1,175 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxLeveledPassages caps the limit of passages_for_reading_level
+const maxLeveledPassages = 20
+
+// maxLeveledVerses caps the max_verses argument of passages_for_reading_level
+const maxLeveledVerses = 10
+
+// passageLengthPenalty is how many readability points each verse after the
+// first adds when ranking passages for a reading level
+const passageLengthPenalty = 2
+
+// readingLevel is a target reading level: the hardest readability score a
+// passage may have and how many verses it may run to by default
+type readingLevel struct {
+	Name      string
+	MaxScore  float64
+	MaxVerses int
+}
+
+// readingLevels are the levels of passages_for_reading_level, easiest
+// first. About a tenth of verses score 15 or less and half 30 or less.
+var readingLevels = []readingLevel{
+	{Name: "easy", MaxScore: 15, MaxVerses: 2},     // Primary children and new English readers
+	{Name: "moderate", MaxScore: 25, MaxVerses: 3}, // Youth and ESL study groups
+	{Name: "advanced", MaxScore: 40, MaxVerses: 5}, // Most adult readers
+}
+
+// LeveledPassage is a passage on a topic with how hard it is to read
+type LeveledPassage struct {
+	Reference   string      `json:"reference"`
+	Verses      []Scripture `json:"verses"`
+	Readability Readability `json:"readability"`
+	Sources     []string    `json:"sources"`              // Sources that suggested it: theme, topical_guide and search
+	AboveLevel  bool        `json:"aboveLevel,omitempty"` // Harder than the level, offered because too few passages fit it
+}
+
+// PassagesForReadingLevel finds passages on a topic for a target reading
+// level, preferring simpler verses and shorter passages, for Primary
+// teachers and ESL study groups. Candidates are the merged passages of
+// topicPassages: a theme's curated passages, Topical Guide verses and
+// search hits. Long passages are cut to their easiest stretch of verses.
+func (s *Service) PassagesForReadingLevel(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	topic, _ := arguments["topic"].(string)
+	topic = strings.TrimSpace(topic)
+	if topic == "" {
+		return mcp.NewToolResultError("topic cannot be empty"), nil
+	}
+
+	level := readingLevels[0]
+	if value, _ := arguments["level"].(string); value != "" {
+		found := false
+		var names []string
+		for _, candidate := range readingLevels {
+			names = append(names, candidate.Name)
+			if strings.EqualFold(strings.TrimSpace(value), candidate.Name) {
+				level, found = candidate, true
+			}
+		}
+		if !found {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid level '%s'; use %s", value, strings.Join(names, ", "))), nil
+		}
+	}
+	maxVerses := level.MaxVerses
+	if maxVal, ok := arguments["max_verses"].(float64); ok {
+		maxVerses = min(max(int(maxVal), 1), maxLeveledVerses)
+	}
+
+	limit := 5
+	if limitVal, ok := arguments["limit"].(float64); ok {
+		limit = min(max(int(limitVal), 1), maxLeveledPassages)
+	}
+
+	scopeText, _ := arguments["scope"].(string)
+	scopeText = strings.TrimSpace(scopeText)
+	keep, err := s.scopeFilter(scopeText)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	passages := s.leveledPassages(ctx, topic, keep, level, maxVerses)
+	passages = passages[:min(limit, len(passages))]
+	structured := ReadingLevelResult{Topic: topic, Level: level.Name, MaxScore: level.MaxScore, MaxVerses: maxVerses, Scope: scopeText, Passages: append([]LeveledPassage{}, passages...)}
+	if len(passages) == 0 {
+		return mcp.NewToolResultStructured(structured, fmt.Sprintf("No passages found on '%s'.", topic)), nil
+	}
+
+	response := fmt.Sprintf("Passages on '%s' for %s reading (score up to %g, at most %d verses):\n\n", topic, level.Name, level.MaxScore, maxVerses)
+	for i, passage := range passages {
+		note := ""
+		if passage.AboveLevel {
+			note = ", above the level"
+		}
+		response += fmt.Sprintf("%d. %s (score %g, %s%s)\n", i+1, passage.Reference, passage.Readability.Score, strings.Join(passage.Sources, ", "), note)
+		for _, verse := range passage.Verses {
+			response += fmt.Sprintf("   %d. %s\n", verse.Verse, verse.Text)
+		}
+		response += "\n"
+	}
+	return mcp.NewToolResultStructured(structured, response), nil
+}
+
+// leveledPassages returns the candidate passages on a topic, those within
+// the level first, each group from easiest to hardest by leveledRank
+func (s *Service) leveledPassages(ctx context.Context, topic string, keep func(Scripture) bool, level readingLevel, maxVerses int) []LeveledPassage {
+	var candidates []LeveledPassage
+	for _, passage := range s.topicPassages(ctx, topic, keep) {
+		verses := easiestStretch(s.tokenizer(), passage.Verses, maxVerses)
+		candidates = append(candidates, LeveledPassage{
+			Reference: spanReference(verses, verseSpan{Start: 0, End: len(verses) - 1}),
+			Verses:    verses,
+			Sources:   passage.Sources,
+		})
+	}
+
+	for i := range candidates {
+		texts := make([]string, len(candidates[i].Verses))
+		for j, verse := range candidates[i].Verses {
+			texts[j] = verse.Text
+		}
+		candidates[i].Readability = measureReadability(s.tokenizer(), texts...)
+		candidates[i].AboveLevel = candidates[i].Readability.Score > level.MaxScore
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.AboveLevel != b.AboveLevel {
+			return !a.AboveLevel
+		}
+		if rankA, rankB := leveledRank(a), leveledRank(b); rankA != rankB {
+			return rankA < rankB
+		}
+		if len(a.Verses) != len(b.Verses) {
+			return len(a.Verses) < len(b.Verses)
+		}
+		return len(a.Sources) > len(b.Sources)
+	})
+	return candidates
+}
+
+// leveledRank orders passages for a reading level: the readability score,
+// with each verse after the first counting as passageLengthPenalty points
+// harder, so a short passage comes before a slightly easier long one
+func leveledRank(passage LeveledPassage) float64 {
+	return passage.Readability.Score + passageLengthPenalty*float64(len(passage.Verses)-1)
+}
+
+// easiestStretch returns the run of at most n consecutive verses that is
+// easiest to read, the earliest among equals
+func easiestStretch(tokenizer Tokenizer, verses []Scripture, n int) []Scripture {
+	if len(verses) <= n {
+		return verses
+	}
+	best, bestScore := 0, 0.0
+	for start := 0; start+n <= len(verses); start++ {
+		texts := make([]string, n)
+		for i, verse := range verses[start : start+n] {
+			texts[i] = verse.Text
+		}
+		if score := measureReadability(tokenizer, texts...).Score; start == 0 || score < bestScore {
+			best, bestScore = start, score
+		}
+	}
+	return verses[best : best+n]
+}
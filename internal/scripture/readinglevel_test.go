@@ -0,0 +1,90 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestService_PassagesForReadingLevel(t *testing.T) {
+	service := NewService()
+	call := func(arguments map[string]any) *mcp.CallToolResult {
+		t.Helper()
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = arguments
+		result, err := service.PassagesForReadingLevel(context.Background(), request)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	result := call(map[string]any{"topic": "prayer", "limit": float64(10)})
+	if result.IsError {
+		t.Fatalf("Unexpected error: %+v", result)
+	}
+	structured := result.StructuredContent.(ReadingLevelResult)
+	if structured.Level != "easy" || structured.MaxScore != 15 || structured.MaxVerses != 2 || len(structured.Passages) != 10 {
+		t.Fatalf("Unexpected result: %+v", structured)
+	}
+	if first := structured.Passages[0]; first.Reference != "1 Thessalonians 5:17" || first.Sources[0] != passageFromTheme {
+		t.Errorf("Expected the curated \"Pray without ceasing\" first, got %+v", first)
+	}
+	for i, passage := range structured.Passages {
+		if passage.AboveLevel || passage.Readability.Score > 15 || len(passage.Verses) > 2 {
+			t.Errorf("Expected easy passages of at most 2 verses, got %+v", passage)
+		}
+		if i > 0 && leveledRank(passage) < leveledRank(structured.Passages[i-1]) {
+			t.Errorf("Expected easiest first, got %s before %s", structured.Passages[i-1].Reference, passage.Reference)
+		}
+	}
+
+	// Long theme passages are cut to fit, and the scope may be a collection
+	structured = call(map[string]any{"topic": "prayer", "level": "Advanced", "max_verses": float64(1), "scope": "Book of Mormon", "limit": float64(20)}).StructuredContent.(ReadingLevelResult)
+	if structured.Level != "advanced" || len(structured.Passages) != 20 {
+		t.Fatalf("Unexpected result: %+v", structured)
+	}
+	for _, passage := range structured.Passages {
+		if len(passage.Verses) != 1 || service.bookCollections[passage.Verses[0].Book] != "Book of Mormon" {
+			t.Errorf("Expected single Book of Mormon verses, got %+v", passage)
+		}
+	}
+
+	// Verses with every word of the topic are preferred
+	for _, passage := range call(map[string]any{"topic": "love one another", "level": "moderate"}).StructuredContent.(ReadingLevelResult).Passages {
+		if text := strings.ToLower(passage.Verses[0].Text); !strings.Contains(text, "love") || !strings.Contains(text, "another") {
+			t.Errorf("Expected verses on loving one another, got %+v", passage)
+		}
+	}
+
+	if result := call(map[string]any{"topic": "xyzzy"}); result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "No passages found") {
+		t.Errorf("Expected no passages, got %+v", result)
+	}
+	for _, arguments := range []map[string]any{
+		{},
+		{"topic": "faith", "level": "graduate"},
+		{"topic": "faith", "scope": "Hezekiah"},
+	} {
+		if result := call(arguments); !result.IsError {
+			t.Errorf("Expected an error for %v", arguments)
+		}
+	}
+}
+
+func TestEasiestStretch(t *testing.T) {
+	verses := []Scripture{
+		{Verse: 1, Text: "And it came to pass that the people did wax strong in the land, and they did multiply exceedingly and spread forth."},
+		{Verse: 2, Text: "Jesus wept."},
+		{Verse: 3, Text: "Pray always."},
+		{Verse: 4, Text: "Behold, thou shalt not suffer thine heart to be lifted up in pride, for verily it doth lead unto destruction."},
+	}
+	stretch := easiestStretch(archaicEnglishTokenizer{}, verses, 2)
+	if len(stretch) != 2 || stretch[0].Verse != 2 || stretch[1].Verse != 3 {
+		t.Errorf("Expected verses 2-3, got %+v", stretch)
+	}
+	if stretch := easiestStretch(archaicEnglishTokenizer{}, verses[:2], 3); len(stretch) != 2 {
+		t.Errorf("Expected a short passage whole, got %+v", stretch)
+	}
+}
@@ -0,0 +1,250 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Reference math operations
+const (
+	refMathContains = "contains"
+	refMathOverlap  = "overlap"
+	refMathDistance = "distance"
+	refMathSplit    = "split"
+)
+
+// refMathOperations lists the operations accepted by reference_math
+var refMathOperations = []string{refMathContains, refMathOverlap, refMathDistance, refMathSplit}
+
+// maxSplitParts caps the number of parts a span can be split into
+const maxSplitParts = 1000
+
+// verseSpan is a contiguous run of verses in canonical reading order, given
+// as inclusive indexes into the flat verse list
+type verseSpan struct {
+	Start int
+	End   int
+}
+
+// Len returns the number of verses in the span
+func (v verseSpan) Len() int {
+	return v.End - v.Start + 1
+}
+
+// ReferenceMathResult is the structured result of a reference_math operation
+type ReferenceMathResult struct {
+	Operation string   `json:"operation"`
+	A         string   `json:"a"`
+	B         string   `json:"b,omitempty"`
+	Contains  *bool    `json:"contains,omitempty"`
+	Overlap   string   `json:"overlap,omitempty"`
+	Verses    int      `json:"verses"`
+	Parts     []string `json:"parts,omitempty"`
+}
+
+// ReferenceMath computes containment, overlap, distance and even splits of references
+func (s *Service) ReferenceMath(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	operation, _ := arguments["operation"].(string)
+	operation = strings.ToLower(operation)
+	if !slices.Contains(refMathOperations, operation) {
+		return mcp.NewToolResultError(fmt.Sprintf("operation must be one of: %s", strings.Join(refMathOperations, ", "))), nil
+	}
+
+	a, ok := arguments["a"].(string)
+	if !ok || a == "" {
+		return mcp.NewToolResultError("reference 'a' cannot be empty"), nil
+	}
+
+	verses := s.flatVerses()
+	spanA, err := s.resolveSpan(verses, a)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	result := ReferenceMathResult{Operation: operation, A: spanReference(verses, spanA)}
+	var response string
+
+	if operation == refMathSplit {
+		parts := 0
+		if partsVal, ok := arguments["parts"].(float64); ok {
+			parts = int(partsVal)
+		}
+		if parts < 1 || parts > maxSplitParts {
+			return mcp.NewToolResultError(fmt.Sprintf("parts must be between 1 and %d", maxSplitParts)), nil
+		}
+		if parts > spanA.Len() {
+			return mcp.NewToolResultError(fmt.Sprintf("cannot split %d verses into %d parts", spanA.Len(), parts)), nil
+		}
+
+		response = fmt.Sprintf("%s (%d verses) in %d parts:\n\n", result.A, spanA.Len(), parts)
+		for i, part := range splitSpan(spanA, parts) {
+			reference := spanReference(verses, part)
+			result.Parts = append(result.Parts, reference)
+			response += fmt.Sprintf("%d. %s (%d verses)\n", i+1, reference, part.Len())
+		}
+		result.Verses = spanA.Len()
+		return refMathResult(response, result), nil
+	}
+
+	b, ok := arguments["b"].(string)
+	if !ok || b == "" {
+		return mcp.NewToolResultError(fmt.Sprintf("reference 'b' is required for %s", operation)), nil
+	}
+	spanB, err := s.resolveSpan(verses, b)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	result.B = spanReference(verses, spanB)
+
+	switch operation {
+	case refMathContains:
+		contains := spanB.Start >= spanA.Start && spanB.End <= spanA.End
+		result.Contains = &contains
+		result.Verses = spanB.Len()
+		if contains {
+			response = fmt.Sprintf("Yes: %s is within %s.", result.B, result.A)
+		} else {
+			response = fmt.Sprintf("No: %s is not within %s.", result.B, result.A)
+		}
+	case refMathOverlap:
+		overlap := verseSpan{Start: max(spanA.Start, spanB.Start), End: min(spanA.End, spanB.End)}
+		if overlap.Start > overlap.End {
+			response = fmt.Sprintf("%s and %s do not overlap.", result.A, result.B)
+			break
+		}
+		result.Overlap = spanReference(verses, overlap)
+		result.Verses = overlap.Len()
+		response = fmt.Sprintf("%s and %s overlap in %s (%d %s).", result.A, result.B, result.Overlap, overlap.Len(), pluralize(overlap.Len(), "verse", "verses"))
+	case refMathDistance:
+		distance := spanB.Start - spanA.Start
+		result.Verses = distance
+		switch {
+		case distance == 0:
+			response = fmt.Sprintf("%s and %s start at the same verse.", result.A, result.B)
+		case distance > 0:
+			response = fmt.Sprintf("%s starts %d %s after %s.", result.B, distance, pluralize(distance, "verse", "verses"), result.A)
+		default:
+			response = fmt.Sprintf("%s starts %d %s before %s.", result.B, -distance, pluralize(-distance, "verse", "verses"), result.A)
+		}
+	}
+
+	return refMathResult(response, result), nil
+}
+
+// refMathResult wraps the text response with the structured result, which is
+// also kept in _meta
+func refMathResult(response string, result ReferenceMathResult) *mcp.CallToolResult {
+	toolResult := mcp.NewToolResultStructured(result, response)
+	toolResult.Meta = &mcp.Meta{AdditionalFields: map[string]any{"result": result}}
+	return toolResult
+}
+
+// flatVerses returns every loaded verse in canonical reading order
+func (s *Service) flatVerses() []Scripture {
+	var verses []Scripture
+	for _, book := range s.orderedBooks() {
+		verses = append(verses, s.scriptures[book]...)
+	}
+	return verses
+}
+
+// resolveSpan finds the verses a book, chapter or verse range selector covers
+func (s *Service) resolveSpan(verses []Scripture, selector string) (verseSpan, error) {
+	ref, err := s.parseSelector(selector)
+	if err != nil {
+		return verseSpan{}, err
+	}
+	refs := []*ScriptureReference{ref}
+
+	span := verseSpan{Start: -1, End: -1}
+	for i, verse := range verses {
+		if !selectorMatches(refs, verse) {
+			continue
+		}
+		if span.Start < 0 {
+			span.Start = i
+		}
+		span.End = i
+	}
+	if span.Start < 0 {
+		return verseSpan{}, fmt.Errorf("no verses found for '%s'", selector)
+	}
+	return span, nil
+}
+
+// splitSpan divides a span into parts whose lengths differ by at most one verse
+func splitSpan(span verseSpan, parts int) []verseSpan {
+	result := make([]verseSpan, 0, parts)
+	size, extra := span.Len()/parts, span.Len()%parts
+	start := span.Start
+	for i := range parts {
+		length := size
+		if i < extra {
+			length++
+		}
+		result = append(result, verseSpan{Start: start, End: start + length - 1})
+		start += length
+	}
+	return result
+}
+
+// spanReference renders a span compactly: "Alma 32:21", "Alma 32:17-27",
+// "Alma 32:17-33:5" or "Alma 63:17-Helaman 1:3"
+func spanReference(verses []Scripture, span verseSpan) string {
+	first, last := verses[span.Start], verses[span.End]
+	start := fmt.Sprintf("%s %d:%d", first.Book, first.Chapter, first.Verse)
+	switch {
+	case span.Start == span.End:
+		return start
+	case first.Book != last.Book:
+		return fmt.Sprintf("%s-%s %d:%d", start, last.Book, last.Chapter, last.Verse)
+	case first.Chapter != last.Chapter:
+		return fmt.Sprintf("%s-%d:%d", start, last.Chapter, last.Verse)
+	default:
+		return fmt.Sprintf("%s-%d", start, last.Verse)
+	}
+}
+
+// parseSelector parses a book, chapter or verse selector into a reference.
+// Open-ended chapter and verse bounds are represented by zero and math.MaxInt.
+func (s *Service) parseSelector(selector string) (*ScriptureReference, error) {
+	selector = strings.TrimSpace(selector)
+	if ref, err := s.parseReference(selector); err == nil {
+		return ref, nil
+	}
+	if ref, err := s.parseChapterReference(selector); err == nil {
+		if _, exists := s.scriptures[ref.Book]; exists {
+			ref.Verse = 1
+			ref.EndVerse = math.MaxInt
+			return ref, nil
+		}
+	}
+	if book, ok := s.resolveBook(selector); ok {
+		return &ScriptureReference{Book: book, EndVerse: math.MaxInt}, nil
+	}
+	return nil, fmt.Errorf("unknown reference '%s'", selector)
+}
+
+// selectorMatches reports whether a verse falls within any of the references.
+// A zero chapter selects the whole book.
+func selectorMatches(refs []*ScriptureReference, verse Scripture) bool {
+	for _, ref := range refs {
+		if ref.Book != verse.Book {
+			continue
+		}
+		if ref.Chapter == 0 {
+			return true
+		}
+		if ref.Chapter == verse.Chapter && verse.Verse >= ref.Verse && verse.Verse <= ref.EndVerse {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestSplitSpan(t *testing.T) {
+	parts := splitSpan(verseSpan{Start: 0, End: 9}, 3)
+	expected := []verseSpan{{0, 3}, {4, 6}, {7, 9}}
+	if len(parts) != len(expected) {
+		t.Fatalf("Expected %d parts, got %d", len(expected), len(parts))
+	}
+	for i := range expected {
+		if parts[i] != expected[i] {
+			t.Errorf("Part %d: expected %v, got %v", i, expected[i], parts[i])
+		}
+	}
+}
+
+func TestService_ReferenceMath(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+
+	// Add test data
+	service.recordBook("Alma", "Book of Mormon")
+	service.recordBook("Helaman", "Book of Mormon")
+	for verse := 1; verse <= 43; verse++ {
+		service.scriptures["Alma"] = append(service.scriptures["Alma"], Scripture{Book: "Alma", Chapter: 32, Verse: verse})
+	}
+	for verse := 1; verse <= 5; verse++ {
+		service.scriptures["Alma"] = append(service.scriptures["Alma"], Scripture{Book: "Alma", Chapter: 33, Verse: verse})
+	}
+	service.scriptures["Helaman"] = []Scripture{
+		{Book: "Helaman", Chapter: 1, Verse: 1},
+		{Book: "Helaman", Chapter: 1, Verse: 2},
+	}
+
+	tests := []struct {
+		name          string
+		arguments     map[string]interface{}
+		expectError   bool
+		shouldContain string
+	}{
+		{
+			name:          "Contains",
+			arguments:     map[string]interface{}{"operation": "contains", "a": "Alma 32:17-27", "b": "Alma 32:21"},
+			shouldContain: "Yes: Alma 32:21 is within Alma 32:17-27.",
+		},
+		{
+			name:          "Typeset en dash",
+			arguments:     map[string]interface{}{"operation": "contains", "a": "Alma 32:17–27", "b": "Alma 32:21"},
+			shouldContain: "Yes: Alma 32:21 is within Alma 32:17-27.",
+		},
+		{
+			name:          "Does not contain",
+			arguments:     map[string]interface{}{"operation": "contains", "a": "Alma 32:17-27", "b": "Alma 32:26-28"},
+			shouldContain: "No: Alma 32:26-28 is not within Alma 32:17-27.",
+		},
+		{
+			name:          "Whole chapter contains",
+			arguments:     map[string]interface{}{"operation": "contains", "a": "Alma 32", "b": "Alma 32:43"},
+			shouldContain: "Yes",
+		},
+		{
+			name:          "Overlap",
+			arguments:     map[string]interface{}{"operation": "overlap", "a": "Alma 32:17-27", "b": "Alma 32:21-35"},
+			shouldContain: "overlap in Alma 32:21-27 (7 verses)",
+		},
+		{
+			name:          "No overlap",
+			arguments:     map[string]interface{}{"operation": "overlap", "a": "Alma 32:1-5", "b": "Alma 32:6-9"},
+			shouldContain: "do not overlap",
+		},
+		{
+			name:          "Distance across chapters",
+			arguments:     map[string]interface{}{"operation": "distance", "a": "Alma 32:40", "b": "Alma 33:2"},
+			shouldContain: "Alma 33:2 starts 5 verses after Alma 32:40.",
+		},
+		{
+			name:          "Distance backwards",
+			arguments:     map[string]interface{}{"operation": "distance", "a": "Helaman 1:1", "b": "Alma 33:5"},
+			shouldContain: "Alma 33:5 starts 1 verse before Helaman 1:1.",
+		},
+		{
+			name:          "Split book range",
+			arguments:     map[string]interface{}{"operation": "split", "a": "Alma", "parts": float64(2)},
+			shouldContain: "1. Alma 32:1-24 (24 verses)\n2. Alma 32:25-33:5 (24 verses)",
+		},
+		{
+			name:        "Too many parts",
+			arguments:   map[string]interface{}{"operation": "split", "a": "Helaman 1", "parts": float64(3)},
+			expectError: true,
+		},
+		{
+			name:        "Missing b",
+			arguments:   map[string]interface{}{"operation": "overlap", "a": "Alma 32"},
+			expectError: true,
+		},
+		{
+			name:        "Unknown operation",
+			arguments:   map[string]interface{}{"operation": "divide", "a": "Alma 32"},
+			expectError: true,
+		},
+		{
+			name:        "Unknown reference",
+			arguments:   map[string]interface{}{"operation": "contains", "a": "Alma 99", "b": "Alma 32:1"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+			result, err := service.ReferenceMath(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.IsError != tt.expectError {
+				t.Fatalf("Expected IsError = %v, got %v: %v", tt.expectError, result.IsError, result.Content)
+			}
+			if tt.expectError {
+				return
+			}
+			text := result.Content[0].(mcp.TextContent).Text
+			if !strings.Contains(text, tt.shouldContain) {
+				t.Errorf("Expected output to contain %q, got:\n%s", tt.shouldContain, text)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package scripture
+
+import (
+	"context"
+	"math"
+	"sort"
+)
+
+// Sort orders for search_scriptures
+const (
+	sortCanonical = "canonical"
+	sortRelevance = "relevance"
+)
+
+// BM25 parameters: bm25K1 limits how much repeating a term raises a score,
+// bm25B how strongly long verses are penalized
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// scoredVerse is a verse with its BM25 relevance score
+type scoredVerse struct {
+	Scripture
+	Score float64
+}
+
+// relevancePage returns up to limit verses starting at offset, ranked by
+// BM25 relevance to the query's words, along with the number of verses that
+// contain at least one of them. Unlike keyword search, words are matched
+// whole and need not appear together, so "faith hope charity" ranks the
+// verses that mention all three first.
+func (s *Service) relevancePage(ctx context.Context, query string, offset, limit int) ([]scoredVerse, int) {
+	index := s.index
+	if index == nil {
+		index = s.newSearchIndex()
+	}
+	scored := index.rank(ctx, query)
+	if offset >= len(scored) {
+		return nil, len(scored)
+	}
+	return scored[offset:min(offset+limit, len(scored))], len(scored)
+}
+
+// rank scores every verse containing a query word, highest score first and
+// canonical order among equal scores
+func (idx *searchIndex) rank(ctx context.Context, query string) []scoredVerse {
+	if len(idx.verses) == 0 {
+		return nil
+	}
+	averageLength := float64(idx.totalWords) / float64(len(idx.verses))
+
+	scores := make(map[int32]float64)
+	seen := make(map[string]bool)
+	for _, word := range idx.tokenizer.Words(query) {
+		if ctx.Err() != nil {
+			break
+		}
+		if seen[word] {
+			continue
+		}
+		seen[word] = true
+
+		postings := idx.words[word]
+		if len(postings) == 0 {
+			continue
+		}
+		df := float64(len(postings))
+		idf := math.Log(1 + (float64(len(idx.verses))-df+0.5)/(df+0.5))
+		for _, p := range postings {
+			tf := float64(p.count)
+			norm := 1 - bm25B + bm25B*float64(idx.lengths[p.id])/averageLength
+			scores[p.id] += idf * tf * (bm25K1 + 1) / (tf + bm25K1*norm)
+		}
+	}
+
+	ids := make([]int32, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if scores[ids[i]] != scores[ids[j]] {
+			return scores[ids[i]] > scores[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+
+	ranked := make([]scoredVerse, len(ids))
+	for i, id := range ids {
+		ranked[i] = scoredVerse{Scripture: idx.verses[id], Score: round2(scores[id])}
+	}
+	return ranked
+}
+
+// round2 rounds to two decimal places
+func round2(value float64) float64 {
+	return math.Round(value*100) / 100
+}
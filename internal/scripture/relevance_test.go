@@ -0,0 +1,115 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestSearchIndex_Rank(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	service.recordBook("Moroni", "Book of Mormon")
+	service.scriptures["Moroni"] = []Scripture{
+		{Book: "Moroni", Chapter: 7, Verse: 1, Text: "And now I speak of faith", Reference: "Moroni 7:1"},
+		{Book: "Moroni", Chapter: 7, Verse: 2, Text: "Faith and hope and charity", Reference: "Moroni 7:2"},
+		{Book: "Moroni", Chapter: 7, Verse: 3, Text: "The people went into the land of the north", Reference: "Moroni 7:3"},
+		{Book: "Moroni", Chapter: 7, Verse: 4, Text: "Charity never faileth", Reference: "Moroni 7:4"},
+		{Book: "Moroni", Chapter: 7, Verse: 5, Text: "And now I speak of hope", Reference: "Moroni 7:5"},
+	}
+
+	ranked := service.newSearchIndex().rank(context.Background(), "Faith, hope, charity")
+	references := make([]string, len(ranked))
+	for i, verse := range ranked {
+		references[i] = verse.Reference
+	}
+
+	// The verse with all three words comes first, then shorter verses before
+	// longer ones; verses with equal scores keep canonical order
+	expected := []string{"Moroni 7:2", "Moroni 7:4", "Moroni 7:1", "Moroni 7:5"}
+	if strings.Join(references, ", ") != strings.Join(expected, ", ") {
+		t.Errorf("Expected %v, got %v", expected, references)
+	}
+	if ranked[0].Score <= ranked[1].Score || ranked[2].Score != ranked[3].Score {
+		t.Errorf("Unexpected scores: %v", ranked)
+	}
+
+	if ranked := service.newSearchIndex().rank(context.Background(), "xyzzy"); len(ranked) != 0 {
+		t.Errorf("Expected no results, got %v", ranked)
+	}
+}
+
+func TestService_SearchScriptures_Sort(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	service.recordBook("1 Corinthians", "New Testament")
+	service.recordBook("Moroni", "Book of Mormon")
+	service.scriptures["1 Corinthians"] = []Scripture{
+		{Book: "1 Corinthians", Chapter: 13, Verse: 2, Text: "and though I have all faith, so that I could remove mountains", Reference: "1 Corinthians 13:2"},
+		{Book: "1 Corinthians", Chapter: 13, Verse: 13, Text: "And now abideth faith, hope, charity", Reference: "1 Corinthians 13:13"},
+	}
+	service.scriptures["Moroni"] = []Scripture{
+		{Book: "Moroni", Chapter: 7, Verse: 47, Text: "But charity is the pure love of Christ", Reference: "Moroni 7:47"},
+	}
+
+	tests := []struct {
+		name          string
+		arguments     map[string]interface{}
+		expectError   bool
+		shouldContain string
+	}{
+		{
+			name:          "Relevance ranks all words first",
+			arguments:     map[string]interface{}{"query": "faith hope charity", "sort": "relevance"},
+			shouldContain: "1. 1 Corinthians 13:13 (score ",
+		},
+		{
+			name:          "Relevance pages",
+			arguments:     map[string]interface{}{"query": "faith hope charity", "sort": "relevance", "limit": float64(1)},
+			shouldContain: "2 more results; call again with offset=1.",
+		},
+		{
+			name:          "Canonical keeps exact matching",
+			arguments:     map[string]interface{}{"query": "faith hope charity", "sort": "canonical"},
+			shouldContain: "No scriptures found matching 'faith hope charity'",
+		},
+		{
+			name:        "Unknown sort",
+			arguments:   map[string]interface{}{"query": "faith", "sort": "random"},
+			expectError: true,
+		},
+		{
+			name:        "Relevance with prefer_simple",
+			arguments:   map[string]interface{}{"query": "faith", "sort": "relevance", "prefer_simple": true},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+			result, err := service.SearchScriptures(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.IsError != tt.expectError {
+				t.Fatalf("Expected IsError = %v, got %v: %v", tt.expectError, result.IsError, result.Content)
+			}
+			if tt.expectError {
+				return
+			}
+			text := result.Content[0].(mcp.TextContent).Text
+			if !strings.Contains(text, tt.shouldContain) {
+				t.Errorf("Expected output to contain %q, got:\n%s", tt.shouldContain, text)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package scripture
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ReadLocked wraps a tool handler so the scripture data cannot be reloaded
+// while it runs. Every tool that reads the data is registered through it;
+// reload_data itself is not, as it takes the lock to write.
+func (s *Service) ReadLocked(handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		return handler(ctx, request)
+	}
+}
+
+// Reload re-reads the scripture data, the optional datasets, the language
+// editions and the translations from the same places as at startup, then
+// replaces the loaded data in one step. Loading happens before the lock is
+// taken, so calls in flight finish against the old data and later calls see
+// the new. When no scripture data is found the current data is kept.
+func (s *Service) Reload() error {
+	fresh := NewService()
+	if len(fresh.scriptures) == 0 {
+		return errors.New("no scripture data found; keeping the current data")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.scriptures = fresh.scriptures
+	s.bookOrder = fresh.bookOrder
+	s.bookCollections = fresh.bookCollections
+	s.integrity = fresh.integrity
+	s.optional = fresh.optional
+	s.works = fresh.works
+	s.stories = fresh.stories
+	s.footnotes = fresh.footnotes
+	s.dictionary = fresh.dictionary
+	s.schedule = fresh.schedule
+	s.questions = fresh.questions
+	s.licenses = fresh.licenses
+	s.provenance = fresh.provenance
+	s.index = fresh.index
+	s.budget = fresh.budget
+	s.requireCitations = fresh.requireCitations
+	s.bookTitles = fresh.bookTitles
+	s.chapterHeadings = fresh.chapterHeadings
+	s.styleOnce = sync.Once{}
+	s.styleStats = nil
+	s.editions = fresh.editions
+	s.translations = fresh.translations
+	return nil
+}
+
+// ReloadData reloads the scripture data without a restart, reporting what
+// was loaded before and after
+func (s *Service) ReloadData(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	s.mu.RLock()
+	previousBooks, previousVerses := s.loadedCounts()
+	s.mu.RUnlock()
+
+	started := time.Now()
+	if err := s.Reload(); err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("reload failed: %v", err)), nil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	books, verses := s.loadedCounts()
+	structured := ReloadResult{
+		Books:          books,
+		Verses:         verses,
+		PreviousBooks:  previousBooks,
+		PreviousVerses: previousVerses,
+		Languages:      s.languages(),
+		Translations:   s.translationNames(),
+		Integrity:      s.integrity,
+	}
+	response := fmt.Sprintf("Reloaded %d books, %d verses in %s (was %d books, %d verses).\n",
+		books, verses, time.Since(started).Round(time.Millisecond), previousBooks, previousVerses)
+	if len(structured.Languages) > 1 {
+		response += fmt.Sprintf("Languages: %s\n", strings.Join(structured.Languages, ", "))
+	}
+	if len(structured.Translations) > 1 {
+		response += fmt.Sprintf("Translations: %s\n", strings.Join(structured.Translations, ", "))
+	}
+	if s.integrity != nil && !s.integrity.OK() {
+		response += fmt.Sprintf("Integrity: FAILED - %s\n", s.integrity.String())
+	}
+	return mcp.NewToolResultStructured(structured, response), nil
+}
+
+// loadedCounts returns the number of books and verses loaded
+func (s *Service) loadedCounts() (int, int) {
+	verses := 0
+	for _, book := range s.scriptures {
+		verses += len(book)
+	}
+	return len(s.scriptures), verses
+}
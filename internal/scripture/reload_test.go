@@ -0,0 +1,100 @@
+package scripture
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestService_ReloadData(t *testing.T) {
+	state := t.TempDir()
+	t.Setenv("SCRIPTURES_STATE_DIR", state)
+	t.Setenv("SCRIPTURES_DATA_DIR", "")
+
+	service := NewService()
+	if len(service.editions) != 0 {
+		t.Fatalf("Expected no language editions yet, got %v", service.languages())
+	}
+
+	// Readers keep running against whole data sets while the data is replaced
+	getScripture := service.ReadLocked(service.GetScripture)
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]any{"query": "1 Nephi 3:7"}
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				result, err := getScripture(context.Background(), request)
+				if err != nil || result.IsError {
+					t.Errorf("Expected the verse during a reload, got %+v %v", result, err)
+					return
+				}
+			}
+		}()
+	}
+
+	editionDir := filepath.Join(state, "data", "es")
+	if err := os.MkdirAll(editionDir, 0755); err != nil {
+		t.Fatalf("Failed to create edition dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(editionDir, "book-of-mormon.json"), []byte(testSpanishEdition), 0644); err != nil {
+		t.Fatalf("Failed to write edition: %v", err)
+	}
+
+	result, err := service.ReloadData(context.Background(), mcp.CallToolRequest{})
+	close(stop)
+	wg.Wait()
+	if err != nil || result.IsError {
+		t.Fatalf("Unexpected error: %+v %v", result, err)
+	}
+	structured := result.StructuredContent.(ReloadResult)
+	if structured.Verses == 0 || structured.Verses != structured.PreviousVerses || strings.Join(structured.Languages, ",") != "en,es" {
+		t.Errorf("Unexpected reload result: %+v", structured)
+	}
+	if !strings.Contains(result.Content[0].(mcp.TextContent).Text, "Languages: en, es") {
+		t.Errorf("Expected the languages in the text, got %q", result.Content[0].(mcp.TextContent).Text)
+	}
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]any{"query": "1 Nefi 3:7", "language": "es"}
+	if result, err := getScripture(context.Background(), request); err != nil || result.IsError {
+		t.Errorf("Expected the reloaded Spanish edition, got %+v %v", result, err)
+	}
+}
+
+func TestService_ReloadData_Collections(t *testing.T) {
+	t.Setenv("SCRIPTURES_STATE_DIR", t.TempDir())
+	t.Setenv("SCRIPTURES_DATA_DIR", "")
+	t.Setenv("SCRIPTURES_COLLECTIONS", "")
+
+	// The selection changes between startup and the reload
+	service := NewService()
+	t.Setenv("SCRIPTURES_COLLECTIONS", "book-of-mormon")
+	if result, err := service.ReloadData(context.Background(), mcp.CallToolRequest{}); err != nil || result.IsError {
+		t.Fatalf("Unexpected error: %+v %v", result, err)
+	}
+
+	// The reloaded service follows the new selection, not just its verses
+	if _, loaded := service.scriptures["Genesis"]; loaded || len(service.scriptures["Alma"]) == 0 {
+		t.Errorf("Expected only the Book of Mormon after the reload, got %v", service.orderedBooks())
+	}
+	if service.fileEnabled("old-testament.json") || !service.fileEnabled("book-of-mormon.json") {
+		t.Errorf("Expected the reloaded selection, got %v", service.works)
+	}
+	if check := service.checkCollections(); !check.Passed || !strings.Contains(check.Detail, "SCRIPTURES_COLLECTIONS selection") {
+		t.Errorf("Expected the collection check to allow the selection, got %+v", check)
+	}
+}
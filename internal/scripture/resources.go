@@ -0,0 +1,169 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Chapters and verses are exposed as MCP resources so clients can attach
+// them to context without a tool call. URIs name the collection, book and
+// chapter as lowercase slugs, with an optional verse or verse range:
+//
+//	scripture://book-of-mormon/1-nephi/3
+//	scripture://book-of-mormon/1-nephi/3/7
+//	scripture://new-testament/john/3/16-17
+
+// resourceScheme prefixes every scripture resource URI
+const resourceScheme = "scripture://"
+
+// ChapterResources returns a resource for every loaded chapter, in reading order
+func (s *Service) ChapterResources() []server.ServerResource {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var resources []server.ServerResource
+	for _, chapter := range s.chapterSequence() {
+		uri, ok := s.resourceURI(chapter.book, chapter.chapter)
+		if !ok {
+			continue
+		}
+		resource := mcp.NewResource(uri, chapter.String(),
+			mcp.WithResourceDescription(fmt.Sprintf("%s, %s", chapter.String(), s.bookCollections[chapter.book])),
+			mcp.WithMIMEType("text/plain"),
+		)
+		resources = append(resources, server.ServerResource{Resource: resource, Handler: s.ReadResource})
+	}
+	return resources
+}
+
+// ResourceTemplates returns the templates for reading any chapter, verse or
+// verse range by URI
+func (s *Service) ResourceTemplates() []server.ServerResourceTemplate {
+	chapter := mcp.NewResourceTemplate(resourceScheme+"{collection}/{book}/{chapter}", "Scripture chapter",
+		mcp.WithTemplateDescription("A whole chapter, e.g. scripture://book-of-mormon/1-nephi/3"),
+		mcp.WithTemplateMIMEType("text/plain"),
+	)
+	verses := mcp.NewResourceTemplate(resourceScheme+"{collection}/{book}/{chapter}/{verses}", "Scripture verses",
+		mcp.WithTemplateDescription("A verse or verse range, e.g. scripture://new-testament/john/3/16-17"),
+		mcp.WithTemplateMIMEType("text/plain"),
+	)
+	return []server.ServerResourceTemplate{
+		{Template: chapter, Handler: s.ReadResource},
+		{Template: verses, Handler: s.ReadResource},
+	}
+}
+
+// ReadResource routes a scripture resource URI to the chapter or verses it names
+func (s *Service) ReadResource(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.readResource(request.Params.URI)
+}
+
+// readResource reads a chapter or verses resource; the caller holds the
+// read lock
+func (s *Service) readResource(uri string) ([]mcp.ResourceContents, error) {
+	path, ok := strings.CutPrefix(uri, resourceScheme)
+	if !ok {
+		return nil, fmt.Errorf("not a scripture resource: %s", uri)
+	}
+	parts := strings.Split(path, "/")
+	if len(parts) != 3 && len(parts) != 4 {
+		return nil, fmt.Errorf("expected %scollection/book/chapter[/verses], got %s", resourceScheme, uri)
+	}
+
+	book, ok := s.bookForSlugs(parts[0], parts[1])
+	if !ok {
+		return nil, fmt.Errorf("no book '%s' in collection '%s'", parts[1], parts[0])
+	}
+	chapter, err := strconv.Atoi(parts[2])
+	if err != nil || chapter < 1 {
+		return nil, fmt.Errorf("invalid chapter number: %s", parts[2])
+	}
+
+	var text string
+	if len(parts) == 3 {
+		verses := s.getChapter(book, chapter)
+		if len(verses) == 0 {
+			return nil, fmt.Errorf("chapter not found: %s %d", book, chapter)
+		}
+		text = fmt.Sprintf("%s Chapter %d\n\n", book, chapter)
+		for _, verse := range verses {
+			text += fmt.Sprintf("%d. %s\n\n", verse.Verse, verse.Text)
+		}
+	} else {
+		ref, err := s.parseReference(fmt.Sprintf("%s %d:%s", book, chapter, parts[3]))
+		if err != nil {
+			return nil, err
+		}
+		verses := s.getScripturesByReference(ref)
+		if len(verses) == 0 {
+			return nil, fmt.Errorf("verses not found: %s %d:%s", book, chapter, parts[3])
+		}
+		for _, verse := range verses {
+			text += fmt.Sprintf("%s - %s\n\n", verse.Reference, verse.Text)
+		}
+	}
+
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{URI: uri, MIMEType: "text/plain", Text: strings.TrimRight(text, "\n")},
+	}, nil
+}
+
+// resourceURI returns the URI of a loaded chapter, or false if its book
+// belongs to no known collection
+func (s *Service) resourceURI(book string, chapter int) (string, bool) {
+	collection := s.collectionSlug(s.bookCollections[book])
+	if collection == "" {
+		return "", false
+	}
+	return fmt.Sprintf("%s%s/%s/%d", resourceScheme, collection, slugify(book), chapter), true
+}
+
+// collectionSlug returns a collection's data file name without extension,
+// such as "book-of-mormon", or "" for an unknown collection
+func (s *Service) collectionSlug(name string) string {
+	for _, work := range s.collections() {
+		if work.Name == name {
+			return strings.TrimSuffix(work.File, ".json")
+		}
+	}
+	return ""
+}
+
+// bookForSlugs finds the loaded book named by a collection and book slug
+func (s *Service) bookForSlugs(collection, book string) (string, bool) {
+	for _, name := range s.orderedBooks() {
+		if slugify(name) == book && s.collectionSlug(s.bookCollections[name]) == collection {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// slugify lowercases a name and joins its words with hyphens, dropping
+// apostrophes: "Solomon's Song" becomes "solomons-song" and
+// "Joseph Smith—History" becomes "joseph-smith-history"
+func slugify(name string) string {
+	var b strings.Builder
+	pending := false // A separator is due before the next letter or digit
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if pending && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			pending = false
+			b.WriteRune(r)
+		case r == '\'' || r == '’':
+		default:
+			pending = true
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,111 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"1 Nephi", "1-nephi"},
+		{"Doctrine and Covenants", "doctrine-and-covenants"},
+		{"Solomon's Song", "solomons-song"},
+		{"Joseph Smith—History", "joseph-smith-history"},
+		{"  Words of Mormon ", "words-of-mormon"},
+	}
+
+	for _, tt := range tests {
+		if got := slugify(tt.name); got != tt.expected {
+			t.Errorf("slugify(%q) = %q, expected %q", tt.name, got, tt.expected)
+		}
+	}
+}
+
+func TestService_Resources(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	service.recordBook("1 Nephi", "Book of Mormon")
+	service.recordBook("Solomon's Song", "Old Testament")
+	service.scriptures["1 Nephi"] = []Scripture{
+		{Book: "1 Nephi", Chapter: 3, Verse: 7, Text: "I will go and do", Reference: "1 Nephi 3:7"},
+		{Book: "1 Nephi", Chapter: 3, Verse: 8, Text: "my father was exceedingly glad", Reference: "1 Nephi 3:8"},
+		{Book: "1 Nephi", Chapter: 4, Verse: 1, Text: "let us go up again", Reference: "1 Nephi 4:1"},
+	}
+	service.scriptures["Solomon's Song"] = []Scripture{
+		{Book: "Solomon's Song", Chapter: 2, Verse: 1, Text: "I am the rose of Sharon", Reference: "Solomon's Song 2:1"},
+	}
+
+	var uris []string
+	for _, resource := range service.ChapterResources() {
+		uris = append(uris, resource.Resource.URI)
+	}
+	expectedURIs := "scripture://old-testament/solomons-song/2, scripture://book-of-mormon/1-nephi/3, scripture://book-of-mormon/1-nephi/4"
+	if strings.Join(uris, ", ") != expectedURIs {
+		t.Errorf("Expected chapter resources %s, got %v", expectedURIs, uris)
+	}
+
+	tests := []struct {
+		name        string
+		uri         string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "Chapter",
+			uri:      "scripture://book-of-mormon/1-nephi/3",
+			expected: "1 Nephi Chapter 3\n\n7. I will go and do\n\n8. my father was exceedingly glad",
+		},
+		{
+			name:     "Verse",
+			uri:      "scripture://book-of-mormon/1-nephi/3/8",
+			expected: "1 Nephi 3:8 - my father was exceedingly glad",
+		},
+		{
+			name:     "Verse range",
+			uri:      "scripture://book-of-mormon/1-nephi/3/7-8",
+			expected: "1 Nephi 3:7 - I will go and do\n\n1 Nephi 3:8 - my father was exceedingly glad",
+		},
+		{
+			name:     "Book with an apostrophe",
+			uri:      "scripture://old-testament/solomons-song/2/1",
+			expected: "Solomon's Song 2:1 - I am the rose of Sharon",
+		},
+		{name: "Wrong collection", uri: "scripture://old-testament/1-nephi/3", expectError: true},
+		{name: "Unknown book", uri: "scripture://book-of-mormon/hezekiah/1", expectError: true},
+		{name: "Missing chapter", uri: "scripture://book-of-mormon/1-nephi/9", expectError: true},
+		{name: "Invalid chapter", uri: "scripture://book-of-mormon/1-nephi/three", expectError: true},
+		{name: "Missing verse", uri: "scripture://book-of-mormon/1-nephi/3/99", expectError: true},
+		{name: "Too short", uri: "scripture://book-of-mormon/1-nephi", expectError: true},
+		{name: "Other scheme", uri: "file:///etc/passwd", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.ReadResourceRequest{Params: mcp.ReadResourceParams{URI: tt.uri}}
+			contents, err := service.ReadResource(context.Background(), request)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected an error, got %v", contents)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			text := contents[0].(mcp.TextResourceContents)
+			if text.URI != tt.uri || text.MIMEType != "text/plain" {
+				t.Errorf("Unexpected URI %q or MIME type %q", text.URI, text.MIMEType)
+			}
+			if text.Text != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, text.Text)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// SearchSyntax describes one form of query the search parser understands.
+// Every entry's example is run against the corpus in tests, through the same
+// query repair as a real call, so the help cannot claim syntax the server
+// does not support.
+type SearchSyntax struct {
+	Name        string `json:"name"`
+	Syntax      string `json:"syntax"`
+	Description string `json:"description"`
+	Example     string `json:"example"`
+	Finds       string `json:"finds"` // A reference the example is guaranteed to return
+}
+
+// searchSyntax is the query syntax accepted by search_scriptures and search_all
+var searchSyntax = []SearchSyntax{
+	{
+		Name:        "word",
+		Syntax:      "word",
+		Description: "Matches verses containing the word, ignoring case. Partial words match too, so 'faith' also finds 'faithful'.",
+		Example:     "charity",
+		Finds:       "Moroni 7:47",
+	},
+	{
+		Name:        "phrase",
+		Syntax:      "several words",
+		Description: "A multi-word query matches that exact sequence of words, including spaces and punctuation.",
+		Example:     "still small voice",
+		Finds:       "1 Kings 19:12",
+	},
+	{
+		Name:        "book name",
+		Syntax:      "book",
+		Description: "A query contained in a book's name matches every verse of that book.",
+		Example:     "Jarom",
+		Finds:       "Jarom 1:1",
+	},
+	{
+		Name:        "AND",
+		Syntax:      "term AND term",
+		Description: "Matches verses containing both terms anywhere. A term is a word, several words or a \"quoted phrase\"; operators must be uppercase.",
+		Example:     "faith AND works",
+		Finds:       "Alma 7:24",
+	},
+	{
+		Name:        "OR",
+		Syntax:      "term OR term",
+		Description: "Matches verses containing either term.",
+		Example:     "charity OR \"pure love\"",
+		Finds:       "Moroni 7:47",
+	},
+	{
+		Name:        "quoted phrases",
+		Syntax:      "\"phrase\" OR \"phrase\"",
+		Description: "Quotes keep a phrase together as one term of a query with operators. A query that is just one quoted phrase is searched without its quotes.",
+		Example:     "\"still small voice\" OR \"pure love\"",
+		Finds:       "1 Kings 19:12",
+	},
+	{
+		Name:        "NOT",
+		Syntax:      "NOT term",
+		Description: "Matches verses that do not contain the term, usually after AND.",
+		Example:     "faith AND NOT works",
+		Finds:       "Hebrews 11:1",
+	},
+	{
+		Name:        "grouping",
+		Syntax:      "(term OR term) AND term",
+		Description: "Parentheses group terms. Without them NOT binds tightest, then AND, then OR.",
+		Example:     "(faith OR hope) AND charity",
+		Finds:       "1 Corinthians 13:13",
+	},
+}
+
+// SearchHelp returns the supported query syntax
+func (s *Service) SearchHelp(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	response := "Search Query Syntax:\n\n"
+	for _, syntax := range searchSyntax {
+		response += fmt.Sprintf("%s: %s\n", syntax.Name, syntax.Syntax)
+		response += fmt.Sprintf("  %s\n", syntax.Description)
+		response += fmt.Sprintf("  Example: %s (finds %s)\n\n", syntax.Example, syntax.Finds)
+	}
+	response += "Any other query is matched literally. Use limit and offset to page through results."
+
+	result := mcp.NewToolResultStructured(SearchHelpResult{Syntax: searchSyntax}, response)
+	result.Meta = &mcp.Meta{AdditionalFields: map[string]any{"syntax": searchSyntax}}
+	return result, nil
+}
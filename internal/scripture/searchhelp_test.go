@@ -0,0 +1,57 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestSearchSyntax_ExamplesMatchCorpus(t *testing.T) {
+	service := NewService()
+	if len(service.scriptures) == 0 {
+		t.Skip("no scripture data loaded")
+	}
+
+	// Run each example the way the server does, with the query repaired first
+	search := RepairQuery(service.SearchScriptures)
+	for _, syntax := range searchSyntax {
+		t.Run(syntax.Name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]any{"query": syntax.Example, "limit": float64(10000)}
+			result, err := search(context.Background(), request)
+			if err != nil || result.IsError {
+				t.Fatalf("Example %q failed: %v %+v", syntax.Example, err, result)
+			}
+			for _, hit := range result.StructuredContent.(SearchResult).Verses {
+				if fmt.Sprintf("%s %d:%d", hit.Book, hit.Chapter, hit.Verse) == syntax.Finds {
+					return
+				}
+			}
+			t.Errorf("Example %q does not find %s", syntax.Example, syntax.Finds)
+		})
+	}
+}
+
+func TestService_SearchHelp(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+
+	result, err := service.SearchHelp(context.Background(), mcp.CallToolRequest{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	text := result.Content[0].(mcp.TextContent).Text
+	for _, syntax := range searchSyntax {
+		if !strings.Contains(text, syntax.Name+": "+syntax.Syntax) {
+			t.Errorf("Expected help to describe %q, got:\n%s", syntax.Name, text)
+		}
+	}
+	if _, ok := result.Meta.AdditionalFields["syntax"]; !ok {
+		t.Error("Expected syntax table under _meta")
+	}
+}
@@ -0,0 +1,18 @@
+package scripture
+
+import "context"
+
+// Search modes for search_scriptures
+const (
+	modePhrase   = "phrase"    // The query appears verbatim
+	modeAllWords = "all_words" // Every word of the query appears somewhere in the verse
+	modeAnyWord  = "any_word"  // At least one word of the query appears
+)
+
+// modeMatches returns every verse matching a query under a search mode, in
+// canonical book order. In the word modes each word is matched like a
+// one-word query, as a case-insensitive substring of the verse text or book
+// name; a query of a single word matches the same verses in every mode.
+func (s *Service) modeMatches(ctx context.Context, query, mode string) []Scripture {
+	return s.run(ctx, s.planSearch(query, mode))
+}
@@ -0,0 +1,100 @@
+package scripture
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestService_SearchScriptures_Mode(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	service.recordBook("1 Kings", "Old Testament")
+	service.recordBook("Helaman", "Book of Mormon")
+	service.scriptures["1 Kings"] = []Scripture{
+		{Book: "1 Kings", Chapter: 19, Verse: 11, Text: "and a great and strong wind rent the mountains", Reference: "1 Kings 19:11"},
+		{Book: "1 Kings", Chapter: 19, Verse: 12, Text: "and after the fire a still small voice.", Reference: "1 Kings 19:12"},
+	}
+	service.scriptures["Helaman"] = []Scripture{
+		{Book: "Helaman", Chapter: 5, Verse: 30, Text: "it was not a voice of thunder, neither was it a voice of a great tumultuous noise, but behold, it was a still voice of perfect mildness, as if it had been a whisper, and it did pierce even to the very soul; small though it was", Reference: "Helaman 5:30"},
+	}
+
+	tests := []struct {
+		name        string
+		arguments   map[string]interface{}
+		expectError bool
+		expected    []string // References in result order
+	}{
+		{
+			name:      "Phrase by default",
+			arguments: map[string]interface{}{"query": "still small voice"},
+			expected:  []string{"1 Kings 19:12"},
+		},
+		{
+			name:      "All words anywhere in the verse",
+			arguments: map[string]interface{}{"query": "still small voice", "mode": "all_words"},
+			expected:  []string{"1 Kings 19:12", "Helaman 5:30"},
+		},
+		{
+			name:      "Any word",
+			arguments: map[string]interface{}{"query": "still strong", "mode": "any_word"},
+			expected:  []string{"1 Kings 19:11", "1 Kings 19:12", "Helaman 5:30"},
+		},
+		{
+			name:      "Mode is case-insensitive",
+			arguments: map[string]interface{}{"query": "small voice", "mode": "ALL_WORDS"},
+			expected:  []string{"1 Kings 19:12", "Helaman 5:30"},
+		},
+		{
+			name:        "Unknown mode",
+			arguments:   map[string]interface{}{"query": "voice", "mode": "fuzzy"},
+			expectError: true,
+		},
+		{
+			name:        "Word mode with boolean operators",
+			arguments:   map[string]interface{}{"query": "still AND voice", "mode": "any_word"},
+			expectError: true,
+		},
+		{
+			name:        "Word mode with relevance",
+			arguments:   map[string]interface{}{"query": "still voice", "mode": "all_words", "sort": "relevance"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+			result, err := service.SearchScriptures(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.IsError != tt.expectError {
+				t.Fatalf("Expected IsError = %v, got %v: %v", tt.expectError, result.IsError, result.Content)
+			}
+			if tt.expectError {
+				return
+			}
+
+			text := result.Content[0].(mcp.TextContent).Text
+			var references []string
+			for _, line := range strings.Split(text, "\n") {
+				if _, rest, ok := strings.Cut(line, ". "); ok && strings.Contains(rest, " - ") {
+					reference, _, _ := strings.Cut(rest, " - ")
+					references = append(references, reference)
+				}
+			}
+			if !reflect.DeepEqual(references, tt.expected) {
+				t.Errorf("Expected %v, got %v in:\n%s", tt.expected, references, text)
+			}
+		})
+	}
+}
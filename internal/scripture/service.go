@@ -4,26 +4,32 @@ import (
 	"archive/zip"
 	"bytes"
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 )
 
 // Scripture represents a scripture verse
 type Scripture struct {
-	Book      string `json:"book"`
-	Chapter   int    `json:"chapter"`
-	Verse     int    `json:"verse"`
-	Text      string `json:"text"`
-	Reference string `json:"reference"`
+	Book       string      `json:"book"`
+	Chapter    int         `json:"chapter"`
+	Verse      int         `json:"verse"`
+	Text       string      `json:"text"`
+	Reference  string      `json:"reference"`
+	Pilcrow    bool        `json:"pilcrow,omitempty"`    // Verse starts a new paragraph
+	Provenance *Provenance `json:"provenance,omitempty"` // Edition the text comes from; nil for verses not loaded from a data file
 }
 
 // ScriptureReference represents a parsed scripture reference
@@ -36,31 +42,132 @@ type ScriptureReference struct {
 
 // Service handles scripture operations
 type Service struct {
-	scriptures map[string][]Scripture // Map of book name to scriptures
+	scriptures       map[string][]Scripture           // Map of book name to scriptures
+	bookOrder        []string                         // Book names in the order they were loaded
+	bookCollections  map[string]string                // Map of book name to collection name
+	progress         LoadProgress                     // Optional callback as each book is loaded
+	integrity        *IntegrityStatus                 // Checksum verification of the loaded data files
+	optional         map[string]bool                  // Data files of the enabled optional collections
+	works            map[string]bool                  // Data files of the standard works to load, or nil for all
+	stories          []ScriptureStory                 // Optional children's scripture stories dataset
+	footnotes        map[verseKey][]Footnote          // Optional footnotes and cross-references dataset
+	dictionary       []DictionaryEntry                // Optional Bible Dictionary dataset
+	schedule         []StudyWeek                      // Optional study schedule dataset
+	questions        map[chapterKey][]ChapterQuestion // Optional question bank of discussion questions per chapter
+	licenses         map[string]*DatasetLicense       // Map of collection name to source and licensing
+	provenance       []*Provenance                    // Provenance of the verses of each loaded data file
+	index            *searchIndex                     // Inverted word index, built once loading finishes
+	budget           time.Duration                    // Time limit for one search call; 0 for none
+	requireCitations bool                             // Attach citations to returned passages and refuse unattributed ones
+	bookTitles       map[string]bookTitle             // Full titles and headings of the books that have them
+	chapterHeadings  map[chapterKey]string            // Chapter and section headings, when the data has them
+	styleOnce        sync.Once                        // Guards styleStats, computed on first use
+	styleStats       map[string]wordStats             // Function word rates across books, for compare_style
+	language         string                           // Language code of the data; empty for the default language
+	editions         map[string]*Service              // Optional language editions by language code
+	translation      string                           // Bible translation of the data; empty for the default translation
+	translations     map[string]*Service              // Optional Bible translations by name
+
+	mu sync.RWMutex // Held to read the data during a call and to replace it on reload
 }
 
+// LoadProgress is called as each book (or Doctrine and Covenants section) finishes loading
+type LoadProgress func(collection, book string, verses int)
+
 // NewService creates a new scripture service
 func NewService() *Service {
+	return NewServiceWithProgress(nil)
+}
+
+// NewServiceWithProgress creates a new scripture service, reporting load progress per book
+func NewServiceWithProgress(progress LoadProgress) *Service {
 	service := &Service{
-		scriptures: make(map[string][]Scripture),
+		scriptures:      make(map[string][]Scripture),
+		bookCollections: make(map[string]string),
+		progress:        progress,
 	}
+	service.configure()
 	service.loadScriptures()
+	service.finishLoading()
+	service.loadStories()
+	service.loadFootnotes()
+	service.loadDictionary()
+	service.loadSchedule()
+	service.loadQuestions()
+	service.loadEditions()
+	service.loadTranslations()
 	return service
 }
 
+// configure applies the settings read from the environment
+func (s *Service) configure() {
+	s.optional = parseOptionalCollections(os.Getenv("SCRIPTURES_OPTIONAL_COLLECTIONS"))
+	s.works = nil
+	if list := strings.TrimSpace(os.Getenv("SCRIPTURES_COLLECTIONS")); list != "" {
+		works, err := slimDataFiles(strings.Split(list, ","))
+		if err != nil {
+			slog.Warn("Ignoring invalid SCRIPTURES_COLLECTIONS; loading every standard work", "error", err)
+		}
+		s.works = works
+	}
+	budget, err := parseSearchBudget(os.Getenv("SCRIPTURES_SEARCH_BUDGET"))
+	if err != nil {
+		slog.Warn("Using the default search budget", "error", err, "budget", defaultSearchBudget)
+		budget = defaultSearchBudget
+	}
+	s.budget = budget
+	if value := strings.TrimSpace(os.Getenv("SCRIPTURES_REQUIRE_CITATIONS")); value != "" {
+		required, err := strconv.ParseBool(value)
+		if err != nil {
+			slog.Warn("Ignoring invalid SCRIPTURES_REQUIRE_CITATIONS; use true or false", "value", value)
+		}
+		s.requireCitations = required
+	}
+}
+
+// NewServiceFromArchive creates a scripture service from a scripture archive
+// already in memory, such as one a browser fetched, without reading the
+// filesystem. This is the data path for WebAssembly hosts. Environment
+// settings such as SCRIPTURES_SEARCH_BUDGET still apply where the host has
+// an environment.
+func NewServiceFromArchive(archive []byte) (*Service, error) {
+	service := &Service{
+		scriptures:      make(map[string][]Scripture),
+		bookCollections: make(map[string]string),
+	}
+	service.configure()
+	if err := service.loadFromZipBytes(archive, "in-memory archive"); err != nil {
+		return nil, fmt.Errorf("load scripture archive: %w", err)
+	}
+	if len(service.scriptures) == 0 {
+		return nil, fmt.Errorf("load scripture archive: no scripture data found")
+	}
+	service.finishLoading()
+	return service, nil
+}
+
 // loadScriptures loads scripture data from JSON files
 func (s *Service) loadScriptures() {
 	// Priority order:
 	// 1. SCRIPTURES_DATA_DIR override (external directory)
-	// 2. Embedded data (data/*.json in this package)
-	// 3. Executable-relative ./data (backward compatibility)
+	// 2. The data folder of the per-user state directory, when it has data
+	// 3. Embedded data (data/*.json in this package)
+	// 4. Executable-relative ./data (backward compatibility)
 
 	if override := os.Getenv("SCRIPTURES_DATA_DIR"); override != "" {
 		s.loadFromDir(override)
 		if len(s.scriptures) > 0 {
 			return
 		}
-		fmt.Printf("Warning: no scripture data loaded from override dir '%s'; falling back to embedded/exe data\n", override)
+		slog.Warn("No scripture data loaded from override dir; falling back to embedded/exe data", "dir", override)
+	}
+
+	if dir, ok := stateDataDir(); ok && s.hasScriptureData(dir) {
+		s.loadFromDir(dir)
+		if len(s.scriptures) > 0 {
+			return
+		}
+		slog.Warn("No scripture data loaded from state dir; falling back to embedded/exe data", "dir", dir)
 	}
 
 	// Attempt embedded data
@@ -70,9 +177,8 @@ func (s *Service) loadScriptures() {
 	}
 
 	// Fallback: executable-relative data directory (legacy layout)
-	if exePath, err := os.Executable(); err == nil && exePath != "" {
-		baseDir := filepath.Dir(exePath)
-		s.loadFromDir(filepath.Join(baseDir, "data"))
+	if dir, ok := executableDataDir(); ok {
+		s.loadFromDir(dir)
 	}
 }
 
@@ -82,22 +188,24 @@ func (s *Service) loadFromEmbedded() {
 		return
 	}
 	// Prefer compressed archive
-	if zipBytes, err := embeddedData.ReadFile("data/scriptures.zip"); err == nil {
+	if zipBytes, err := embeddedData.ReadFile(embeddedArchive); err == nil {
 		if err := s.loadFromZipBytes(zipBytes, "embedded zip"); err != nil {
-			fmt.Printf("Warning: failed to load embedded zip: %v (falling back to discrete files)\n", err)
+			slog.Warn("Failed to load embedded zip; falling back to discrete files", "error", err)
 		} else {
 			return
 		}
 	}
 	// Fallback: discrete JSON files (development fallback if embed pattern changed)
-	files := scriptureJSONFilenames()
+	files := s.dataFilenames()
 	for _, f := range files {
-		data, err := embeddedData.ReadFile("data/" + f)
+		file, err := embeddedData.Open("data/" + f)
 		if err != nil {
-			fmt.Printf("Warning: embedded read failed %s: %v\n", f, err)
+			slog.Warn("Embedded read failed", "file", f, "error", err)
 			continue
 		}
-		s.parseAndStore(data, f)
+		s.recordLicense(f, nil)
+		s.parseAndStore(file, f)
+		file.Close()
 	}
 }
 
@@ -109,71 +217,384 @@ func (s *Service) loadFromDir(dir string) {
 		if err := s.loadFromZipBytes(data, zipPath); err == nil {
 			return
 		} else {
-			fmt.Printf("Warning: could not load %s: %v (falling back to discrete files)\n", zipPath, err)
+			slog.Warn("Could not load archive; falling back to discrete files", "path", zipPath, "error", err)
+		}
+	}
+	var manifest *Manifest
+	if data, err := os.ReadFile(filepath.Join(dir, manifestName)); err == nil {
+		if manifest, err = parseManifest(data); err != nil {
+			slog.Warn("Could not parse manifest", "dir", dir, "error", err)
 		}
 	}
-	files := scriptureJSONFilenames()
+	observed := make(map[string]string)
+	files := s.dataFilenames()
 	for _, f := range files {
 		path := filepath.Join(dir, f)
-		data, err := os.ReadFile(path)
+		file, err := os.Open(path)
 		if err != nil {
-			fmt.Printf("Warning: Could not read %s: %v\n", path, err)
+			slog.Warn("Could not read data file", "path", path, "error", err)
 			continue
 		}
-		s.parseAndStore(data, f)
+		observed[f] = s.loadDataFile(file, f, manifest)
+		file.Close()
+	}
+	if len(observed) > 0 {
+		status := verifyChecksums(dir, manifest, observed)
+		var skipped []string
+		for _, name := range s.disabledFilenames() {
+			if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+				skipped = append(skipped, name)
+			}
+		}
+		status.skip(skipped)
+		s.recordIntegrity(status)
 	}
 }
 
-// loadFromZipBytes loads scriptures from an in-memory zip archive.
+// loadFromZipBytes loads scriptures from an in-memory zip archive, verifying
+// each data file against the archive's checksum manifest when it has one.
+// Each collection is its own entry, found through the archive's directory,
+// so the entries of disabled collections are never decompressed; they are
+// reported as skipped rather than verified.
 func (s *Service) loadFromZipBytes(data []byte, label string) error {
 	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
 	if err != nil {
 		return err
 	}
+
+	var manifest *Manifest
+	if f, err := r.Open(manifestName); err == nil {
+		manifestBytes, err := io.ReadAll(f)
+		f.Close()
+		if err == nil {
+			manifest, err = parseManifest(manifestBytes)
+		}
+		if err != nil {
+			slog.Warn("Could not read manifest", "file", manifestName, "source", label, "error", err)
+		}
+	}
+
+	observed := make(map[string]string)
+	var skipped []string
 	for _, f := range r.File {
 		if f.FileInfo().IsDir() {
 			continue
 		}
 		name := f.Name
-		if !strings.HasSuffix(name, ".json") { // skip non-json
+		if !strings.HasSuffix(name, ".json") || name == manifestName { // skip non-data files
 			continue
 		}
-		rc, err := f.Open()
-		if err != nil {
-			fmt.Printf("Warning: could not open %s in %s: %v\n", name, label, err)
+		if !s.fileEnabled(name) {
+			skipped = append(skipped, name)
 			continue
 		}
-		fileBytes, err := io.ReadAll(rc)
-		rc.Close()
+		rc, err := f.Open()
 		if err != nil {
-			fmt.Printf("Warning: could not read %s in %s: %v\n", name, label, err)
+			slog.Warn("Could not open data file", "file", name, "source", label, "error", err)
 			continue
 		}
-		s.parseAndStore(fileBytes, name)
+		observed[name] = s.loadDataFile(rc, name, manifest)
+		rc.Close()
 	}
+	status := verifyChecksums(label, manifest, observed)
+	status.skip(skipped)
+	s.recordIntegrity(status)
 	return nil
 }
 
-// parseAndStore parses raw JSON scripture data and stores verses in memory.
-func (s *Service) parseAndStore(data []byte, label string) {
-	var scriptureData ScriptureData
-	if err := json.Unmarshal(data, &scriptureData); err != nil {
-		fmt.Printf("Warning: Could not parse %s: %v\n", label, err)
+// loadDataFile parses and stores a data file and returns its SHA-256. Files
+// the manifest lists without license metadata are only checksummed, so the
+// manifest check stays complete.
+func (s *Service) loadDataFile(r io.Reader, name string, manifest *Manifest) string {
+	if manifest != nil && !manifest.licensed(name) {
+		_, sum := sha256Reader(r)
+		return sum()
+	}
+	s.recordLicense(name, manifest)
+	return s.parseAndHash(r, name)
+}
+
+// recordLicense remembers the licensing of a data file's collection: the
+// manifest's entry when it has one, otherwise the built-in default
+func (s *Service) recordLicense(name string, manifest *Manifest) {
+	license := defaultLicense(name)
+	if manifest != nil {
+		if entry, listed := manifest.Files[filepath.Base(name)]; listed && entry.License.complete() {
+			license = entry.License
+		}
+	}
+	if license == nil {
+		return
+	}
+	if s.licenses == nil {
+		s.licenses = make(map[string]*DatasetLicense)
+	}
+	s.licenses[collectionForFile(name)] = license
+}
+
+// parseAndHash parses and stores a data file and returns its SHA-256, which
+// also names the version of its verses' provenance
+func (s *Service) parseAndHash(r io.Reader, label string) string {
+	hashed, sum := sha256Reader(r)
+	provenance := s.parseAndStore(hashed, label)
+	checksum := sum()
+	provenance.Version = checksum[:min(versionDigits, len(checksum))]
+	return checksum
+}
+
+// recordIntegrity keeps the checksum verification result and warns about any problems
+func (s *Service) recordIntegrity(status IntegrityStatus) {
+	s.integrity = &status
+	if !status.OK() {
+		slog.Warn("Data integrity check failed", "status", status.String())
+	}
+}
+
+// parseAndStore streams JSON scripture data and stores verses in memory one
+// book or section at a time, returning the provenance the verses share.
+// Books decoded before a parse error are kept.
+func (s *Service) parseAndStore(r io.Reader, label string) *Provenance {
+	collection := collectionForFile(label)
+	provenance := s.newProvenance(label)
+	err := decodeScriptureData(r,
+		func(book Book) {
+			s.storeBook(book, collection, provenance)
+		},
+		func(section Section) {
+			s.storeSection(section, collection, provenance)
+		},
+	)
+	if err != nil {
+		slog.Warn("Could not parse data file", "file", label, "error", err)
+	}
+	return provenance
+}
+
+// storeBook adds the verses of a decoded book
+func (s *Service) storeBook(book Book, collection string, provenance *Provenance) {
+	s.recordBook(book.Book, collection)
+	s.recordHeadings(book)
+	count := 0
+	for _, chapter := range book.Chapters {
+		for _, verse := range chapter.Verses {
+			s.scriptures[book.Book] = append(s.scriptures[book.Book], Scripture{
+				Book:       book.Book,
+				Chapter:    chapter.Chapter,
+				Verse:      verse.Verse,
+				Text:       verse.Text,
+				Reference:  verse.Reference,
+				Pilcrow:    verse.Pilcrow,
+				Provenance: provenance,
+			})
+			count++
+		}
+	}
+	s.reportProgress(collection, book.Book, count)
+}
+
+// storeSection adds the verses of a decoded Doctrine and Covenants section.
+// The Doctrine and Covenants is organized in sections rather than books.
+func (s *Service) storeSection(section Section, collection string, provenance *Provenance) {
+	s.recordBook(doctrineAndCovenants, collection)
+	s.recordChapterHeading(doctrineAndCovenants, section.Section, section.Heading)
+	for _, verse := range section.Verses {
+		s.scriptures[doctrineAndCovenants] = append(s.scriptures[doctrineAndCovenants], Scripture{
+			Book:       doctrineAndCovenants,
+			Chapter:    section.Section,
+			Verse:      verse.Verse,
+			Text:       verse.Text,
+			Reference:  verse.Reference,
+			Pilcrow:    verse.Pilcrow,
+			Provenance: provenance,
+		})
+	}
+	s.reportProgress(collection, fmt.Sprintf("%s %d", doctrineAndCovenants, section.Section), len(section.Verses))
+}
+
+// reportProgress calls the load progress callback, if any
+func (s *Service) reportProgress(collection, book string, verses int) {
+	if s.progress != nil {
+		s.progress(collection, book, verses)
+	}
+}
+
+// doctrineAndCovenants is the book name used for verses loaded from D&C sections.
+const doctrineAndCovenants = "Doctrine and Covenants"
+
+// recordBook remembers the load order and collection of a book the first time it is seen.
+func (s *Service) recordBook(book, collection string) {
+	if s.bookCollections == nil {
+		s.bookCollections = make(map[string]string)
+	}
+	if _, seen := s.bookCollections[book]; seen {
 		return
 	}
-	for _, book := range scriptureData.Books {
-		for _, chapter := range book.Chapters {
-			for _, verse := range chapter.Verses {
-				s.scriptures[book.Book] = append(s.scriptures[book.Book], Scripture{
-					Book:      book.Book,
-					Chapter:   chapter.Chapter,
-					Verse:     verse.Verse,
-					Text:      verse.Text,
-					Reference: verse.Reference,
-				})
+	s.bookCollections[book] = collection
+	s.bookOrder = append(s.bookOrder, book)
+}
+
+// chapterNumbers returns the distinct chapter numbers of a book in ascending order
+func (s *Service) chapterNumbers(book string) []int {
+	var chapters []int
+	seen := make(map[int]bool)
+	for _, scripture := range s.scriptures[book] {
+		if !seen[scripture.Chapter] {
+			seen[scripture.Chapter] = true
+			chapters = append(chapters, scripture.Chapter)
+		}
+	}
+	sort.Ints(chapters)
+	return chapters
+}
+
+// chapterKey identifies a single chapter of a book
+type chapterKey struct {
+	book    string
+	chapter int
+}
+
+// String formats the chapter as a reference like "1 Nephi 3"
+func (k chapterKey) String() string {
+	return fmt.Sprintf("%s %d", k.book, k.chapter)
+}
+
+// chapterSequence returns every loaded chapter in reading order
+func (s *Service) chapterSequence() []chapterKey {
+	var sequence []chapterKey
+	for _, book := range s.orderedBooks() {
+		for _, chapter := range s.chapterNumbers(book) {
+			sequence = append(sequence, chapterKey{book: book, chapter: chapter})
+		}
+	}
+	return sequence
+}
+
+// scriptureCollection is a named collection and the data file it is loaded from
+type scriptureCollection struct {
+	Name    string
+	File    string
+	License *DatasetLicense // Default licensing, used when no manifest supplies it
+	Books   []string        // Canonical book order; nil to keep the data file's
+}
+
+// scripturesJSONLicense covers the standard works as published by bcbooks/scriptures-json
+var scripturesJSONLicense = &DatasetLicense{
+	Source:  "bcbooks/scriptures-json (2013 LDS edition)",
+	License: "As published by bcbooks/scriptures-json; see the source repository for terms",
+	URL:     "https://github.com/bcbooks/scriptures-json",
+}
+
+// standardWorks lists the scripture collections in canonical order along with
+// the data file each one is loaded from.
+var standardWorks = []scriptureCollection{
+	{Name: "Old Testament", File: "old-testament.json", License: scripturesJSONLicense, Books: oldTestamentBooks},
+	{Name: "New Testament", File: "new-testament.json", License: scripturesJSONLicense, Books: newTestamentBooks},
+	{Name: "Book of Mormon", File: "book-of-mormon.json", License: scripturesJSONLicense, Books: bookOfMormonBooks},
+	{Name: "Doctrine and Covenants", File: "doctrine-and-covenants.json", License: scripturesJSONLicense, Books: doctrineAndCovenantsBooks},
+	{Name: "Pearl of Great Price", File: "pearl-of-great-price.json", License: scripturesJSONLicense, Books: pearlOfGreatPriceBooks},
+}
+
+// optionalCollections are off by default and loaded only when named in the
+// SCRIPTURES_OPTIONAL_COLLECTIONS environment variable.
+var optionalCollections = []scriptureCollection{
+	{Name: "Apocrypha", File: "apocrypha.json"},
+}
+
+// defaultLicense returns the built-in licensing of a known data file, or nil.
+// Optional collections have none and must be licensed by their manifest.
+func defaultLicense(name string) *DatasetLicense {
+	base := filepath.Base(name)
+	for _, work := range standardWorks {
+		if work.File == base {
+			return work.License
+		}
+	}
+	return nil
+}
+
+// collectionForFile returns the collection name for a data file, or "" if unknown.
+func collectionForFile(name string) string {
+	base := filepath.Base(name)
+	for _, works := range [][]scriptureCollection{standardWorks, optionalCollections} {
+		for _, work := range works {
+			if work.File == base {
+				return work.Name
 			}
 		}
 	}
+	return ""
+}
+
+// parseOptionalCollections returns the data files of the optional collections
+// named in a comma-separated list, matching collection names or file names
+// without extension, ignoring case.
+func parseOptionalCollections(list string) map[string]bool {
+	enabled := make(map[string]bool)
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		for _, collection := range optionalCollections {
+			if strings.EqualFold(name, collection.Name) || strings.EqualFold(name, strings.TrimSuffix(collection.File, ".json")) {
+				enabled[collection.File] = true
+			}
+		}
+	}
+	return enabled
+}
+
+// fileEnabled reports whether a data file should be loaded. Files of optional
+// collections load only when enabled, standard works unless
+// SCRIPTURES_COLLECTIONS leaves them out, and every other file always loads.
+func (s *Service) fileEnabled(name string) bool {
+	for _, collection := range optionalCollections {
+		if collection.File == filepath.Base(name) {
+			return s.optional[collection.File]
+		}
+	}
+	for _, work := range standardWorks {
+		if work.File == filepath.Base(name) {
+			return s.works == nil || s.works[work.File]
+		}
+	}
+	return true
+}
+
+// disabledFilenames returns the data files of the standard works and
+// optional collections that are not loaded
+func (s *Service) disabledFilenames() []string {
+	var files []string
+	for _, collection := range append(append([]scriptureCollection{}, standardWorks...), optionalCollections...) {
+		if !s.fileEnabled(collection.File) {
+			files = append(files, collection.File)
+		}
+	}
+	return files
+}
+
+// collections returns the standard works followed by the enabled optional collections
+func (s *Service) collections() []scriptureCollection {
+	collections := append([]scriptureCollection(nil), standardWorks...)
+	for _, collection := range optionalCollections {
+		if s.optional[collection.File] {
+			collections = append(collections, collection)
+		}
+	}
+	return collections
+}
+
+// dataFilenames returns the enabled standard data files followed by those of the enabled optional collections
+func (s *Service) dataFilenames() []string {
+	var files []string
+	for _, name := range scriptureJSONFilenames() {
+		if s.fileEnabled(name) {
+			files = append(files, name)
+		}
+	}
+	for _, collection := range optionalCollections {
+		if s.optional[collection.File] {
+			files = append(files, collection.File)
+		}
+	}
+	return files
 }
 
 // scriptureJSONFilenames returns the list of scripture JSON files expected.
@@ -189,53 +610,64 @@ func scriptureJSONFilenames() []string {
 
 // ScriptureData represents the structure of the scripture JSON files
 type ScriptureData struct {
-	Books []struct {
-		Book     string `json:"book"`
-		Chapters []struct {
-			Chapter int `json:"chapter"`
-			Verses  []struct {
-				Verse     int    `json:"verse"`
-				Text      string `json:"text"`
-				Reference string `json:"reference"`
-			} `json:"verses"`
-		} `json:"chapters"`
-	} `json:"books"`
+	Books    []Book    `json:"books"`
+	Sections []Section `json:"sections,omitempty"`
+}
+
+// Book is a book of scripture and its chapters
+type Book struct {
+	Book      string    `json:"book"`
+	FullTitle string    `json:"full_title,omitempty"` // e.g. "The First Book of Nephi"
+	Heading   string    `json:"heading,omitempty"`    // The book's own heading, printed before its first chapter
+	Chapters  []Chapter `json:"chapters"`
+}
+
+// Chapter is a numbered chapter, its optional heading and its verses
+type Chapter struct {
+	Chapter int     `json:"chapter"`
+	Heading string  `json:"heading,omitempty"` // Chapter summary printed before the verses
+	Verses  []Verse `json:"verses"`
+}
+
+// Section is a numbered Doctrine and Covenants section, its optional heading and its verses
+type Section struct {
+	Section int     `json:"section"`
+	Heading string  `json:"heading,omitempty"` // Section summary printed before the verses
+	Verses  []Verse `json:"verses"`
+}
+
+// Verse is a single verse as stored in the scripture JSON files
+type Verse struct {
+	Verse     int    `json:"verse"`
+	Text      string `json:"text"`
+	Reference string `json:"reference"`
+	Pilcrow   bool   `json:"pilcrow,omitempty"` // Verse starts a new paragraph
 }
 
 // loadScriptureFile loads scriptures from a single JSON file
-func (s *Service) loadScriptureFile(filepath string) {
-	data, err := os.ReadFile(filepath)
+func (s *Service) loadScriptureFile(path string) {
+	file, err := os.Open(path)
 	if err != nil {
-		fmt.Printf("Warning: Could not read %s: %v\n", filepath, err)
-		return
-	}
-
-	var scriptureData ScriptureData
-	if err := json.Unmarshal(data, &scriptureData); err != nil {
-		fmt.Printf("Warning: Could not parse %s: %v\n", filepath, err)
+		slog.Warn("Could not read data file", "path", path, "error", err)
 		return
 	}
+	defer file.Close()
 
-	for _, book := range scriptureData.Books {
-		for _, chapter := range book.Chapters {
-			for _, verse := range chapter.Verses {
-				scripture := Scripture{
-					Book:      book.Book,
-					Chapter:   chapter.Chapter,
-					Verse:     verse.Verse,
-					Text:      verse.Text,
-					Reference: verse.Reference,
-				}
-				s.scriptures[book.Book] = append(s.scriptures[book.Book], scripture)
-			}
-		}
-	}
+	s.parseAndStore(file, filepath.Base(path))
 }
 
 // SearchScriptures searches for scriptures by keyword or phrase
 func (s *Service) SearchScriptures(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	arguments := request.GetArguments()
 
+	edition, err := s.edition(arguments)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if edition != s {
+		return edition.SearchScriptures(ctx, request)
+	}
+
 	query, ok := arguments["query"].(string)
 	if !ok || query == "" {
 		return mcp.NewToolResultError("search query cannot be empty"), nil
@@ -248,30 +680,292 @@ func (s *Service) SearchScriptures(ctx context.Context, request mcp.CallToolRequ
 		}
 	}
 
-	// Perform the search
-	results := s.performSearch(query, limit)
+	offset := 0
+	if offsetVal, exists := arguments["offset"]; exists {
+		if offsetFloat, ok := offsetVal.(float64); ok {
+			offset = int(offsetFloat)
+		}
+	}
+	if offset < 0 {
+		return mcp.NewToolResultError("offset cannot be negative"), nil
+	}
+	limit = max(limit, 1)
+
+	order := sortCanonical
+	if sortVal, ok := arguments["sort"].(string); ok && sortVal != "" {
+		order = strings.ToLower(sortVal)
+	}
+	if order != sortCanonical && order != sortRelevance {
+		return mcp.NewToolResultError(fmt.Sprintf("sort must be %s or %s", sortCanonical, sortRelevance)), nil
+	}
+	preferSimple, _ := arguments["prefer_simple"].(bool)
+	if preferSimple && order == sortRelevance {
+		return mcp.NewToolResultError("prefer_simple cannot be combined with sort=relevance"), nil
+	}
+	node, err := parseBooleanQuery(query)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid query: %v", err)), nil
+	}
+	if node != nil && order == sortRelevance {
+		return mcp.NewToolResultError("AND, OR and NOT cannot be combined with sort=relevance"), nil
+	}
+
+	mode := modePhrase
+	if modeVal, ok := arguments["mode"].(string); ok && modeVal != "" {
+		mode = strings.ToLower(modeVal)
+	}
+	if mode != modePhrase && mode != modeAllWords && mode != modeAnyWord {
+		return mcp.NewToolResultError(fmt.Sprintf("mode must be %s, %s or %s", modePhrase, modeAllWords, modeAnyWord)), nil
+	}
+	if mode != modePhrase && node != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("mode=%s cannot be combined with AND, OR and NOT", mode)), nil
+	}
+	if mode != modePhrase && order == sortRelevance {
+		return mcp.NewToolResultError(fmt.Sprintf("mode=%s cannot be combined with sort=relevance, which already ranks verses by the query's words", mode)), nil
+	}
+
+	var highlight *highlighter
+	if enabled, _ := arguments["highlight"].(bool); enabled {
+		open, _ := arguments["highlight_open"].(string)
+		if open == "" {
+			open = defaultHighlightMarker
+		}
+		close, _ := arguments["highlight_close"].(string)
+		if close == "" {
+			close = open
+		}
+		terms, wholeWords := highlightTerms(s.tokenizer(), query, order, mode, node)
+		highlight = newHighlighter(terms, wholeWords, open, close)
+	}
+
+	fingerprint := searchFingerprint(query, order, mode, preferSimple)
+	if cursor, ok := arguments["cursor"].(string); ok && cursor != "" {
+		if _, exists := arguments["offset"]; exists {
+			return mcp.NewToolResultError("offset and cursor cannot be combined"), nil
+		}
+		if offset, err = decodeCursor(cursor, fingerprint); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid cursor: %v", err)), nil
+		}
+	}
 
+	// Perform the search, easiest verses first or most relevant first if
+	// requested, stopping with what has been found when the budget runs out
+	ctx, cancel := s.withBudget(ctx)
+	defer cancel()
+	var results []Scripture
+	var scores map[string]float64
+	var total int
+	switch {
+	case order == sortRelevance:
+		var scored []scoredVerse
+		scored, total = s.relevancePage(ctx, query, offset, limit)
+		scores = make(map[string]float64, len(scored))
+		for _, verse := range scored {
+			results = append(results, verse.Scripture)
+			scores[verse.Reference] = verse.Score
+		}
+	case preferSimple:
+		results, total = s.simplestPage(ctx, query, mode, offset, limit)
+	default:
+		results, total = s.searchPage(ctx, query, mode, offset, limit)
+	}
+	partial := errors.Is(ctx.Err(), context.DeadlineExceeded)
+
+	// With explain, report how the matches were found
+	var plan *SearchPlan
+	if explain, _ := arguments["explain"].(bool); explain {
+		planned := s.planSearch(query, mode)
+		if order == sortRelevance {
+			planned = relevancePlan(query)
+		}
+		plan = &planned
+	}
+
+	structured := SearchResult{Query: query, Mode: mode, Sort: order, Offset: offset, Total: total, Verses: make([]SearchHit, 0, len(results)), Partial: partial}
 	if len(results) == 0 {
-		return mcp.NewToolResultText(fmt.Sprintf("No scriptures found matching '%s'. Try different keywords or check spelling.", query)), nil
+		message := fmt.Sprintf("No scriptures found matching '%s'. Try different keywords or check spelling.", query)
+		if total > 0 {
+			message = fmt.Sprintf("No more results for '%s': offset %d is past the last of %d matches.", query, offset, total)
+		}
+		meta := make(map[string]any)
+		if partial {
+			message += "\n\n" + s.partialNotice(total)
+			meta["partial"] = true
+		}
+		if plan != nil {
+			message += "\n\n" + plan.String()
+			meta["plan"] = plan
+		}
+		result := mcp.NewToolResultStructured(structured, message)
+		if len(meta) > 0 {
+			result.Meta = &mcp.Meta{AdditionalFields: meta}
+		}
+		return result, nil
 	}
 
 	response := fmt.Sprintf("Scripture Search Results for '%s':\n\n", query)
+	if mode != modePhrase {
+		response = fmt.Sprintf("Scripture Search Results for '%s' (%s):\n\n", query, mode)
+	}
 	for i, result := range results {
-		response += fmt.Sprintf("%d. %s %d:%d - %s\n\n", i+1, result.Book, result.Chapter, result.Verse, result.Text)
+		hit := SearchHit{Scripture: result, Score: scores[result.Reference]}
+		text := result.Text
+		if highlight != nil {
+			text = highlight.apply(text)
+			hit.Highlighted = text
+		}
+		structured.Verses = append(structured.Verses, hit)
+		if scores != nil {
+			response += fmt.Sprintf("%d. %s %d:%d (score %.2f) - %s\n\n", offset+i+1, result.Book, result.Chapter, result.Verse, scores[result.Reference], text)
+			continue
+		}
+		response += fmt.Sprintf("%d. %s %d:%d - %s\n\n", offset+i+1, result.Book, result.Chapter, result.Verse, text)
 	}
 
-	return mcp.NewToolResultText(response), nil
+	truncation := newTruncation(offset, len(results), total)
+	if truncation != nil {
+		truncation.NextCursor = encodeCursor(fingerprint, truncation.NextOffset)
+		structured.NextCursor = truncation.NextCursor
+		response += truncation.String() + "\n"
+	}
+	if partial {
+		response += s.partialNotice(total) + "\n"
+	}
+	if plan != nil {
+		response += plan.String() + "\n"
+	}
+
+	meta := map[string]any{"readability": s.verseReadability(results)}
+	if scores != nil {
+		meta["scores"] = scores
+	}
+	if partial {
+		meta["partial"] = true
+	}
+	if plan != nil {
+		meta["plan"] = plan
+	}
+	if truncation != nil {
+		meta["truncated"] = truncation
+	}
+	result := mcp.NewToolResultStructured(structured, response)
+	result.Meta = &mcp.Meta{AdditionalFields: meta}
+	return result, nil
 }
 
-// GetScripture retrieves a specific scripture reference
-func (s *Service) GetScripture(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+// SearchAll searches every loaded collection at once and groups the results by collection
+func (s *Service) SearchAll(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	arguments := request.GetArguments()
 
 	query, ok := arguments["query"].(string)
 	if !ok || query == "" {
-		return mcp.NewToolResultError("scripture reference cannot be empty"), nil
+		return mcp.NewToolResultError("search query cannot be empty"), nil
+	}
+
+	limit := 5 // default per collection
+	if limitVal, exists := arguments["limit"]; exists {
+		if limitFloat, ok := limitVal.(float64); ok {
+			limit = int(limitFloat)
+		}
+	}
+	limit = max(limit, 1)
+
+	if _, err := parseBooleanQuery(query); err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid query: %v", err)), nil
+	}
+
+	ctx, cancel := s.withBudget(ctx)
+	defer cancel()
+
+	response := fmt.Sprintf("Search Results for '%s' across all collections:\n\n", query)
+	structured := SearchAllResult{Query: query, Collections: []CollectionResult{}}
+	total := 0
+	truncated := make(map[string]int) // Collection name to number of results not shown
+	shown, counts := s.splitByCollection(s.modeMatches(ctx, query, modePhrase), limit)
+	for _, work := range s.collections() {
+		if !s.hasCollection(work.Name) {
+			continue
+		}
+		results, matches := shown[work.Name], counts[work.Name]
+		total += len(results)
+		structured.Collections = append(structured.Collections, CollectionResult{
+			Collection: work.Name,
+			Verses:     append([]Scripture{}, results...),
+			More:       matches - len(results),
+		})
+
+		response += fmt.Sprintf("== %s (%d) ==\n", work.Name, len(results))
+		if len(results) == 0 {
+			response += "No matches.\n\n"
+			continue
+		}
+		for i, result := range results {
+			response += fmt.Sprintf("%d. %s %d:%d - %s\n", i+1, result.Book, result.Chapter, result.Verse, result.Text)
+		}
+		if more := matches - len(results); more > 0 {
+			response += fmt.Sprintf("%d more %s; call search_scriptures to page through every match.\n", more, pluralize(more, "result", "results"))
+			truncated[work.Name] = more
+		}
+		response += "\n"
+	}
+	partial := errors.Is(ctx.Err(), context.DeadlineExceeded)
+	structured.Partial = partial
+
+	if total == 0 {
+		message := fmt.Sprintf("No scriptures found matching '%s'. Try different keywords or check spelling.", query)
+		if !partial {
+			return mcp.NewToolResultStructured(structured, message), nil
+		}
+		result := mcp.NewToolResultStructured(structured, message+"\n\n"+s.partialNotice(0))
+		result.Meta = &mcp.Meta{AdditionalFields: map[string]any{"partial": true}}
+		return result, nil
 	}
 
+	meta := make(map[string]any)
+	if len(truncated) > 0 {
+		meta["truncated"] = truncated
+	}
+	if partial {
+		response += s.partialNotice(total) + "\n"
+		meta["partial"] = true
+	}
+	result := mcp.NewToolResultStructured(structured, response)
+	if len(meta) > 0 {
+		result.Meta = &mcp.Meta{AdditionalFields: meta}
+	}
+	return result, nil
+}
+
+// GetScripture retrieves a specific scripture reference
+func (s *Service) GetScripture(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	edition, err := s.edition(arguments)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if edition != s {
+		return edition.GetScripture(ctx, request)
+	}
+
+	references, err := scriptureReferences(arguments)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	format, err := parseFormat(arguments)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	contextVerses, err := parseContextVerses(arguments)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(references) > 1 {
+		return s.getPassages(references, format, contextVerses), nil
+	}
+	query := references[0]
+
 	// Parse the reference
 	ref, err := s.parseReference(query)
 	if err != nil {
@@ -280,28 +974,49 @@ func (s *Service) GetScripture(ctx context.Context, request mcp.CallToolRequest)
 
 	// Get the scripture(s)
 	scriptures := s.getScripturesByReference(ref)
+	structured := PassageResult{Reference: query, Verses: append([]Scripture{}, scriptures...)}
 
 	if len(scriptures) == 0 {
-		return mcp.NewToolResultText(fmt.Sprintf("Scripture reference '%s' not found.", query)), nil
+		return mcp.NewToolResultStructured(structured, fmt.Sprintf("Scripture reference '%s' not found.", query)), nil
 	}
 
-	response := fmt.Sprintf("Scripture Reference: %s\n\n", query)
-	for _, scripture := range scriptures {
-		response += fmt.Sprintf("%s %d:%d - %s\n\n", scripture.Book, scripture.Chapter, scripture.Verse, scripture.Text)
+	structured.Before, structured.After = s.surroundingVerses(scriptures, contextVerses)
+	response := passageText(query, structured.Before, scriptures, structured.After, format)
+	if format == formatSpeech || format == formatAccessible {
+		return mcp.NewToolResultStructured(structured, response), nil
 	}
 
-	return mcp.NewToolResultText(response), nil
+	meta := map[string]any{"readability": s.verseReadability(scriptures)}
+	if lines := poeticLineMetadata(scriptures); len(lines) > 0 {
+		meta["poeticLines"] = lines
+	}
+	result := mcp.NewToolResultStructured(structured, response)
+	result.Meta = &mcp.Meta{AdditionalFields: meta}
+	return result, nil
 }
 
 // GetChapter retrieves a full chapter from scriptures
 func (s *Service) GetChapter(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	arguments := request.GetArguments()
 
+	edition, err := s.edition(arguments)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if edition != s {
+		return edition.GetChapter(ctx, request)
+	}
+
 	query, ok := arguments["query"].(string)
 	if !ok || query == "" {
 		return mcp.NewToolResultError("chapter reference cannot be empty"), nil
 	}
 
+	format, err := parseFormat(arguments)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
 	// Parse the reference (should be book chapter format)
 	ref, err := s.parseChapterReference(query)
 	if err != nil {
@@ -310,51 +1025,196 @@ func (s *Service) GetChapter(ctx context.Context, request mcp.CallToolRequest) (
 
 	// Get the entire chapter
 	scriptures := s.getChapter(ref.Book, ref.Chapter)
+	structured := ChapterResult{Book: ref.Book, Chapter: ref.Chapter, Verses: append([]Scripture{}, scriptures...)}
 
 	if len(scriptures) == 0 {
-		return mcp.NewToolResultText(fmt.Sprintf("Chapter '%s' not found.", query)), nil
+		return mcp.NewToolResultStructured(structured, fmt.Sprintf("Chapter '%s' not found.", query)), nil
+	}
+
+	nav := s.chapterNavigation(ref.Book, ref.Chapter)
+	structured.Navigation = &nav
+	summary := s.chapterSummary(ref.Book, ref.Chapter)
+	structured.BookTitle, structured.BookHeading, structured.Heading = summary.BookTitle, summary.BookHeading, summary.Heading
+	switch format {
+	case formatSpeech:
+		return mcp.NewToolResultStructured(structured, speechPassage(spokenChapter(ref.Book, ref.Chapter), scriptures)), nil
+	case formatAccessible:
+		return mcp.NewToolResultStructured(structured, accessiblePassage(fmt.Sprintf("%s chapter %d", ref.Book, ref.Chapter), scriptures, &nav)), nil
 	}
 
 	response := fmt.Sprintf("%s Chapter %d\n\n", ref.Book, ref.Chapter)
+	if headings := summary.String(); headings != "" {
+		response += headings + "\n\n"
+	}
 	for _, scripture := range scriptures {
-		response += fmt.Sprintf("%d. %s\n\n", scripture.Verse, scripture.Text)
+		response += fmt.Sprintf("%d. %s\n\n", scripture.Verse, formatVerseText(scripture, format, "   "))
+	}
+
+	response += nav.String()
+
+	meta := map[string]any{"navigation": nav, "readability": s.chapterReadability(scriptures)}
+	if lines := poeticLineMetadata(scriptures); len(lines) > 0 {
+		meta["poeticLines"] = lines
+	}
+	result := mcp.NewToolResultStructured(structured, response)
+	result.Meta = &mcp.Meta{AdditionalFields: meta}
+	return result, nil
+}
+
+// ChapterNavigation describes where a chapter sits in reading order
+type ChapterNavigation struct {
+	Previous     string `json:"previous,omitempty"`
+	Next         string `json:"next,omitempty"`
+	Collection   string `json:"collection,omitempty"`
+	BookPosition int    `json:"bookPosition"`
+	BookCount    int    `json:"bookCount"`
+	ChapterCount int    `json:"chapterCount"`
+}
+
+// String renders the navigation as a short footer for text output
+func (n ChapterNavigation) String() string {
+	var links []string
+	if n.Previous != "" {
+		links = append(links, "Previous: "+n.Previous)
+	}
+	if n.Next != "" {
+		links = append(links, "Next: "+n.Next)
+	}
+
+	footer := strings.Join(links, " | ")
+	if n.Collection != "" && n.BookPosition > 0 {
+		footer += fmt.Sprintf("\nBook %d of %d in the %s", n.BookPosition, n.BookCount, n.Collection)
+	}
+	return footer
+}
+
+// chapterNavigation computes the previous/next chapters and the book's position in its collection
+func (s *Service) chapterNavigation(book string, chapter int) ChapterNavigation {
+	nav := ChapterNavigation{
+		Collection:   s.bookCollections[book],
+		ChapterCount: len(s.chapterNumbers(book)),
+	}
+
+	sequence := s.chapterSequence()
+	for i, key := range sequence {
+		if key.book != book || key.chapter != chapter {
+			continue
+		}
+		if i > 0 {
+			nav.Previous = sequence[i-1].String()
+		}
+		if i < len(sequence)-1 {
+			nav.Next = sequence[i+1].String()
+		}
+		break
+	}
+
+	if nav.Collection != "" {
+		for _, other := range s.orderedBooks() {
+			if s.bookCollections[other] != nav.Collection {
+				continue
+			}
+			nav.BookCount++
+			if other == book {
+				nav.BookPosition = nav.BookCount
+			}
+		}
 	}
 
-	return mcp.NewToolResultText(response), nil
+	return nav
 }
 
 // performSearch performs a keyword search through loaded scripture data
 func (s *Service) performSearch(query string, limit int) []Scripture {
-	var results []Scripture
-	queryLower := strings.ToLower(query)
+	results, _ := s.searchPage(context.Background(), query, modePhrase, 0, limit)
+	return results
+}
 
-	// Search through all loaded scriptures
-	for _, bookScriptures := range s.scriptures {
-		for _, scripture := range bookScriptures {
-			if strings.Contains(strings.ToLower(scripture.Text), queryLower) ||
-				strings.Contains(strings.ToLower(scripture.Book), queryLower) {
+// searchPage returns up to limit keyword matches for a search mode starting
+// at offset, in canonical book order, along with the total number of matches
+func (s *Service) searchPage(ctx context.Context, query, mode string, offset, limit int) ([]Scripture, int) {
+	matches := s.modeMatches(ctx, query, mode)
+	if offset >= len(matches) {
+		return nil, len(matches)
+	}
+	return matches[offset:min(offset+limit, len(matches))], len(matches)
+}
+
+// searchMatches returns every verse matching the lowercased query in
+// canonical book order, using the inverted index when it has been built
+func (s *Service) searchMatches(ctx context.Context, queryLower string) []Scripture {
+	if s.index != nil {
+		return s.index.search(ctx, queryLower)
+	}
+
+	var results []Scripture
+	for _, book := range s.orderedBooks() {
+		if ctx.Err() != nil {
+			break
+		}
+		for _, scripture := range s.scriptures[book] {
+			if matchesQuery(scripture, queryLower) {
 				results = append(results, scripture)
-				if len(results) >= limit {
-					return results
-				}
 			}
 		}
 	}
-
 	return results
 }
 
-// parseReference parses a scripture reference like "1 Nephi 3:7" or "John 3:16-17"
+// splitByCollection splits search matches by the collection of their book,
+// keeping up to limit of each collection and counting all of them
+func (s *Service) splitByCollection(matches []Scripture, limit int) (map[string][]Scripture, map[string]int) {
+	shown := make(map[string][]Scripture)
+	counts := make(map[string]int)
+	for _, scripture := range matches {
+		collection := s.bookCollections[scripture.Book]
+		if counts[collection] < limit {
+			shown[collection] = append(shown[collection], scripture)
+		}
+		counts[collection]++
+	}
+	return shown, counts
+}
+
+// matchesQuery reports whether a verse's text or book name contains the lowercased query
+func matchesQuery(scripture Scripture, queryLower string) bool {
+	return strings.Contains(strings.ToLower(scripture.Text), queryLower) ||
+		strings.Contains(strings.ToLower(scripture.Book), queryLower)
+}
+
+// hasCollection reports whether any book of the named collection is loaded
+func (s *Service) hasCollection(collection string) bool {
+	for _, c := range s.bookCollections {
+		if c == collection {
+			return true
+		}
+	}
+	return false
+}
+
+// rangeDashPattern matches an en or em dash between two numbers, as in
+// "Alma 32:17–27", leaving the dashes of book names such as
+// "Joseph Smith—History" alone
+var rangeDashPattern = regexp.MustCompile(`(\d)\s*[–—]\s*(\d)`)
+
+// normalizeRangeDashes writes the en and em dashes of verse ranges as
+// hyphens, so typeset references parse like typed ones
+func normalizeRangeDashes(reference string) string {
+	return rangeDashPattern.ReplaceAllString(reference, "$1-$2")
+}
+
+// parseReference parses a scripture reference like "1 Nephi 3:7" or "John 3:16-17".
+// The book may be abbreviated, as in "1 Ne. 3:7".
 func (s *Service) parseReference(reference string) (*ScriptureReference, error) {
 	// Simple regex to parse references like "1 Nephi 3:7" or "John 3:16-17"
 	re := regexp.MustCompile(`^(.+?)\s+(\d+):(\d+)(?:-(\d+))?$`)
-	matches := re.FindStringSubmatch(strings.TrimSpace(reference))
+	matches := re.FindStringSubmatch(normalizeRangeDashes(strings.TrimSpace(reference)))
 
 	if len(matches) < 4 {
 		return nil, fmt.Errorf("invalid reference format. Use format like '1 Nephi 3:7' or 'John 3:16-17'")
 	}
 
-	book := strings.TrimSpace(matches[1])
+	book := s.bookName(matches[1])
 	chapter, err := strconv.Atoi(matches[2])
 	if err != nil {
 		return nil, fmt.Errorf("invalid chapter number: %s", matches[2])
@@ -380,7 +1240,7 @@ func (s *Service) parseReference(reference string) (*ScriptureReference, error)
 	}, nil
 }
 
-// parseChapterReference parses a chapter reference like "1 Nephi 3"
+// parseChapterReference parses a chapter reference like "1 Nephi 3" or "Matt 5"
 func (s *Service) parseChapterReference(reference string) (*ScriptureReference, error) {
 	// Simple regex to parse chapter references like "1 Nephi 3"
 	re := regexp.MustCompile(`^(.+?)\s+(\d+)$`)
@@ -390,7 +1250,7 @@ func (s *Service) parseChapterReference(reference string) (*ScriptureReference,
 		return nil, fmt.Errorf("invalid chapter reference format. Use format like '1 Nephi 3'")
 	}
 
-	book := strings.TrimSpace(matches[1])
+	book := s.bookName(matches[1])
 	chapter, err := strconv.Atoi(matches[2])
 	if err != nil {
 		return nil, fmt.Errorf("invalid chapter number: %s", matches[2])
@@ -402,6 +1262,16 @@ func (s *Service) parseChapterReference(reference string) (*ScriptureReference,
 	}, nil
 }
 
+// bookName returns the loaded book a reference names, or the name as given
+// if it matches none, so lookups report the reference as not found
+func (s *Service) bookName(name string) string {
+	name = strings.TrimSpace(name)
+	if book, ok := s.resolveBook(name); ok {
+		return book
+	}
+	return name
+}
+
 // getScripturesByReference retrieves scriptures by reference from loaded data
 func (s *Service) getScripturesByReference(ref *ScriptureReference) []Scripture {
 	var results []Scripture
@@ -1,6 +1,7 @@
 package scripture
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"os"
@@ -13,45 +14,20 @@ import (
 
 // Test data for scripture testing
 var testScriptureData = ScriptureData{
-	Books: []struct {
-		Book     string `json:"book"`
-		Chapters []struct {
-			Chapter int `json:"chapter"`
-			Verses  []struct {
-				Verse     int    `json:"verse"`
-				Text      string `json:"text"`
-				Reference string `json:"reference"`
-			} `json:"verses"`
-		} `json:"chapters"`
-	}{
+	Books: []Book{
 		{
 			Book: "1 Nephi",
-			Chapters: []struct {
-				Chapter int `json:"chapter"`
-				Verses  []struct {
-					Verse     int    `json:"verse"`
-					Text      string `json:"text"`
-					Reference string `json:"reference"`
-				} `json:"verses"`
-			}{
+			Chapters: []Chapter{
 				{
 					Chapter: 3,
-					Verses: []struct {
-						Verse     int    `json:"verse"`
-						Text      string `json:"text"`
-						Reference string `json:"reference"`
-					}{
+					Verses: []Verse{
 						{Verse: 7, Text: "And it came to pass that I, Nephi, said unto my father: I will go and do the things which the Lord hath commanded, for I know that the Lord giveth no commandments unto the children of men, save he shall prepare a way for them that they may accomplish the thing which he commandeth them.", Reference: "1 Nephi 3:7"},
 						{Verse: 8, Text: "And it came to pass that when my father had heard these words he was exceedingly glad, for he knew that I had been blessed of the Lord.", Reference: "1 Nephi 3:8"},
 					},
 				},
 				{
 					Chapter: 17,
-					Verses: []struct {
-						Verse     int    `json:"verse"`
-						Text      string `json:"text"`
-						Reference string `json:"reference"`
-					}{
+					Verses: []Verse{
 						{Verse: 50, Text: "And I said unto them: If God had commanded me to do all things I could do them. If he should command me that I should say unto this water, be thou earth, it should be earth; and if I should say it, it would be done.", Reference: "1 Nephi 17:50"},
 					},
 				},
@@ -59,21 +35,10 @@ var testScriptureData = ScriptureData{
 		},
 		{
 			Book: "John",
-			Chapters: []struct {
-				Chapter int `json:"chapter"`
-				Verses  []struct {
-					Verse     int    `json:"verse"`
-					Text      string `json:"text"`
-					Reference string `json:"reference"`
-				} `json:"verses"`
-			}{
+			Chapters: []Chapter{
 				{
 					Chapter: 3,
-					Verses: []struct {
-						Verse     int    `json:"verse"`
-						Text      string `json:"text"`
-						Reference string `json:"reference"`
-					}{
+					Verses: []Verse{
 						{Verse: 16, Text: "For God so loved the world, that he gave his only begotten Son, that whosoever believeth in him should not perish, but have everlasting life.", Reference: "John 3:16"},
 						{Verse: 17, Text: "For God sent not his Son into the world to condemn the world; but that the world through him might be saved.", Reference: "John 3:17"},
 					},
@@ -189,6 +154,28 @@ func TestService_parseReference(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name:      "Verse range with en dash",
+			reference: "Alma 32:17–27",
+			expected: &ScriptureReference{
+				Book:     "Alma",
+				Chapter:  32,
+				Verse:    17,
+				EndVerse: 27,
+			},
+			expectError: false,
+		},
+		{
+			name:      "Verse range with em dash in a dashed book",
+			reference: "Joseph Smith—History 1:15 — 17",
+			expected: &ScriptureReference{
+				Book:     "Joseph Smith—History",
+				Chapter:  1,
+				Verse:    15,
+				EndVerse: 17,
+			},
+			expectError: false,
+		},
 		{
 			name:        "Invalid format",
 			reference:   "Invalid reference",
@@ -675,3 +662,324 @@ func TestService_GetChapter(t *testing.T) {
 	}
 }
 
+
+
+func TestService_SearchAll(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	
+	// Load the test data as the Book of Mormon
+	jsonData, err := json.Marshal(testScriptureData)
+	if err != nil {
+		t.Fatalf("Failed to marshal test data: %v", err)
+	}
+	service.parseAndStore(bytes.NewReader(jsonData), "book-of-mormon.json")
+	
+	if got := service.bookCollections["1 Nephi"]; got != "Book of Mormon" {
+		t.Errorf("Expected 1 Nephi in 'Book of Mormon', got '%s'", got)
+	}
+	
+	tests := []struct {
+		name          string
+		arguments     map[string]interface{}
+		expectError   bool
+		shouldContain []string
+	}{
+		{
+			name: "Grouped results",
+			arguments: map[string]interface{}{
+				"query": "God",
+				"limit": 1.0,
+			},
+			expectError:   false,
+			shouldContain: []string{"== Book of Mormon (1) =="},
+		},
+		{
+			name: "Zero limit returns one per collection",
+			arguments: map[string]interface{}{
+				"query": "God",
+				"limit": 0.0,
+			},
+			expectError:   false,
+			shouldContain: []string{"== Book of Mormon (1) =="},
+		},
+		{
+			name: "Negative limit returns one per collection",
+			arguments: map[string]interface{}{
+				"query": "God",
+				"limit": -3.0,
+			},
+			expectError:   false,
+			shouldContain: []string{"== Book of Mormon (1) =="},
+		},
+		{
+			name: "No matches",
+			arguments: map[string]interface{}{
+				"query": "nonexistent",
+			},
+			expectError:   false,
+			shouldContain: []string{"No scriptures found"},
+		},
+		{
+			name:        "Missing query",
+			arguments:   map[string]interface{}{},
+			expectError: true,
+		},
+	}
+	
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+			result, err := service.SearchAll(context.Background(), request)
+			
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			
+			if tt.expectError {
+				if !result.IsError {
+					t.Error("Expected error result but got success")
+				}
+				return
+			}
+			if result.IsError {
+				t.Error("Expected success but got error result")
+			}
+			
+			text := result.Content[0].(mcp.TextContent).Text
+			for _, want := range tt.shouldContain {
+				if !strings.Contains(text, want) {
+					t.Errorf("Expected output to contain '%s', got:\n%s", want, text)
+				}
+			}
+		})
+	}
+}
+
+func TestService_parseAndStore_Sections(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	
+	data := []byte(`{"sections": [{"section": 4, "verses": [
+		{"verse": 1, "text": "Now behold, a marvelous work is about to come forth among the children of men.", "reference": "D&C 4:1"},
+		{"verse": 2, "text": "Therefore, O ye that embark in the service of God", "reference": "D&C 4:2"}
+	]}]}`)
+	service.parseAndStore(bytes.NewReader(data), "doctrine-and-covenants.json")
+	
+	verses := service.getScripturesByReference(&ScriptureReference{
+		Book:     "Doctrine and Covenants",
+		Chapter:  4,
+		Verse:    2,
+		EndVerse: 2,
+	})
+	if len(verses) != 1 {
+		t.Fatalf("Expected 1 verse for D&C 4:2, got %d", len(verses))
+	}
+	if verses[0].Reference != "D&C 4:2" {
+		t.Errorf("Expected reference 'D&C 4:2', got '%s'", verses[0].Reference)
+	}
+	if got := service.bookCollections["Doctrine and Covenants"]; got != "Doctrine and Covenants" {
+		t.Errorf("Expected collection 'Doctrine and Covenants', got '%s'", got)
+	}
+}
+
+func TestService_chapterNavigation(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	
+	// Add test data
+	service.recordBook("1 Nephi", "Book of Mormon")
+	service.recordBook("2 Nephi", "Book of Mormon")
+	service.scriptures["1 Nephi"] = []Scripture{
+		{Book: "1 Nephi", Chapter: 21, Verse: 1, Text: "And again: Hearken, O ye house of Israel", Reference: "1 Nephi 21:1"},
+		{Book: "1 Nephi", Chapter: 22, Verse: 1, Text: "And now it came to pass", Reference: "1 Nephi 22:1"},
+	}
+	service.scriptures["2 Nephi"] = []Scripture{
+		{Book: "2 Nephi", Chapter: 1, Verse: 1, Text: "And now it came to pass", Reference: "2 Nephi 1:1"},
+	}
+	
+	tests := []struct {
+		name     string
+		book     string
+		chapter  int
+		expected ChapterNavigation
+	}{
+		{
+			name:    "First chapter",
+			book:    "1 Nephi",
+			chapter: 21,
+			expected: ChapterNavigation{
+				Next:         "1 Nephi 22",
+				Collection:   "Book of Mormon",
+				BookPosition: 1,
+				BookCount:    2,
+				ChapterCount: 2,
+			},
+		},
+		{
+			name:    "Crosses into next book",
+			book:    "1 Nephi",
+			chapter: 22,
+			expected: ChapterNavigation{
+				Previous:     "1 Nephi 21",
+				Next:         "2 Nephi 1",
+				Collection:   "Book of Mormon",
+				BookPosition: 1,
+				BookCount:    2,
+				ChapterCount: 2,
+			},
+		},
+		{
+			name:    "Last chapter",
+			book:    "2 Nephi",
+			chapter: 1,
+			expected: ChapterNavigation{
+				Previous:     "1 Nephi 22",
+				Collection:   "Book of Mormon",
+				BookPosition: 2,
+				BookCount:    2,
+				ChapterCount: 1,
+			},
+		},
+	}
+	
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nav := service.chapterNavigation(tt.book, tt.chapter)
+			if nav != tt.expected {
+				t.Errorf("Expected %+v, got %+v", tt.expected, nav)
+			}
+		})
+	}
+	
+	t.Run("Metadata on get_chapter", func(t *testing.T) {
+		request := mcp.CallToolRequest{
+			Params: mcp.CallToolParams{
+				Arguments: map[string]interface{}{"query": "1 Nephi 22"},
+			},
+		}
+		result, err := service.GetChapter(context.Background(), request)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.Meta == nil {
+			t.Fatal("Expected navigation metadata")
+		}
+		nav, ok := result.Meta.AdditionalFields["navigation"].(ChapterNavigation)
+		if !ok || nav.Next != "2 Nephi 1" {
+			t.Errorf("Expected navigation with next '2 Nephi 1', got %+v", result.Meta.AdditionalFields["navigation"])
+		}
+		text := result.Content[0].(mcp.TextContent).Text
+		if !strings.Contains(text, "Previous: 1 Nephi 21 | Next: 2 Nephi 1") {
+			t.Errorf("Expected navigation footer, got:\n%s", text)
+		}
+	})
+}
+
+func TestParseOptionalCollections(t *testing.T) {
+	tests := []struct {
+		list     string
+		expected bool
+	}{
+		{"", false},
+		{"Apocrypha", true},
+		{"apocrypha", true},
+		{"Old Testament, apocrypha", true},
+		{"Pseudepigrapha", false},
+	}
+
+	for _, tt := range tests {
+		if got := parseOptionalCollections(tt.list)["apocrypha.json"]; got != tt.expected {
+			t.Errorf("parseOptionalCollections(%q) enabled Apocrypha = %v, expected %v", tt.list, got, tt.expected)
+		}
+	}
+}
+
+func TestService_OptionalCollections(t *testing.T) {
+	archive := buildTestZip(t, map[string]string{
+		"book-of-mormon.json": `{"books": [{"book": "Enos", "chapters": [{"chapter": 1, "verses": [{"verse": 1, "text": "wisdom of my father", "reference": "Enos 1:1"}]}]}]}`,
+		"apocrypha.json":      `{"books": [{"book": "Wisdom of Solomon", "chapters": [{"chapter": 1, "verses": [{"verse": 1, "text": "Love righteousness", "reference": "Wisdom of Solomon 1:1"}]}]}]}`,
+	})
+	
+	tests := []struct {
+		name         string
+		optional     map[string]bool
+		expectLoaded bool
+	}{
+		{name: "Off by default", optional: nil, expectLoaded: false},
+		{name: "Enabled", optional: map[string]bool{"apocrypha.json": true}, expectLoaded: true},
+	}
+	
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &Service{
+				scriptures: make(map[string][]Scripture),
+				optional:   tt.optional,
+			}
+			if err := service.loadFromZipBytes(archive, "test zip"); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			
+			_, loaded := service.scriptures["Wisdom of Solomon"]
+			if loaded != tt.expectLoaded {
+				t.Errorf("Expected Apocrypha loaded = %v, got %v", tt.expectLoaded, loaded)
+			}
+			if len(service.scriptures["Enos"]) != 1 {
+				t.Error("Expected standard works to load regardless of optional collections")
+			}
+			
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: map[string]interface{}{"query": "wisdom"},
+				},
+			}
+			result, err := service.SearchAll(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			text := result.Content[0].(mcp.TextContent).Text
+			if strings.Contains(text, "== Apocrypha (1) ==") != tt.expectLoaded {
+				t.Errorf("Unexpected Apocrypha group in search_all output:\n%s", text)
+			}
+		})
+	}
+}
+
+func TestNewServiceFromArchive(t *testing.T) {
+	data, err := json.Marshal(testScriptureData)
+	if err != nil {
+		t.Fatalf("Failed to marshal test data: %v", err)
+	}
+	archive, err := BuildArchive(map[string][]byte{"book-of-mormon.json": data})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	service, err := NewServiceFromArchive(archive)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := service.Ready(); err != nil {
+		t.Errorf("Expected the service to be ready, got %v", err)
+	}
+	if results := service.performSearch("prepare a way", 10); len(results) != 1 || results[0].Reference != "1 Nephi 3:7" {
+		t.Errorf("Expected the in-memory data to be searchable, got %v", results)
+	}
+
+	empty, err := BuildArchive(map[string][]byte{"book-of-mormon.json": []byte(`{"books": []}`)})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for name, archive := range map[string][]byte{"not a zip": []byte("not a zip"), "no verses": empty} {
+		if _, err := NewServiceFromArchive(archive); err == nil {
+			t.Errorf("Expected an error for an archive with %s", name)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package scripture
+
+import (
+	"hash/fnv"
+	"slices"
+)
+
+// shingleSize is the number of consecutive words in a shingle
+const shingleSize = 3
+
+// shingles returns the sorted, distinct hashes of a text's overlapping word
+// k-grams, splitting it into words with the tokenizer; a text shorter than
+// shingleSize words yields a single shingle of all its words.
+func shingles(tokenizer Tokenizer, text string) []uint64 {
+	words := tokenizer.Words(text)
+	if len(words) == 0 {
+		return nil
+	}
+
+	n := max(len(words)-shingleSize+1, 1)
+	hashes := make([]uint64, 0, n)
+	h := fnv.New64a()
+	for i := range n {
+		h.Reset()
+		for k, word := range words[i:min(i+shingleSize, len(words))] {
+			if k > 0 {
+				h.Write([]byte{' '})
+			}
+			h.Write([]byte(word))
+		}
+		hashes = append(hashes, h.Sum64())
+	}
+	slices.Sort(hashes)
+	return slices.Compact(hashes)
+}
+
+// jaccard returns the Jaccard similarity of two sorted, distinct shingle sets
+func jaccard(a, b []uint64) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			shared++
+			i++
+			j++
+		case a[i] < b[j]:
+			i++
+		default:
+			j++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
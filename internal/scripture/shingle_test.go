@@ -0,0 +1,45 @@
+package scripture
+
+import "testing"
+
+func TestShingles(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected int
+	}{
+		{name: "Overlapping word triples", text: "And the LORD spake unto Moses, saying,", expected: 5},
+		{name: "Short text is one shingle", text: "Jesus wept.", expected: 1},
+		{name: "Repeated triples are counted once", text: "holy holy holy holy", expected: 1},
+		{name: "No words", text: " -- ", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := len(shingles(archaicEnglishTokenizer{}, tt.text)); got != tt.expected {
+				t.Errorf("Expected %d shingles, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestJaccard(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		expected float64
+	}{
+		{name: "Case and punctuation are ignored", a: "The word of the LORD came unto me.", b: "the word of the Lord came unto me", expected: 1},
+		{name: "One word changed", a: "a b c d e f", b: "a b c d e g", expected: 3.0 / 5.0},
+		{name: "Nothing shared", a: "a b c", b: "d e f", expected: 0},
+		{name: "Empty text", a: "", b: "a b c", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jaccard(shingles(archaicEnglishTokenizer{}, tt.a), shingles(archaicEnglishTokenizer{}, tt.b)); got != tt.expected {
+				t.Errorf("Expected similarity %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxSimilarVerses caps the limit of find_similar_verses
+const maxSimilarVerses = 50
+
+// maxSharedTerms is the number of shared terms reported per similar verse
+const maxSharedTerms = 5
+
+// SimilarVerse is a verse ranked by its similarity to the query passage, with
+// the rarest words the two have in common
+type SimilarVerse struct {
+	Reference   string   `json:"reference"`
+	Text        string   `json:"text"`
+	Similarity  float64  `json:"similarity"`  // TF-IDF cosine similarity between 0 and 1
+	SharedTerms []string `json:"sharedTerms"` // Rarest first
+}
+
+// FindSimilarVerses returns the verses whose wording is most similar to a
+// verse or range, weighting each word by its rarity across the corpus so
+// shared rare terms count for more than shared common ones
+func (s *Service) FindSimilarVerses(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	query, ok := arguments["query"].(string)
+	if !ok || query == "" {
+		return mcp.NewToolResultError("scripture reference cannot be empty"), nil
+	}
+	ref, err := s.parseReference(query)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid scripture reference: %v", err)), nil
+	}
+	source := s.getScripturesByReference(ref)
+	if len(source) == 0 {
+		return mcp.NewToolResultError(fmt.Sprintf("no verses found for '%s'", query)), nil
+	}
+
+	limit := 10
+	if limitVal, ok := arguments["limit"].(float64); ok {
+		limit = min(max(int(limitVal), 1), maxSimilarVerses)
+	}
+
+	var scope []*ScriptureReference
+	scopeText, _ := arguments["scope"].(string)
+	if scopeText != "" {
+		scopeRef, err := s.parseSelector(scopeText)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		scope = append(scope, scopeRef)
+	}
+
+	index := s.index
+	if index == nil {
+		index = s.newSearchIndex()
+	}
+	var text []string
+	exclude := make(map[string]bool, len(source))
+	for _, verse := range source {
+		text = append(text, verse.Text)
+		exclude[verse.Reference] = true
+	}
+	similar := index.similar(ctx, strings.Join(text, " "), func(verse Scripture) bool {
+		return !exclude[verse.Reference] && (scope == nil || selectorMatches(scope, verse))
+	}, limit)
+
+	reference := spanReference(source, verseSpan{Start: 0, End: len(source) - 1})
+	structured := SimilarVersesResult{Reference: reference, Scope: scopeText, Verses: append([]SimilarVerse{}, similar...)}
+	where := ""
+	if scopeText != "" {
+		where = " in " + scopeText
+	}
+	if len(similar) == 0 {
+		return mcp.NewToolResultStructured(structured, fmt.Sprintf("No verses similar to %s found%s.", reference, where)), nil
+	}
+
+	response := fmt.Sprintf("Verses most similar to %s%s:\n\n", reference, where)
+	for i, verse := range similar {
+		response += fmt.Sprintf("%d. %s (similarity %.2f; shared: %s)\n   %s\n\n", i+1, verse.Reference, verse.Similarity, strings.Join(verse.SharedTerms, ", "), verse.Text)
+	}
+	return mcp.NewToolResultStructured(structured, response), nil
+}
+
+// similar ranks the verses accepted by keep by the TF-IDF cosine similarity
+// of their words to the text's, returning up to limit of them, most similar
+// first and in canonical order among equal scores
+func (idx *searchIndex) similar(ctx context.Context, text string, keep func(Scripture) bool, limit int) []SimilarVerse {
+	if len(idx.verses) == 0 {
+		return nil
+	}
+	norms := idx.vectorNorms()
+
+	counts := make(map[string]int)
+	for _, word := range idx.tokenizer.Words(text) {
+		counts[word]++
+	}
+	queryNorm := 0.0
+	scores := make(map[int32]float64)
+	for word, count := range counts {
+		if ctx.Err() != nil {
+			break
+		}
+		postings := idx.words[word]
+		if len(postings) == 0 {
+			continue
+		}
+		idf := idx.idf(word)
+		weight := float64(count) * idf
+		queryNorm += weight * weight
+		for _, p := range postings {
+			scores[p.id] += weight * float64(p.count) * idf
+		}
+	}
+	if queryNorm == 0 {
+		return nil
+	}
+	queryNorm = math.Sqrt(queryNorm)
+
+	ids := make([]int32, 0, len(scores))
+	for id, score := range scores {
+		if score > 0 && keep(idx.verses[id]) {
+			scores[id] = score / (queryNorm * norms[id])
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if scores[ids[i]] != scores[ids[j]] {
+			return scores[ids[i]] > scores[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+
+	similar := make([]SimilarVerse, 0, min(limit, len(ids)))
+	for _, id := range ids[:min(limit, len(ids))] {
+		verse := idx.verses[id]
+		var shared []string
+		seen := make(map[string]bool)
+		for _, word := range idx.tokenizer.Words(verse.Text) {
+			if counts[word] > 0 && !seen[word] {
+				seen[word] = true
+				shared = append(shared, word)
+			}
+		}
+		sort.SliceStable(shared, func(i, j int) bool {
+			return len(idx.words[shared[i]]) < len(idx.words[shared[j]])
+		})
+		similar = append(similar, SimilarVerse{
+			Reference:   verse.Reference,
+			Text:        verse.Text,
+			Similarity:  round2(min(scores[id], 1)),
+			SharedTerms: shared[:min(len(shared), maxSharedTerms)],
+		})
+	}
+	return similar
+}
+
+// idf returns the inverse document frequency of an indexed word: the log of
+// how many times rarer than in every verse it is
+func (idx *searchIndex) idf(word string) float64 {
+	return math.Log(float64(len(idx.verses)) / float64(len(idx.words[word])))
+}
+
+// vectorNorms returns the length of each verse's TF-IDF vector, computed once
+func (idx *searchIndex) vectorNorms() []float64 {
+	idx.normsOnce.Do(func() {
+		idx.norms = make([]float64, len(idx.verses))
+		for word, postings := range idx.words {
+			idf := idx.idf(word)
+			for _, p := range postings {
+				weight := float64(p.count) * idf
+				idx.norms[p.id] += weight * weight
+			}
+		}
+		for i := range idx.norms {
+			idx.norms[i] = math.Sqrt(idx.norms[i])
+		}
+	})
+	return idx.norms
+}
@@ -0,0 +1,83 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestSearchIndex_Similar(t *testing.T) {
+	service := &Service{scriptures: map[string][]Scripture{
+		"Genesis": {
+			{Book: "Genesis", Chapter: 1, Verse: 1, Reference: "Genesis 1:1", Text: "In the beginning God created the heaven and the earth."},
+			{Book: "Genesis", Chapter: 1, Verse: 2, Reference: "Genesis 1:2", Text: "And the earth was without form, and void."},
+			{Book: "Genesis", Chapter: 1, Verse: 3, Reference: "Genesis 1:3", Text: "And God said, Let there be light: and there was light."},
+		},
+		"Moses": {
+			{Book: "Moses", Chapter: 2, Verse: 1, Reference: "Moses 2:1", Text: "In the beginning I created the heaven, and the earth."},
+		},
+	}}
+	index := service.newSearchIndex()
+
+	similar := index.similar(context.Background(), "In the beginning God created the heaven and the earth.", func(verse Scripture) bool {
+		return verse.Reference != "Genesis 1:1"
+	}, 10)
+	if len(similar) != 3 || similar[0].Reference != "Moses 2:1" {
+		t.Fatalf("Expected Moses 2:1 first of 3 verses, got %+v", similar)
+	}
+	if similar[0].SharedTerms[0] != "in" || similar[0].Similarity < 10*similar[1].Similarity {
+		t.Errorf("Expected the shared rare words to dominate, got %+v", similar)
+	}
+	if identical := index.similar(context.Background(), "And the earth was without form, and void.", func(Scripture) bool { return true }, 1); identical[0].Similarity != 1 {
+		t.Errorf("Expected a similarity of 1 for identical text, got %+v", identical)
+	}
+}
+
+func TestService_FindSimilarVerses(t *testing.T) {
+	service := NewService()
+
+	tests := []struct {
+		name      string
+		arguments map[string]any
+		first     string // Expected most similar verse
+		count     int
+		isError   bool
+	}{
+		{name: "Isaiah in 2 Nephi", arguments: map[string]any{"query": "Isaiah 2:2", "limit": float64(2)}, first: "2 Nephi 12:2", count: 2},
+		{name: "Scope", arguments: map[string]any{"query": "Matthew 5:3", "scope": "3 Nephi"}, first: "3 Nephi 12:3", count: 10},
+		{name: "Range excludes itself", arguments: map[string]any{"query": "Isaiah 53:4-5", "limit": float64(3)}, first: "Mosiah 14:5", count: 3},
+		{name: "Empty", arguments: map[string]any{}, isError: true},
+		{name: "Chapter", arguments: map[string]any{"query": "Isaiah 2"}, isError: true},
+		{name: "Missing verse", arguments: map[string]any{"query": "Isaiah 2:99"}, isError: true},
+		{name: "Bad scope", arguments: map[string]any{"query": "Isaiah 2:2", "scope": "Hezekiah"}, isError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = tt.arguments
+			result, err := service.FindSimilarVerses(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.IsError != tt.isError {
+				t.Fatalf("Expected IsError %v, got %+v", tt.isError, result)
+			}
+			if tt.isError {
+				return
+			}
+
+			structured := result.StructuredContent.(SimilarVersesResult)
+			if len(structured.Verses) != tt.count || structured.Verses[0].Reference != tt.first {
+				t.Fatalf("Expected %d verses starting with %s, got %+v", tt.count, tt.first, structured.Verses)
+			}
+			for _, verse := range structured.Verses {
+				if strings.HasPrefix(verse.Reference, "Isaiah 53:") {
+					t.Errorf("Expected the query's verses to be excluded, got %s", verse.Reference)
+				}
+			}
+		})
+	}
+}
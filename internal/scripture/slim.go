@@ -0,0 +1,109 @@
+package scripture
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SlimData writes an archive holding only the named collections of the
+// archive at source, for embedding in builds with the scriptures_slim tag.
+// Collections are named as in the standard works ("Book of Mormon") or by
+// data file without extension ("book-of-mormon"), ignoring case.
+func SlimData(source, out string, collections []string, w io.Writer) error {
+	archive, err := os.ReadFile(source)
+	if err != nil {
+		return err
+	}
+	slim, err := SlimArchive(archive, collections)
+	if err != nil {
+		return err
+	}
+	if _, err := verifyArchive(slim); err != nil {
+		return fmt.Errorf("slim archive failed verification: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(out), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(out, slim, 0644); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "Wrote %s (%d bytes, %d%% of %s)\n", out, len(slim), len(slim)*100/len(archive), source)
+	return nil
+}
+
+// SlimArchive rebuilds a scripture archive keeping only the data files of
+// the named collections, with a manifest covering just those files
+func SlimArchive(archive []byte, collections []string) ([]byte, error) {
+	keep, err := slimDataFiles(collections)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string][]byte, len(keep))
+	for _, f := range r.File {
+		if !keep[f.Name] {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("open %s: %w", f.Name, err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", f.Name, err)
+		}
+		files[f.Name] = data
+	}
+
+	for name := range keep {
+		if _, found := files[name]; !found {
+			return nil, fmt.Errorf("the source archive has no %s", name)
+		}
+	}
+	return BuildArchive(files)
+}
+
+// slimDataFiles returns the data files of the named standard works
+func slimDataFiles(collections []string) (map[string]bool, error) {
+	keep := make(map[string]bool)
+	for _, name := range collections {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		found := false
+		for _, work := range standardWorks {
+			if strings.EqualFold(name, work.Name) || strings.EqualFold(name, strings.TrimSuffix(work.File, ".json")) {
+				keep[work.File] = true
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown collection '%s' (expected one of: %s)", name, strings.Join(standardWorkNames(), ", "))
+		}
+	}
+	if len(keep) == 0 {
+		return nil, fmt.Errorf("no collections given")
+	}
+	return keep, nil
+}
+
+// standardWorkNames lists the names of the standard works in canonical order
+func standardWorkNames() []string {
+	names := make([]string, 0, len(standardWorks))
+	for _, work := range standardWorks {
+		names = append(names, work.Name)
+	}
+	return names
+}
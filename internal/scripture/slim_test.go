@@ -0,0 +1,87 @@
+package scripture
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestSlimArchive(t *testing.T) {
+	files := make(map[string][]byte)
+	for _, name := range scriptureJSONFilenames() {
+		files[name] = []byte(`{"books": []}`)
+	}
+	archive, err := BuildArchive(files)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		collections []string
+		expected    []string
+		expectError string
+	}{
+		{name: "By name", collections: []string{"Book of Mormon"}, expected: []string{"book-of-mormon.json"}},
+		{name: "By file", collections: []string{" doctrine-and-covenants", "PEARL-OF-GREAT-PRICE"}, expected: []string{"doctrine-and-covenants.json", "pearl-of-great-price.json"}},
+		{name: "Unknown", collections: []string{"Book of Mormon", "Apocrypha"}, expectError: "unknown collection 'Apocrypha'"},
+		{name: "None", collections: []string{"", " "}, expectError: "no collections given"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slim, err := SlimArchive(archive, tt.collections)
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if _, err := verifyArchive(slim); err != nil {
+				t.Fatalf("Slim archive failed verification: %v", err)
+			}
+
+			service := &Service{scriptures: make(map[string][]Scripture)}
+			if err := service.loadFromZipBytes(slim, "slim"); err != nil {
+				t.Fatalf("Failed to load slim archive: %v", err)
+			}
+			verified := append([]string{}, service.integrity.Verified...)
+			sort.Strings(tt.expected)
+			if strings.Join(verified, ",") != strings.Join(tt.expected, ",") || len(service.integrity.Missing) > 0 {
+				t.Errorf("Expected exactly %v verified, got %+v", tt.expected, service.integrity)
+			}
+		})
+	}
+}
+
+func TestSlimData(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "scriptures.zip")
+	archive, err := BuildArchive(map[string][]byte{"book-of-mormon.json": []byte(`{"books": []}`)})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := os.WriteFile(source, archive, 0644); err != nil {
+		t.Fatalf("Failed to write source archive: %v", err)
+	}
+
+	// The source must hold every requested collection
+	out := filepath.Join(dir, "slim", "scriptures-slim.zip")
+	if err := SlimData(source, out, []string{"New Testament"}, &bytes.Buffer{}); err == nil || !strings.Contains(err.Error(), "no new-testament.json") {
+		t.Errorf("Expected a missing collection error, got %v", err)
+	}
+
+	var report bytes.Buffer
+	if err := SlimData(source, out, []string{"Book of Mormon"}, &report); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := os.Stat(out); err != nil || !strings.HasPrefix(report.String(), "Wrote "+out) {
+		t.Errorf("Expected the slim archive to be written, got %v:\n%s", err, report.String())
+	}
+}
@@ -0,0 +1,94 @@
+package scripture
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// stateDirName is the server's folder inside the user's application data directory
+const stateDirName = "scriptures-mcp"
+
+// StateDir returns the per-user directory the server keeps its files in:
+// SCRIPTURES_STATE_DIR when set, otherwise the operating system's
+// application data location. It reports false when there is none, as under
+// WebAssembly without the override.
+func StateDir() (string, bool) {
+	if dir := os.Getenv("SCRIPTURES_STATE_DIR"); dir != "" {
+		return dir, true
+	}
+	base, err := userDataHome()
+	if err != nil || base == "" {
+		return "", false
+	}
+	return filepath.Join(base, stateDirName), true
+}
+
+// userDataHome returns the base directory for per-user application data:
+// %AppData% on Windows, ~/Library/Application Support on macOS and
+// $XDG_DATA_HOME (default ~/.local/share) elsewhere
+func userDataHome() (string, error) {
+	switch runtime.GOOS {
+	case "js", "wasip1":
+		return "", errors.New("no user data directory under WebAssembly")
+	case "windows", "darwin", "ios":
+		return os.UserConfigDir()
+	}
+	// The XDG spec says to ignore relative paths
+	if dir := os.Getenv("XDG_DATA_HOME"); filepath.IsAbs(dir) {
+		return dir, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".local", "share"), nil
+}
+
+// stateDataDir returns the data folder of the state directory, which is
+// searched for data files after SCRIPTURES_DATA_DIR
+func stateDataDir() (string, bool) {
+	dir, ok := StateDir()
+	if !ok {
+		return "", false
+	}
+	return filepath.Join(dir, "data"), true
+}
+
+// dataDirs returns the directories searched for data files, in priority order
+func dataDirs() []string {
+	var dirs []string
+	if override := os.Getenv("SCRIPTURES_DATA_DIR"); override != "" {
+		dirs = append(dirs, override)
+	}
+	if dir, ok := stateDataDir(); ok {
+		dirs = append(dirs, dir)
+	}
+	return dirs
+}
+
+// readDataFile reads a data file from the first data directory that has it,
+// returning its path
+func readDataFile(name string) ([]byte, string, error) {
+	err := error(os.ErrNotExist)
+	for _, dir := range dataDirs() {
+		path := filepath.Join(dir, name)
+		var data []byte
+		if data, err = os.ReadFile(path); err == nil {
+			return data, path, nil
+		}
+	}
+	return nil, "", err
+}
+
+// hasScriptureData reports whether a directory holds a scripture archive or
+// any of the scripture data files
+func (s *Service) hasScriptureData(dir string) bool {
+	for _, name := range append([]string{"scriptures.zip"}, s.dataFilenames()...) {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package scripture
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestStateDir(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_DATA_HOME", "")
+	t.Setenv("SCRIPTURES_STATE_DIR", "")
+
+	if runtime.GOOS == "linux" {
+		tests := []struct {
+			name        string
+			xdgDataHome string
+			expected    string
+		}{
+			{name: "Default", expected: filepath.Join(home, ".local", "share", stateDirName)},
+			{name: "XDG_DATA_HOME", xdgDataHome: "/srv/data", expected: filepath.Join("/srv/data", stateDirName)},
+			{name: "Relative XDG_DATA_HOME is ignored", xdgDataHome: "data", expected: filepath.Join(home, ".local", "share", stateDirName)},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Setenv("XDG_DATA_HOME", tt.xdgDataHome)
+				if dir, ok := StateDir(); !ok || dir != tt.expected {
+					t.Errorf("Expected %q, got %q (%v)", tt.expected, dir, ok)
+				}
+			})
+		}
+	}
+
+	t.Setenv("SCRIPTURES_STATE_DIR", "/tmp/state")
+	if dir, ok := StateDir(); !ok || dir != "/tmp/state" {
+		t.Errorf("Expected the SCRIPTURES_STATE_DIR override, got %q (%v)", dir, ok)
+	}
+}
+
+func TestService_LoadsFromStateDir(t *testing.T) {
+	state := t.TempDir()
+	t.Setenv("SCRIPTURES_STATE_DIR", state)
+	t.Setenv("SCRIPTURES_DATA_DIR", "")
+
+	// An empty state directory falls back to the embedded data quietly
+	service := NewService()
+	if len(service.scriptures) == 0 {
+		t.Fatal("Expected the embedded data without a state data directory")
+	}
+
+	dataDir := filepath.Join(state, "data")
+	if err := os.MkdirAll(dataDir, 0755); err != nil {
+		t.Fatalf("Failed to create data dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dataDir, storiesFile), []byte(testStories), 0644); err != nil {
+		t.Fatalf("Failed to write stories: %v", err)
+	}
+	book := `{"books": [{"book": "Enos", "chapters": [{"chapter": 1, "verses": [{"verse": 1, "text": "Behold", "reference": "Enos 1:1"}]}]}]}`
+	if err := os.WriteFile(filepath.Join(dataDir, "book-of-mormon.json"), []byte(book), 0644); err != nil {
+		t.Fatalf("Failed to write data file: %v", err)
+	}
+
+	service = NewService()
+	if len(service.scriptures) != 1 || len(service.scriptures["Enos"]) != 1 {
+		t.Errorf("Expected only the state directory's scripture data, got %d books", len(service.scriptures))
+	}
+	if len(service.stories) != 1 {
+		t.Errorf("Expected the stories dataset from the state directory, got %d stories", len(service.stories))
+	}
+}
@@ -0,0 +1,95 @@
+package scripture
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// CollectionStatus is the number of books and verses loaded for a collection
+// and the source and licensing of its text, when known
+type CollectionStatus struct {
+	Name    string          `json:"name"`
+	Books   int             `json:"books"`
+	Verses  int             `json:"verses"`
+	License *DatasetLicense `json:"license,omitempty"`
+}
+
+// ServerStatus reports what data is loaded and whether it passed checksum verification
+func (s *Service) ServerStatus(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	collections := s.collectionStatus()
+
+	books, verses := 0, 0
+	for _, collection := range collections {
+		books += collection.Books
+		verses += collection.Verses
+	}
+
+	response := "Server Status:\n\n"
+	response += fmt.Sprintf("Loaded: %d books, %d verses\n", books, verses)
+	if s.integrity == nil {
+		response += "Integrity: not checked\n"
+	} else if s.integrity.OK() {
+		response += fmt.Sprintf("Integrity: %s\n", s.integrity.String())
+	} else {
+		response += fmt.Sprintf("Integrity: FAILED - %s\n", s.integrity.String())
+	}
+
+	response += "\nCollections:\n"
+	for _, collection := range collections {
+		response += fmt.Sprintf("- %s: %d books, %d verses\n", collection.Name, collection.Books, collection.Verses)
+		if license := collection.License; license != nil {
+			response += fmt.Sprintf("  Source: %s\n", license.Source)
+			if license.URL != "" {
+				response += fmt.Sprintf("  URL: %s\n", license.URL)
+			}
+			response += fmt.Sprintf("  License: %s\n", license.License)
+		}
+	}
+
+	structured := StatusResult{Books: books, Verses: verses, Integrity: s.integrity, Collections: collections}
+	result := mcp.NewToolResultStructured(structured, response)
+	result.Meta = &mcp.Meta{AdditionalFields: map[string]any{
+		"collections": collections,
+		"integrity":   s.integrity,
+	}}
+	return result, nil
+}
+
+// Ready reports whether the service can answer requests: scripture data is
+// loaded and the search index is built
+func (s *Service) Ready() error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if len(s.scriptures) == 0 {
+		return errors.New("no scripture data loaded")
+	}
+	if s.index == nil {
+		return errors.New("search index not built")
+	}
+	return nil
+}
+
+// collectionStatus counts loaded books and verses per collection, in load order
+func (s *Service) collectionStatus() []CollectionStatus {
+	var collections []CollectionStatus
+	index := make(map[string]int)
+	for _, book := range s.orderedBooks() {
+		collection := s.bookCollections[book]
+		name := collection
+		if name == "" {
+			name = "Other"
+		}
+		i, seen := index[name]
+		if !seen {
+			i = len(collections)
+			index[name] = i
+			collections = append(collections, CollectionStatus{Name: name, License: s.licenses[collection]})
+		}
+		collections[i].Books++
+		collections[i].Verses += len(s.scriptures[book])
+	}
+	return collections
+}
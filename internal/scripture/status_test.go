@@ -0,0 +1,27 @@
+package scripture
+
+import (
+	"testing"
+)
+
+func TestService_Ready(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	if err := service.Ready(); err == nil {
+		t.Error("Expected an empty service not to be ready")
+	}
+
+	service.recordBook("Enos", "Book of Mormon")
+	service.scriptures["Enos"] = []Scripture{
+		{Book: "Enos", Chapter: 1, Verse: 27, Text: "And I soon go to the place of my rest", Reference: "Enos 1:27"},
+	}
+	if err := service.Ready(); err == nil {
+		t.Error("Expected a service without a search index not to be ready")
+	}
+
+	service.buildIndex()
+	if err := service.Ready(); err != nil {
+		t.Errorf("Expected a loaded and indexed service to be ready, got %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+package scripture
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// storiesFile is the optional children's scripture stories dataset, read from a
+// data directory. The stories are not redistributable, so none are embedded.
+const storiesFile = "scripture-stories.json"
+
+// ScriptureStory is an age-appropriate retelling mapped to the chapters it covers
+type ScriptureStory struct {
+	Title    string   `json:"title"`
+	Summary  string   `json:"summary"`
+	Chapters []string `json:"chapters"` // Chapter references, e.g. "1 Nephi 3"
+	URL      string   `json:"url,omitempty"`
+}
+
+// storiesData is the structure of the stories dataset file
+type storiesData struct {
+	Stories []ScriptureStory `json:"stories"`
+}
+
+// loadStories reads the optional stories dataset from SCRIPTURES_DATA_DIR or the
+// state data directory, if present
+func (s *Service) loadStories() {
+	data, _, err := readDataFile(storiesFile)
+	if err != nil {
+		return
+	}
+	stories, err := s.parseStories(data)
+	if err != nil {
+		slog.Warn("Could not parse stories dataset", "file", storiesFile, "error", err)
+		return
+	}
+	s.stories = stories
+}
+
+// parseStories decodes a stories dataset, keeping only stories whose chapter references parse
+func (s *Service) parseStories(data []byte) ([]ScriptureStory, error) {
+	var parsed storiesData
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return nil, err
+	}
+
+	var stories []ScriptureStory
+	for _, story := range parsed.Stories {
+		valid := story.Title != "" && len(story.Chapters) > 0
+		for _, chapter := range story.Chapters {
+			if _, err := s.parseChapterReference(chapter); err != nil {
+				valid = false
+			}
+		}
+		if !valid {
+			slog.Warn("Skipping story: needs a title and valid chapter references", "title", story.Title, "file", storiesFile)
+			continue
+		}
+		stories = append(stories, story)
+	}
+	return stories, nil
+}
+
+// GetScriptureStory retrieves the children's stories that retell a chapter
+func (s *Service) GetScriptureStory(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	query, ok := arguments["query"].(string)
+	if !ok || query == "" {
+		return mcp.NewToolResultError("chapter reference cannot be empty"), nil
+	}
+
+	if !s.hasDataset(DatasetStories) {
+		return datasetUnavailable(DatasetStories), nil
+	}
+
+	// A verse reference is narrowed to its chapter
+	ref, err := s.parseReference(query)
+	if err != nil {
+		ref, err = s.parseChapterReference(query)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid chapter reference: %v", err)), nil
+		}
+	}
+
+	stories := s.storiesForChapter(ref.Book, ref.Chapter)
+	structured := StoriesResult{Book: ref.Book, Chapter: ref.Chapter, Stories: append([]ScriptureStory{}, stories...)}
+	if len(stories) == 0 {
+		return mcp.NewToolResultStructured(structured, fmt.Sprintf("No scripture stories found for %s %d.", ref.Book, ref.Chapter)), nil
+	}
+
+	response := fmt.Sprintf("Scripture Stories for %s %d:\n\n", ref.Book, ref.Chapter)
+	for _, story := range stories {
+		response += fmt.Sprintf("%s (%s)\n%s\n", story.Title, strings.Join(story.Chapters, "; "), story.Summary)
+		if story.URL != "" {
+			response += story.URL + "\n"
+		}
+		response += "\n"
+	}
+
+	result := mcp.NewToolResultStructured(structured, response)
+	result.Meta = &mcp.Meta{AdditionalFields: map[string]any{"stories": stories}}
+	return result, nil
+}
+
+// storiesForChapter returns the stories mapped to a chapter
+func (s *Service) storiesForChapter(book string, chapter int) []ScriptureStory {
+	var stories []ScriptureStory
+	for _, story := range s.stories {
+		for _, reference := range story.Chapters {
+			ref, err := s.parseChapterReference(reference)
+			if err == nil && ref.Book == book && ref.Chapter == chapter {
+				stories = append(stories, story)
+				break
+			}
+		}
+	}
+	return stories
+}
@@ -0,0 +1,87 @@
+package scripture
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const testStories = `{"stories": [
+	{"title": "Nephi Gets the Brass Plates", "summary": "Nephi and his brothers go back to Jerusalem.", "chapters": ["1 Nephi 3", "1 Nephi 4"]},
+	{"title": "Broken Story", "summary": "No chapters."},
+	{"title": "Bad Reference", "summary": "Unparseable.", "chapters": ["somewhere"]}
+]}`
+
+func TestService_loadStories(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, storiesFile), []byte(testStories), 0644); err != nil {
+		t.Fatalf("Failed to write stories: %v", err)
+	}
+	t.Setenv("SCRIPTURES_DATA_DIR", dir)
+
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	service.loadStories()
+
+	// Stories without chapters or with invalid references are skipped
+	if len(service.stories) != 1 {
+		t.Fatalf("Expected 1 valid story, got %d", len(service.stories))
+	}
+	if got := service.storiesForChapter("1 Nephi", 4); len(got) != 1 {
+		t.Errorf("Expected story for 1 Nephi 4, got %d", len(got))
+	}
+}
+
+func TestService_GetScriptureStory(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	stories, err := service.parseStories([]byte(testStories))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		stories       []ScriptureStory
+		query         string
+		expectError   bool
+		shouldContain string
+	}{
+		{name: "Chapter", stories: stories, query: "1 Nephi 3", shouldContain: "Nephi Gets the Brass Plates (1 Nephi 3; 1 Nephi 4)"},
+		{name: "Verse narrows to chapter", stories: stories, query: "1 Nephi 4:6", shouldContain: "Nephi and his brothers go back"},
+		{name: "No story", stories: stories, query: "Alma 32", shouldContain: "No scripture stories found for Alma 32."},
+		{name: "Invalid reference", stories: stories, query: "nowhere", expectError: true},
+		{name: "No dataset", stories: nil, query: "1 Nephi 3", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service.stories = tt.stories
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: map[string]interface{}{"query": tt.query},
+				},
+			}
+			result, err := service.GetScriptureStory(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.IsError != tt.expectError {
+				t.Fatalf("Expected IsError = %v, got %v", tt.expectError, result.IsError)
+			}
+			if tt.expectError {
+				return
+			}
+			text := result.Content[0].(mcp.TextContent).Text
+			if !strings.Contains(text, tt.shouldContain) {
+				t.Errorf("Expected output to contain %q, got:\n%s", tt.shouldContain, text)
+			}
+		})
+	}
+}
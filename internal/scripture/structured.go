@@ -0,0 +1,295 @@
+package scripture
+
+// Every tool returns its result as structured content alongside the text
+// rendering, so agents can read verses and counts without parsing text. The
+// types below are the structured results; main.go declares each as its
+// tool's output schema. Lists are never nil, so they encode as [] rather
+// than null when nothing was found.
+
+// SearchResult is the structured result of search_scriptures: one page of
+// matching verses
+type SearchResult struct {
+	Query      string      `json:"query"`
+	Mode       string      `json:"mode"`
+	Sort       string      `json:"sort"`
+	Offset     int         `json:"offset"`
+	Total      int         `json:"total"` // Number of matches across all pages
+	Verses     []SearchHit `json:"verses"`
+	NextCursor string      `json:"nextCursor,omitempty"`
+	Partial    bool        `json:"partial,omitempty"` // The search ran out of time
+}
+
+// SearchHit is a verse found by a search
+type SearchHit struct {
+	Scripture
+	Score       float64 `json:"score,omitempty"`       // BM25 score, with sort=relevance
+	Highlighted string  `json:"highlighted,omitempty"` // Text with matched terms marked, with highlight
+}
+
+// SearchAllResult is the structured result of search_all
+type SearchAllResult struct {
+	Query       string             `json:"query"`
+	Collections []CollectionResult `json:"collections"`
+	Partial     bool               `json:"partial,omitempty"` // The search ran out of time
+}
+
+// CollectionResult is the verses search_all found in one collection
+type CollectionResult struct {
+	Collection string      `json:"collection"`
+	Verses     []Scripture `json:"verses"`
+	More       int         `json:"more"` // Matches beyond the limit
+}
+
+// PassageResult is the structured result of get_scripture
+type PassageResult struct {
+	Reference string      `json:"reference"`
+	Verses    []Scripture `json:"verses"`
+	Before    []Scripture `json:"before,omitempty"`   // Context verses preceding the passage, when requested
+	After     []Scripture `json:"after,omitempty"`    // Context verses following the passage, when requested
+	Passages  []Passage   `json:"passages,omitempty"` // The verses grouped per reference, when several were requested
+}
+
+// ChapterResult is the structured result of get_chapter
+type ChapterResult struct {
+	Book        string             `json:"book"`
+	Chapter     int                `json:"chapter"`
+	BookTitle   string             `json:"bookTitle,omitempty"`   // The book's full title, with its first chapter
+	BookHeading string             `json:"bookHeading,omitempty"` // The book's own heading, with its first chapter
+	Heading     string             `json:"heading,omitempty"`     // The chapter heading (summary), when the data has one
+	Verses      []Scripture        `json:"verses"`
+	Navigation  *ChapterNavigation `json:"navigation,omitempty"`
+}
+
+// BookResult is the structured result of get_book
+type BookResult struct {
+	Book         string        `json:"book"`
+	Collection   string        `json:"collection,omitempty"`
+	BookTitle    string        `json:"bookTitle,omitempty"`   // The book's full title
+	BookHeading  string        `json:"bookHeading,omitempty"` // The book's own heading
+	ChapterCount int           `json:"chapterCount"`
+	Chapters     []BookChapter `json:"chapters"`              // This page of chapters
+	NextChapter  int           `json:"nextChapter,omitempty"` // start_chapter of the next page, if any
+}
+
+// BookInfoResult is the structured result of get_book_info
+type BookInfoResult struct {
+	Book               string        `json:"book"`
+	Collection         string        `json:"collection,omitempty"`
+	CollectionPosition int           `json:"collectionPosition,omitempty"` // 1-based position of the book in its collection
+	CollectionBooks    int           `json:"collectionBooks,omitempty"`
+	CanonicalPosition  int           `json:"canonicalPosition"` // 1-based position of the book among all loaded books
+	CanonicalBooks     int           `json:"canonicalBooks"`
+	ChapterCount       int           `json:"chapterCount"`
+	VerseCount         int           `json:"verseCount"`
+	WordCount          int           `json:"wordCount"`
+	Chapters           []ChapterInfo `json:"chapters"` // Every chapter, or only the one asked for
+}
+
+// ChapterSummaryResult is the structured result of get_chapter_summary
+type ChapterSummaryResult struct {
+	Book        string `json:"book"`
+	Chapter     int    `json:"chapter"`
+	BookTitle   string `json:"bookTitle,omitempty"`   // The book's full title, with its first chapter
+	BookHeading string `json:"bookHeading,omitempty"` // The book's own heading, with its first chapter
+	Heading     string `json:"heading,omitempty"`     // The chapter heading (summary), when the data has one
+}
+
+// OutlineResult is the structured result of outline_chapter
+type OutlineResult struct {
+	Book     string           `json:"book"`
+	Chapter  int              `json:"chapter"`
+	Method   string           `json:"method,omitempty"` // paragraph or heuristic
+	Sections []ChapterSection `json:"sections"`
+}
+
+// ParallelResult is the structured result of get_parallel_passages
+type ParallelResult struct {
+	Reference string         `json:"reference"`
+	Pairs     []ParallelPair `json:"pairs"`
+}
+
+// CrossReferencesResult is the structured result of get_cross_references
+type CrossReferencesResult struct {
+	Reference       string           `json:"reference"`
+	CrossReferences []CrossReference `json:"crossReferences"`
+}
+
+// DictionaryResult is the structured result of bible_dictionary
+type DictionaryResult struct {
+	Query       string                `json:"query"`
+	Entry       *DictionaryEntry      `json:"entry,omitempty"`
+	Match       string                `json:"match,omitempty"` // exact or fuzzy
+	References  []DictionaryReference `json:"references,omitempty"`
+	Suggestions []string              `json:"suggestions,omitempty"` // Other entries close to the query
+}
+
+// ThemeResult is the structured result of search_by_theme
+type ThemeResult struct {
+	Theme    string         `json:"theme"`
+	Scope    string         `json:"scope,omitempty"`
+	Passages []ThemePassage `json:"passages"`
+}
+
+// ReadingLevelResult is the structured result of passages_for_reading_level
+type ReadingLevelResult struct {
+	Topic     string           `json:"topic"`
+	Level     string           `json:"level"`
+	MaxScore  float64          `json:"maxScore"` // Hardest readability score within the level
+	MaxVerses int              `json:"maxVerses"`
+	Scope     string           `json:"scope,omitempty"`
+	Passages  []LeveledPassage `json:"passages"` // Within the level first, easiest first
+}
+
+// TopicVersesResult is the structured result of find_topic_verses
+type TopicVersesResult struct {
+	Topic    string         `json:"topic"`
+	Theme    string         `json:"theme,omitempty"` // Curated theme the topic names, if any
+	Scope    string         `json:"scope,omitempty"`
+	Total    int            `json:"total"`    // Passages found before the limit
+	Passages []TopicPassage `json:"passages"` // Most agreed first
+}
+
+// DigestResult is the structured result of daily_digest
+type DigestResult struct {
+	Date          string         `json:"date"`
+	VerseOfTheDay *VerseOfTheDay `json:"verseOfTheDay,omitempty"`
+	Study         *StudyWeek     `json:"study,omitempty"` // The week of the study schedule covering the date
+}
+
+// VerseOfTheDayResult is the structured result of verse_of_the_day
+type VerseOfTheDayResult struct {
+	Date          string         `json:"date"`
+	Collection    string         `json:"collection,omitempty"`
+	Topics        []string       `json:"topics,omitempty"`
+	VerseOfTheDay *VerseOfTheDay `json:"verseOfTheDay,omitempty"` // Empty when no passage matches the filters
+}
+
+// StyleComparisonResult is the structured result of compare_style
+type StyleComparisonResult struct {
+	A           StyleFingerprint  `json:"a"`
+	B           StyleFingerprint  `json:"b"`
+	Delta       float64           `json:"delta"`       // Burrows' Delta over the function words
+	Differences []StyleDifference `json:"differences"` // Most distinctive function words first
+}
+
+// CitationLintResult is the structured result of lint_citations
+type CitationLintResult struct {
+	Quotations int              `json:"quotations"` // Number of quotations found
+	Verified   int              `json:"verified"`
+	Problems   int              `json:"problems"`
+	Checks     []QuotationCheck `json:"checks"`
+}
+
+// ChapterQuestionsResult is the structured result of get_chapter_questions
+type ChapterQuestionsResult struct {
+	Chapter   string            `json:"chapter"`
+	Questions []ChapterQuestion `json:"questions"` // Discussion questions first, then fill-in questions
+}
+
+// ChapterFinderResult is the structured result of find_chapter_by_opening
+type ChapterFinderResult struct {
+	Query    string         `json:"query"`
+	Scope    string         `json:"scope,omitempty"`
+	Chapters []ChapterMatch `json:"chapters"` // Opening matches first
+}
+
+// TriviaResult is the structured result of trivia
+type TriviaResult struct {
+	Scope      string           `json:"scope,omitempty"`
+	Difficulty string           `json:"difficulty"`
+	Seed       int64            `json:"seed"` // Pass back to get the same questions
+	Questions  []TriviaQuestion `json:"questions"`
+}
+
+// ActivityBoardResult is the structured result of activity_board
+type ActivityBoardResult struct {
+	Scope  string          `json:"scope"`
+	Kind   string          `json:"kind"` // phrases or references
+	Size   int             `json:"size"` // Squares per side
+	Seed   int64           `json:"seed"` // Pass back to get the same boards
+	Boards []ActivityBoard `json:"boards"`
+	Calls  []BoardSquare   `json:"calls"` // Every item on the boards once, in the order to call them
+}
+
+// ParallelTextResult is the structured result of parallel_text
+type ParallelTextResult struct {
+	Reference string          `json:"reference"` // In the book names of the edition the query named
+	Languages []string        `json:"languages"`
+	Layout    string          `json:"layout"`
+	Verses    []ParallelVerse `json:"verses"`
+}
+
+// TranslationComparisonResult is the structured result of compare_translations
+type TranslationComparisonResult struct {
+	Reference    string             `json:"reference"`
+	Translations []string           `json:"translations"` // Compared translations, the default first
+	Verses       []TranslationVerse `json:"verses"`
+}
+
+// StoriesResult is the structured result of get_scripture_story
+type StoriesResult struct {
+	Book    string           `json:"book"`
+	Chapter int              `json:"chapter"`
+	Stories []ScriptureStory `json:"stories"`
+}
+
+// SimilarVersesResult is the structured result of find_similar_verses
+type SimilarVersesResult struct {
+	Reference string         `json:"reference"`
+	Scope     string         `json:"scope,omitempty"`
+	Verses    []SimilarVerse `json:"verses"` // Most similar first
+}
+
+// DuplicatesResult is the structured result of find_duplicate_verses: one
+// page of clusters
+type DuplicatesResult struct {
+	MinSimilarity float64            `json:"minSimilarity"`
+	Offset        int                `json:"offset"`
+	Total         int                `json:"total"` // Number of clusters across all pages
+	Clusters      []DuplicateCluster `json:"clusters"`
+}
+
+// TermCountsResult is the structured result of count_terms
+type TermCountsResult struct {
+	Scope   string      `json:"scope,omitempty"`
+	Verses  int         `json:"verses"` // Number of verses counted
+	Counts  []TermCount `json:"counts"`
+	Skipped []string    `json:"skipped,omitempty"` // Common words dropped with skip_common
+}
+
+// AnkiDeckResult is the structured result of export_anki_deck
+type AnkiDeckResult struct {
+	Cards int    `json:"cards"`
+	Deck  string `json:"deck"` // Tab-separated deck, ready to import
+}
+
+// CapabilityUnavailableResult is the structured result of a tool called
+// without the optional dataset it needs
+type CapabilityUnavailableResult struct {
+	Dataset string `json:"dataset"` // e.g. "bible_dictionary"
+	Hint    string `json:"hint"`    // How to add the dataset
+}
+
+// StatusResult is the structured result of server_status
+type StatusResult struct {
+	Books       int                `json:"books"`
+	Verses      int                `json:"verses"`
+	Integrity   *IntegrityStatus   `json:"integrity,omitempty"`
+	Collections []CollectionStatus `json:"collections"`
+}
+
+// ReloadResult is the structured result of reload_data
+type ReloadResult struct {
+	Books          int              `json:"books"`
+	Verses         int              `json:"verses"`
+	PreviousBooks  int              `json:"previousBooks"`
+	PreviousVerses int              `json:"previousVerses"`
+	Languages      []string         `json:"languages"`
+	Translations   []string         `json:"translations"`
+	Integrity      *IntegrityStatus `json:"integrity,omitempty"`
+}
+
+// SearchHelpResult is the structured result of search_help
+type SearchHelpResult struct {
+	Syntax []SearchSyntax `json:"syntax"`
+}
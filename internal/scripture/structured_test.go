@@ -0,0 +1,228 @@
+package scripture
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestService_StructuredContent(t *testing.T) {
+	service := NewService()
+
+	tests := []struct {
+		name      string
+		handler   func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		arguments map[string]interface{}
+		expected  []string // Fragments of the JSON encoding of the structured content
+	}{
+		{
+			name:      "search_scriptures",
+			handler:   service.SearchScriptures,
+			arguments: map[string]interface{}{"query": "faith is not to have a perfect knowledge", "highlight": true},
+			expected:  []string{`"reference":"Alma 32:21"`, `"chapter":32`, `"total":1`, `"highlighted":"And now as I said concerning faith—**faith is not to have a perfect knowledge** of things`},
+		},
+		{
+			name:      "search_scriptures without matches",
+			handler:   service.SearchScriptures,
+			arguments: map[string]interface{}{"query": "xyzzy"},
+			expected:  []string{`"verses":[]`, `"total":0`},
+		},
+		{
+			name:      "search_all",
+			handler:   service.SearchAll,
+			arguments: map[string]interface{}{"query": "charity", "limit": float64(1)},
+			expected:  []string{`"collection":"New Testament"`, `"collection":"Book of Mormon"`, `"more":`},
+		},
+		{
+			name:      "get_scripture",
+			handler:   service.GetScripture,
+			arguments: map[string]interface{}{"query": "John 3:16-17"},
+			expected:  []string{`"reference":"John 3:16"`, `"reference":"John 3:17"`},
+		},
+		{
+			name:      "get_scripture in speech format",
+			handler:   service.GetScripture,
+			arguments: map[string]interface{}{"query": "1 Nephi 3:7", "format": "speech"},
+			expected:  []string{`"book":"1 Nephi"`, `"verse":7`},
+		},
+		{
+			name:      "get_chapter",
+			handler:   service.GetChapter,
+			arguments: map[string]interface{}{"query": "Enos 1"},
+			expected:  []string{`"book":"Enos"`, `"reference":"Enos 1:27"`, `"navigation":{`},
+		},
+		{
+			name:      "get_book",
+			handler:   service.GetBook,
+			arguments: map[string]interface{}{"book": "Jude"},
+			expected:  []string{`"book":"Jude"`, `"chapterCount":1`, `"chapters":[{"chapter":1`, `"reference":"Jude 1:25"`},
+		},
+		{
+			name:      "get_book_info",
+			handler:   service.GetBookInfo,
+			arguments: map[string]interface{}{"book": "Enos"},
+			expected:  []string{`"collection":"Book of Mormon"`, `"collectionPosition":4`, `"chapterCount":1`, `"chapters":[{"chapter":1,"verses":27,"words":`},
+		},
+		{
+			name:      "get_chapter_summary",
+			handler:   service.GetChapterSummary,
+			arguments: map[string]interface{}{"query": "Alma 5"},
+			expected:  []string{`"book":"Alma"`, `"chapter":5`, `"heading":"The words which Alma`},
+		},
+		{
+			name:      "get_cross_references",
+			handler:   service.GetCrossReferences,
+			arguments: map[string]interface{}{"query": "2 Nephi 12:2"},
+			expected:  []string{`"crossReferences":[{"verse":"2 Nephi 12:2","kind":"parallel"`, `"reference":"Isaiah 2:2"`},
+		},
+		{
+			name:      "find_similar_verses",
+			handler:   service.FindSimilarVerses,
+			arguments: map[string]interface{}{"query": "Isaiah 2:2", "limit": float64(1)},
+			expected:  []string{`"reference":"Isaiah 2:2"`, `"reference":"2 Nephi 12:2"`, `"sharedTerms":[`},
+		},
+		{
+			name:      "find_chapter_by_opening",
+			handler:   service.FindChapterByOpening,
+			arguments: map[string]interface{}{"query": "The LORD is my shepherd", "limit": float64(1)},
+			expected:  []string{`"chapter":"Psalms 23"`, `"match":"opening"`, `"score":1`},
+		},
+		{
+			name:      "get_chapter_questions",
+			handler:   service.GetChapterQuestions,
+			arguments: map[string]interface{}{"query": "Alma 32", "fill_in": float64(1)},
+			expected:  []string{`"chapter":"Alma 32"`, `"kind":"fill_in"`, `"answer":`},
+		},
+		{
+			name:      "verse_of_the_day",
+			handler:   service.GetVerseOfTheDay,
+			arguments: map[string]interface{}{"date": "2026-10-14", "collection": "Book of Mormon"},
+			expected:  []string{`"date":"2026-10-14"`, `"collection":"Book of Mormon"`, `"verseOfTheDay":{"theme":`},
+		},
+		{
+			name:      "trivia",
+			handler:   service.Trivia,
+			arguments: map[string]interface{}{"scope": "John 3", "kinds": "which_book", "count": float64(1), "seed": float64(1)},
+			expected:  []string{`"seed":1`, `"kind":"which_book"`, `"answer":"John"`, `"answerIndex":`},
+		},
+		{
+			name:      "activity_board",
+			handler:   service.ActivityBoard,
+			arguments: map[string]interface{}{"scope": "Alma 32", "kind": "references", "size": float64(3), "seed": float64(1)},
+			expected:  []string{`"seed":1`, `"kind":"references"`, `"free":true`, `"clue":`},
+		},
+		{
+			name:      "passages_for_reading_level",
+			handler:   service.PassagesForReadingLevel,
+			arguments: map[string]interface{}{"topic": "prayer", "limit": float64(1)},
+			expected:  []string{`"level":"easy"`, `"reference":"1 Thessalonians 5:17"`, `"sources":["theme"`, `"readability":{"words":3`},
+		},
+		{
+			name:      "find_topic_verses",
+			handler:   service.FindTopicVerses,
+			arguments: map[string]interface{}{"topic": "hope", "scope": "Ether", "limit": float64(1)},
+			expected:  []string{`"theme":"hope"`, `"reference":"Ether 12:4"`, `"sources":["theme","search"]`, `"total":`},
+		},
+		{
+			name:      "search_by_theme",
+			handler:   service.SearchByTheme,
+			arguments: map[string]interface{}{"theme": "hope", "scope": "Isaiah"},
+			expected:  []string{`"theme":"hope"`, `"reference":"Isaiah 40:31"`, `"keyword":false`},
+		},
+		{
+			name:      "compare_style",
+			handler:   service.CompareStyle,
+			arguments: map[string]interface{}{"scope_a": "Alma", "scope_b": "Helaman"},
+			expected:  []string{`"a":{"scope":"Alma"`, `"range":"11-20"`, `"delta":`, `"differences":[{"word":`},
+		},
+		{
+			name:      "lint_citations",
+			handler:   service.LintCitations,
+			arguments: map[string]interface{}{"text": `"For God so loved the world that he gave his only Son" (John 3:16)`},
+			expected:  []string{`"quotations":1`, `"status":"misquoted"`, `{"op":"-","text":"begotten"}`},
+		},
+		{
+			name:      "outline_chapter",
+			handler:   service.OutlineChapter,
+			arguments: map[string]interface{}{"query": "Alma 5"},
+			expected:  []string{`"sections":[{`, `"method":"heuristic"`},
+		},
+		{
+			name:      "get_parallel_passages",
+			handler:   service.GetParallelPassages,
+			arguments: map[string]interface{}{"query": "2 Nephi 12:2"},
+			expected:  []string{`"sourceReference":"Isaiah 2:2"`},
+		},
+		{
+			name:      "reference_math",
+			handler:   service.ReferenceMath,
+			arguments: map[string]interface{}{"operation": "contains", "a": "John 3", "b": "John 3:16"},
+			expected:  []string{`"contains":true`},
+		},
+		{
+			name:      "count_terms",
+			handler:   service.CountTerms,
+			arguments: map[string]interface{}{"terms": "charity", "scope": "Moroni 7"},
+			expected:  []string{`"scope":"Moroni 7"`, `"term":"charity"`},
+		},
+		{
+			name:      "export_anki_deck",
+			handler:   service.ExportAnkiDeck,
+			arguments: map[string]interface{}{"references": "John 3:16; Moroni 10:4-5"},
+			expected:  []string{`"cards":2`, `"deck":"#separator:tab`},
+		},
+		{
+			name:      "server_status",
+			handler:   service.ServerStatus,
+			arguments: map[string]interface{}{},
+			expected:  []string{`"collections":[{`, `"name":"Book of Mormon"`},
+		},
+		{
+			name:      "reload_data",
+			handler:   service.ReloadData,
+			arguments: map[string]interface{}{},
+			expected:  []string{`"previousVerses":`, `"languages":["en"]`},
+		},
+		{
+			name:      "search_help",
+			handler:   service.SearchHelp,
+			arguments: map[string]interface{}{},
+			expected:  []string{`"syntax":[{`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+			result, err := tt.handler(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.IsError {
+				t.Fatalf("Unexpected tool error: %v", result.Content)
+			}
+			if len(result.Content) == 0 || result.Content[0].(mcp.TextContent).Text == "" {
+				t.Error("Expected the text rendering to be kept")
+			}
+			if result.StructuredContent == nil {
+				t.Fatal("Expected structured content")
+			}
+			encoded, err := json.Marshal(result.StructuredContent)
+			if err != nil {
+				t.Fatalf("Unexpected error encoding structured content: %v", err)
+			}
+			for _, fragment := range tt.expected {
+				if !strings.Contains(string(encoded), fragment) {
+					t.Errorf("Expected %s in structured content, got %s", fragment, encoded)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,317 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// minStyleWords is the sample size, in words, below which a fingerprint is
+// too small to compare reliably
+const minStyleWords = 2000
+
+// maxStyleDifferences is the number of most distinctive function words reported
+const maxStyleDifferences = 10
+
+// functionWords are the frequent, topic-independent words whose rates make
+// up a stylometric fingerprint, including the connectives typical of the
+// King James style
+var functionWords = []string{
+	"the", "and", "of", "to", "that", "in", "he", "shall", "unto", "for",
+	"i", "his", "a", "they", "be", "is", "him", "not", "them", "it",
+	"with", "all", "thou", "thy", "was", "which", "my", "me", "but", "ye",
+	"this", "as", "are", "by", "were", "from", "upon", "or", "their", "behold",
+	"yea", "therefore", "wherefore", "now", "if", "when", "then", "also", "even", "because",
+}
+
+// sentenceLengthRanges are the upper bounds, in words, of the sentence
+// length distribution's ranges; the last range is open
+var sentenceLengthRanges = []int{10, 20, 30, 40, 50}
+
+// StyleFingerprint is the stylometric profile of a scope
+type StyleFingerprint struct {
+	Scope                string         `json:"scope"`
+	Verses               int            `json:"verses"`
+	Words                int            `json:"words"`
+	Sentences            int            `json:"sentences"`
+	MeanSentenceLength   float64        `json:"meanSentenceLength"`
+	MedianSentenceLength float64        `json:"medianSentenceLength"`
+	SentenceLengthStdDev float64        `json:"sentenceLengthStdDev"`
+	SentenceLengths      []LengthBucket `json:"sentenceLengths"` // Share of sentences in each length range
+	FunctionWords        []WordRate     `json:"functionWords"`
+}
+
+// LengthBucket is the share of sentences whose length falls in a range of words
+type LengthBucket struct {
+	Range string  `json:"range"` // e.g. "11-20"
+	Share float64 `json:"share"` // Between 0 and 1
+}
+
+// WordRate is how often a word occurs, per 1,000 words
+type WordRate struct {
+	Word        string  `json:"word"`
+	PerThousand float64 `json:"perThousand"`
+}
+
+// StyleDifference is a function word whose rate differs between two scopes,
+// measured in standard deviations of its rate across the books of the corpus
+type StyleDifference struct {
+	Word       string  `json:"word"`
+	RateA      float64 `json:"rateA"` // Per 1,000 words
+	RateB      float64 `json:"rateB"`
+	Difference float64 `json:"difference"` // z-score of A minus z-score of B
+}
+
+// styleProfile holds the counts behind a fingerprint
+type styleProfile struct {
+	verses    int
+	words     int
+	counts    map[string]int
+	sentences []int // Length of each sentence in words
+}
+
+// wordStats is the mean and standard deviation of a word's rate per 1,000
+// words across the books of the corpus
+type wordStats struct {
+	mean   float64
+	stdDev float64
+}
+
+// CompareStyle computes the stylometric fingerprints of two scopes and compares them
+func (s *Service) CompareStyle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	var profiles [2]styleProfile
+	var scopes [2]string
+	for i, name := range []string{"scope_a", "scope_b"} {
+		scopes[i], _ = arguments[name].(string)
+		verses, err := s.scopeVerses(scopes[i])
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("%s: %v", name, err)), nil
+		}
+		profiles[i] = profileStyle(s.tokenizer(), verses)
+	}
+
+	baseline := s.styleBaseline()
+	a, b := profiles[0].fingerprint(scopes[0]), profiles[1].fingerprint(scopes[1])
+	delta, differences := compareStyles(profiles[0], profiles[1], baseline)
+	structured := StyleComparisonResult{A: a, B: b, Delta: delta, Differences: differences}
+
+	response := fmt.Sprintf("Style comparison: %s vs %s\n\n", a.Scope, b.Scope)
+	row := func(label string, valueA, valueB any) {
+		response += fmt.Sprintf("%-24s %12v %12v\n", label, valueA, valueB)
+	}
+	row("", "A", "B")
+	row("Verses", a.Verses, b.Verses)
+	row("Words", a.Words, b.Words)
+	row("Sentences", a.Sentences, b.Sentences)
+	row("Mean sentence length", a.MeanSentenceLength, b.MeanSentenceLength)
+	row("Median sentence length", a.MedianSentenceLength, b.MedianSentenceLength)
+	row("Sentence length std dev", a.SentenceLengthStdDev, b.SentenceLengthStdDev)
+	for i := range a.SentenceLengths {
+		row("Sentences of "+a.SentenceLengths[i].Range+" words", fmt.Sprintf("%.0f%%", 100*a.SentenceLengths[i].Share), fmt.Sprintf("%.0f%%", 100*b.SentenceLengths[i].Share))
+	}
+
+	response += fmt.Sprintf("\nBurrows' Delta: %.2f over %d function words (0 for identical rates; lower is more similar, so compare with pairs of known authorship for scale)\n", delta, len(functionWords))
+	if len(differences) > 0 {
+		response += "\nMost distinctive function words (per 1,000 words):\n"
+		for _, difference := range differences {
+			response += fmt.Sprintf("- %s: %.1f vs %.1f (%+.1f standard deviations)\n", difference.Word, difference.RateA, difference.RateB, difference.Difference)
+		}
+	}
+	for _, fingerprint := range []StyleFingerprint{a, b} {
+		if fingerprint.Words < minStyleWords {
+			response += fmt.Sprintf("\nNote: %s has only %d words; fingerprints under %d words are unreliable.\n", fingerprint.Scope, fingerprint.Words, minStyleWords)
+		}
+	}
+	return mcp.NewToolResultStructured(structured, response), nil
+}
+
+// scopeVerses returns the verses of a semicolon-separated list of book,
+// chapter or verse range selectors, in canonical order
+func (s *Service) scopeVerses(scope string) ([]Scripture, error) {
+	var refs []*ScriptureReference
+	for _, selector := range strings.Split(scope, ";") {
+		if strings.TrimSpace(selector) == "" {
+			continue
+		}
+		ref, err := s.parseSelector(selector)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, ref)
+	}
+	if len(refs) == 0 {
+		return nil, fmt.Errorf("scope cannot be empty")
+	}
+
+	var verses []Scripture
+	for _, verse := range s.flatVerses() {
+		if selectorMatches(refs, verse) {
+			verses = append(verses, verse)
+		}
+	}
+	if len(verses) == 0 {
+		return nil, fmt.Errorf("no verses found in '%s'", scope)
+	}
+	return verses, nil
+}
+
+// profileStyle counts the words, function words and sentence lengths of
+// verses. Sentences run across verses, as they often do in the Book of
+// Mormon, but not across chapters.
+func profileStyle(tokenizer Tokenizer, verses []Scripture) styleProfile {
+	profile := styleProfile{verses: len(verses), counts: make(map[string]int)}
+	sentence := 0
+	endSentence := func() {
+		if sentence > 0 {
+			profile.sentences = append(profile.sentences, sentence)
+			sentence = 0
+		}
+	}
+	for i, verse := range verses {
+		if i > 0 && (verse.Book != verses[i-1].Book || verse.Chapter != verses[i-1].Chapter) {
+			endSentence()
+		}
+		for _, field := range strings.Fields(verse.Text) {
+			words := letterWords(tokenizer, field)
+			if len(words) == 0 {
+				continue
+			}
+			for _, word := range words {
+				profile.words++
+				profile.counts[word]++
+				sentence++
+			}
+			if strings.ContainsAny(field, ".?!") {
+				endSentence()
+			}
+		}
+	}
+	endSentence()
+	return profile
+}
+
+// rate returns how often a word occurs per 1,000 words
+func (p styleProfile) rate(word string) float64 {
+	if p.words == 0 {
+		return 0
+	}
+	return 1000 * float64(p.counts[word]) / float64(p.words)
+}
+
+// fingerprint summarizes a profile
+func (p styleProfile) fingerprint(scope string) StyleFingerprint {
+	fingerprint := StyleFingerprint{Scope: scope, Verses: p.verses, Words: p.words, Sentences: len(p.sentences)}
+	for _, word := range functionWords {
+		fingerprint.FunctionWords = append(fingerprint.FunctionWords, WordRate{Word: word, PerThousand: round2(p.rate(word))})
+	}
+
+	lower := 1
+	for i := 0; i <= len(sentenceLengthRanges); i++ {
+		bucket := LengthBucket{Range: fmt.Sprintf("%d+", lower)}
+		upper := math.MaxInt
+		if i < len(sentenceLengthRanges) {
+			upper = sentenceLengthRanges[i]
+			bucket.Range = fmt.Sprintf("%d-%d", lower, upper)
+		}
+		count := 0
+		for _, length := range p.sentences {
+			if length >= lower && length <= upper {
+				count++
+			}
+		}
+		if len(p.sentences) > 0 {
+			bucket.Share = round2(float64(count) / float64(len(p.sentences)))
+		}
+		fingerprint.SentenceLengths = append(fingerprint.SentenceLengths, bucket)
+		lower = upper + 1
+	}
+
+	if len(p.sentences) == 0 {
+		return fingerprint
+	}
+	lengths := append([]int{}, p.sentences...)
+	sort.Ints(lengths)
+	total := 0
+	for _, length := range lengths {
+		total += length
+	}
+	mean := float64(total) / float64(len(lengths))
+	variance := 0.0
+	for _, length := range lengths {
+		variance += (float64(length) - mean) * (float64(length) - mean)
+	}
+	median := float64(lengths[len(lengths)/2])
+	if len(lengths)%2 == 0 {
+		median = float64(lengths[len(lengths)/2-1]+lengths[len(lengths)/2]) / 2
+	}
+	fingerprint.MeanSentenceLength = round1(mean)
+	fingerprint.MedianSentenceLength = round1(median)
+	fingerprint.SentenceLengthStdDev = round1(math.Sqrt(variance / float64(len(lengths))))
+	return fingerprint
+}
+
+// styleBaseline returns the mean and spread of each function word's rate
+// across the books of at least minStyleWords words, computed once
+func (s *Service) styleBaseline() map[string]wordStats {
+	s.styleOnce.Do(func() {
+		var profiles []styleProfile
+		verses := s.flatVerses()
+		for start := 0; start < len(verses); {
+			end := start + 1
+			for end < len(verses) && verses[end].Book == verses[start].Book {
+				end++
+			}
+			if profile := profileStyle(s.tokenizer(), verses[start:end]); profile.words >= minStyleWords {
+				profiles = append(profiles, profile)
+			}
+			start = end
+		}
+
+		s.styleStats = make(map[string]wordStats, len(functionWords))
+		for _, word := range functionWords {
+			var stats wordStats
+			for _, profile := range profiles {
+				stats.mean += profile.rate(word) / float64(len(profiles))
+			}
+			for _, profile := range profiles {
+				stats.stdDev += (profile.rate(word) - stats.mean) * (profile.rate(word) - stats.mean) / float64(len(profiles))
+			}
+			stats.stdDev = math.Sqrt(stats.stdDev)
+			s.styleStats[word] = stats
+		}
+	})
+	return s.styleStats
+}
+
+// compareStyles computes Burrows' Delta between two profiles, the mean
+// absolute difference of their function word z-scores, along with the
+// words that differ most
+func compareStyles(a, b styleProfile, baseline map[string]wordStats) (float64, []StyleDifference) {
+	var differences []StyleDifference
+	total := 0.0
+	for _, word := range functionWords {
+		stats := baseline[word]
+		if stats.stdDev == 0 {
+			continue
+		}
+		rateA, rateB := a.rate(word), b.rate(word)
+		difference := (rateA - rateB) / stats.stdDev // The means cancel
+		total += math.Abs(difference)
+		differences = append(differences, StyleDifference{Word: word, RateA: round2(rateA), RateB: round2(rateB), Difference: round2(difference)})
+	}
+	if len(differences) == 0 {
+		return 0, nil
+	}
+
+	delta := round2(total / float64(len(differences)))
+	sort.SliceStable(differences, func(i, j int) bool {
+		return math.Abs(differences[i].Difference) > math.Abs(differences[j].Difference)
+	})
+	return delta, differences[:min(len(differences), maxStyleDifferences)]
+}
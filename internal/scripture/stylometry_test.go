@@ -0,0 +1,89 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestProfileStyle(t *testing.T) {
+	verses := []Scripture{
+		{Book: "Enos", Chapter: 1, Verse: 1, Text: "Behold, it came to pass that I, Enos, knowing my father"},
+		{Book: "Enos", Chapter: 1, Verse: 2, Text: "that he was a just man. And I will tell you!"},
+		{Book: "Enos", Chapter: 2, Verse: 1, Text: "And the wrestle — which I had"},
+	}
+
+	profile := profileStyle(archaicEnglishTokenizer{}, verses)
+	// A sentence runs across verses but stops at the end of a chapter
+	if fmt.Sprint(profile.sentences) != "[17 5 6]" {
+		t.Errorf("Expected sentences of 17, 5 and 6 words, got %v", profile.sentences)
+	}
+	if profile.words != 28 || profile.counts["i"] != 3 || profile.counts["and"] != 2 {
+		t.Errorf("Unexpected counts: %d words, %v", profile.words, profile.counts)
+	}
+
+	fingerprint := profile.fingerprint("Enos")
+	if fingerprint.MeanSentenceLength != 9.3 || fingerprint.MedianSentenceLength != 6 {
+		t.Errorf("Expected mean 9.3 and median 6, got %+v", fingerprint)
+	}
+	if bucket := fingerprint.SentenceLengths[0]; bucket.Range != "1-10" || bucket.Share != 0.67 {
+		t.Errorf("Expected two thirds of sentences in 1-10, got %+v", bucket)
+	}
+	if last := fingerprint.SentenceLengths[len(fingerprint.SentenceLengths)-1]; last.Range != "51+" || last.Share != 0 {
+		t.Errorf("Expected an empty open range last, got %+v", last)
+	}
+	if len(fingerprint.FunctionWords) != len(functionWords) {
+		t.Errorf("Expected a rate for each function word, got %d", len(fingerprint.FunctionWords))
+	}
+}
+
+func TestService_CompareStyle(t *testing.T) {
+	service := NewService()
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]any{"scope_a": "Alma", "scope_b": "Alma"}
+	result, err := service.CompareStyle(context.Background(), request)
+	if err != nil || result.IsError {
+		t.Fatalf("Unexpected error: %v %+v", err, result)
+	}
+	if structured := result.StructuredContent.(StyleComparisonResult); structured.Delta != 0 {
+		t.Errorf("Expected a Delta of 0 between identical scopes, got %v", structured.Delta)
+	}
+
+	// Paul's letters are closer to each other than to Hebrews
+	deltas := make(map[string]float64)
+	for _, pair := range [][2]string{{"Romans", "1 Corinthians; 2 Corinthians"}, {"Romans", "Hebrews"}} {
+		request.Params.Arguments = map[string]any{"scope_a": pair[0], "scope_b": pair[1]}
+		result, _ := service.CompareStyle(context.Background(), request)
+		structured := result.StructuredContent.(StyleComparisonResult)
+		if len(structured.Differences) != maxStyleDifferences {
+			t.Errorf("Expected %d differences, got %d", maxStyleDifferences, len(structured.Differences))
+		}
+		deltas[pair[1]] = structured.Delta
+	}
+	if deltas["1 Corinthians; 2 Corinthians"] >= deltas["Hebrews"] {
+		t.Errorf("Expected Romans closer to Corinthians than to Hebrews, got %v", deltas)
+	}
+
+	// Small samples are flagged
+	request.Params.Arguments = map[string]any{"scope_a": "John 3", "scope_b": "Alma"}
+	result, _ = service.CompareStyle(context.Background(), request)
+	if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "John 3 has only") {
+		t.Errorf("Expected a small sample note, got:\n%s", text)
+	}
+
+	for _, arguments := range []map[string]any{
+		{"scope_a": "Alma"},
+		{"scope_a": "Alma", "scope_b": " ; "},
+		{"scope_a": "Hezekiah", "scope_b": "Alma"},
+		{"scope_a": "Alma 99", "scope_b": "Alma"},
+	} {
+		request.Params.Arguments = arguments
+		if result, _ := service.CompareStyle(context.Background(), request); !result.IsError {
+			t.Errorf("Expected an error for %v", arguments)
+		}
+	}
+}
@@ -0,0 +1,253 @@
+package scripture
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultSyncSource is the upstream location of the scripture JSON files
+const DefaultSyncSource = "https://raw.githubusercontent.com/bcbooks/scriptures-json/HEAD"
+
+// syncHTTPTimeout bounds each upstream download
+const syncHTTPTimeout = 2 * time.Minute
+
+// VerseChange is a verse that was added, changed or removed between two data versions
+type VerseChange struct {
+	Reference string `json:"reference"`
+	OldText   string `json:"oldText,omitempty"`
+	NewText   string `json:"newText,omitempty"`
+}
+
+// CorpusDiff is the verse-level difference between two data versions
+type CorpusDiff struct {
+	Added   []VerseChange `json:"added"`
+	Changed []VerseChange `json:"changed"`
+	Removed []VerseChange `json:"removed"`
+}
+
+// Empty reports whether the two data versions are identical
+func (d *CorpusDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Changed) == 0 && len(d.Removed) == 0
+}
+
+// WriteReport prints a summary line followed by every added, changed and removed verse
+func (d *CorpusDiff) WriteReport(w io.Writer) {
+	fmt.Fprintf(w, "%d added, %d changed, %d removed verses\n", len(d.Added), len(d.Changed), len(d.Removed))
+	if len(d.Added) > 0 {
+		fmt.Fprintf(w, "\nAdded:\n")
+		for _, change := range d.Added {
+			fmt.Fprintf(w, "  + %s: %s\n", change.Reference, change.NewText)
+		}
+	}
+	if len(d.Changed) > 0 {
+		fmt.Fprintf(w, "\nChanged:\n")
+		for _, change := range d.Changed {
+			fmt.Fprintf(w, "  ~ %s: %s\n", change.Reference, renderDiff(wordDiff(change.OldText, change.NewText)))
+		}
+	}
+	if len(d.Removed) > 0 {
+		fmt.Fprintf(w, "\nRemoved:\n")
+		for _, change := range d.Removed {
+			fmt.Fprintf(w, "  - %s: %s\n", change.Reference, change.OldText)
+		}
+	}
+}
+
+// SyncData fetches the scripture JSON files from source (an http(s) URL
+// prefix or a local directory), rebuilds the archive at out and prints a
+// verse-level diff against the archive it replaces.
+func SyncData(source, out string, w io.Writer) error {
+	files := make(map[string][]byte)
+	for _, name := range scriptureJSONFilenames() {
+		fmt.Fprintf(w, "Fetching %s...\n", name)
+		data, err := fetchSourceFile(source, name)
+		if err != nil {
+			return fmt.Errorf("fetch %s: %w", name, err)
+		}
+		files[name] = data
+	}
+
+	archive, err := BuildArchive(files)
+	if err != nil {
+		return err
+	}
+	if _, err := verifyArchive(archive); err != nil {
+		return fmt.Errorf("new archive failed verification: %w", err)
+	}
+
+	previous, err := os.ReadFile(out)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	diff, err := DiffArchives(previous, archive)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(out), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(out, archive, 0644); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\nWrote %s (%d bytes)\n\n", out, len(archive))
+	diff.WriteReport(w)
+	return nil
+}
+
+// DiffData compares two scripture archives on disk, such as the embedded
+// archive and a freshly synced one, and prints their verse-level diff as a
+// report or, with asJSON, as a CorpusDiff document
+func DiffData(oldPath, newPath string, asJSON bool, w io.Writer) error {
+	oldArchive, err := os.ReadFile(oldPath)
+	if err != nil {
+		return err
+	}
+	newArchive, err := os.ReadFile(newPath)
+	if err != nil {
+		return err
+	}
+	diff, err := DiffArchives(oldArchive, newArchive)
+	if err != nil {
+		return err
+	}
+
+	if asJSON {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(diff)
+	}
+	fmt.Fprintf(w, "Comparing %s with %s\n\n", oldPath, newPath)
+	diff.WriteReport(w)
+	return nil
+}
+
+// fetchSourceFile reads one data file from an http(s) URL prefix or a local directory
+func fetchSourceFile(source, name string) ([]byte, error) {
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		return os.ReadFile(filepath.Join(source, name))
+	}
+
+	client := &http.Client{Timeout: syncHTTPTimeout}
+	resp, err := client.Get(strings.TrimRight(source, "/") + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// BuildArchive zips data files and their checksum manifest in name order
+// with no timestamps, so the same input always produces the same archive
+func BuildArchive(files map[string][]byte) ([]byte, error) {
+	manifest, err := json.MarshalIndent(buildManifest(files), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	contents := make(map[string][]byte, len(files)+1)
+	names := make([]string, 0, len(files)+1)
+	for name, data := range files {
+		contents[name] = data
+		names = append(names, name)
+	}
+	contents[manifestName] = manifest
+	names = append(names, manifestName)
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, name := range names {
+		f, err := w.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := f.Write(contents[name]); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DiffArchives compares two scripture archives verse by verse. An empty old
+// archive treats every verse in the new one as added.
+func DiffArchives(oldArchive, newArchive []byte) (*CorpusDiff, error) {
+	oldVerses, err := archiveVerses(oldArchive)
+	if err != nil {
+		return nil, fmt.Errorf("old archive: %w", err)
+	}
+	newVerses, err := archiveVerses(newArchive)
+	if err != nil {
+		return nil, fmt.Errorf("new archive: %w", err)
+	}
+	return diffVerses(oldVerses, newVerses), nil
+}
+
+// archiveVerses loads an archive into a fresh service and returns its verses in reading order
+func archiveVerses(archive []byte) ([]Scripture, error) {
+	if len(archive) == 0 {
+		return nil, nil
+	}
+	service := &Service{
+		scriptures:      make(map[string][]Scripture),
+		bookCollections: make(map[string]string),
+	}
+	if err := service.loadFromZipBytes(archive, "archive"); err != nil {
+		return nil, err
+	}
+
+	var verses []Scripture
+	for _, book := range service.orderedBooks() {
+		verses = append(verses, service.scriptures[book]...)
+	}
+	return verses, nil
+}
+
+// diffVerses reports verses added, changed and removed between two verse lists
+func diffVerses(oldVerses, newVerses []Scripture) *CorpusDiff {
+	key := func(scripture Scripture) string {
+		return fmt.Sprintf("%s %d:%d", scripture.Book, scripture.Chapter, scripture.Verse)
+	}
+
+	oldByKey := make(map[string]Scripture, len(oldVerses))
+	for _, verse := range oldVerses {
+		oldByKey[key(verse)] = verse
+	}
+	newByKey := make(map[string]bool, len(newVerses))
+
+	diff := &CorpusDiff{}
+	for _, verse := range newVerses {
+		k := key(verse)
+		newByKey[k] = true
+		previous, existed := oldByKey[k]
+		switch {
+		case !existed:
+			diff.Added = append(diff.Added, VerseChange{Reference: k, NewText: verse.Text})
+		case previous.Text != verse.Text:
+			diff.Changed = append(diff.Changed, VerseChange{Reference: k, OldText: previous.Text, NewText: verse.Text})
+		}
+	}
+	for _, verse := range oldVerses {
+		if k := key(verse); !newByKey[k] {
+			diff.Removed = append(diff.Removed, VerseChange{Reference: k, OldText: verse.Text})
+		}
+	}
+	return diff
+}
@@ -0,0 +1,180 @@
+package scripture
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeSourceDir writes every expected data file to a temporary directory,
+// using data for the Book of Mormon and an empty document for the rest
+func writeSourceDir(t *testing.T, data ScriptureData) string {
+	dir := t.TempDir()
+	for _, name := range scriptureJSONFilenames() {
+		contents := []byte(`{"books": []}`)
+		if name == "book-of-mormon.json" {
+			var err error
+			if contents, err = json.Marshal(data); err != nil {
+				t.Fatalf("Failed to marshal test data: %v", err)
+			}
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), contents, 0644); err != nil {
+			t.Fatalf("Failed to write source file: %v", err)
+		}
+	}
+	return dir
+}
+
+func TestBuildArchive_Deterministic(t *testing.T) {
+	files := map[string][]byte{
+		"old-testament.json":  []byte(`{"books": []}`),
+		"book-of-mormon.json": []byte(`{"books": []}`),
+	}
+
+	first, err := BuildArchive(files)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	second, err := BuildArchive(files)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !bytes.Equal(first, second) {
+		t.Error("Expected identical archives for identical input")
+	}
+	if count, err := verifyArchive(first); err != nil || count != 2 {
+		t.Errorf("Expected a valid archive with 2 files, got %d (%v)", count, err)
+	}
+}
+
+func TestDiffVerses(t *testing.T) {
+	oldVerses := []Scripture{
+		{Book: "John", Chapter: 3, Verse: 16, Text: "For God so loved the world"},
+		{Book: "John", Chapter: 3, Verse: 17, Text: "For God sent not his Son"},
+	}
+	newVerses := []Scripture{
+		{Book: "John", Chapter: 3, Verse: 16, Text: "For God so loved the whole world"},
+		{Book: "John", Chapter: 3, Verse: 18, Text: "He that believeth on him is not condemned"},
+	}
+
+	diff := diffVerses(oldVerses, newVerses)
+	if len(diff.Added) != 1 || diff.Added[0].Reference != "John 3:18" {
+		t.Errorf("Expected John 3:18 added, got %+v", diff.Added)
+	}
+	if len(diff.Changed) != 1 || diff.Changed[0].Reference != "John 3:16" {
+		t.Errorf("Expected John 3:16 changed, got %+v", diff.Changed)
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0].Reference != "John 3:17" {
+		t.Errorf("Expected John 3:17 removed, got %+v", diff.Removed)
+	}
+
+	var report bytes.Buffer
+	diff.WriteReport(&report)
+	for _, want := range []string{"1 added, 1 changed, 1 removed verses", "~ John 3:16: For God so loved the {+whole+} world", "- John 3:17"} {
+		if !strings.Contains(report.String(), want) {
+			t.Errorf("Expected report to contain %q, got:\n%s", want, report.String())
+		}
+	}
+
+	if !diffVerses(oldVerses, oldVerses).Empty() {
+		t.Error("Expected no differences for identical verses")
+	}
+}
+
+func TestSyncData(t *testing.T) {
+	source := writeSourceDir(t, testScriptureData)
+	out := filepath.Join(t.TempDir(), "data", "scriptures.zip")
+
+	// First sync creates the archive and reports every verse as added
+	var report bytes.Buffer
+	if err := SyncData(source, out, &report); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(report.String(), "5 added, 0 changed, 0 removed verses") {
+		t.Errorf("Unexpected first sync report:\n%s", report.String())
+	}
+
+	// Syncing the same source again reports no changes
+	report.Reset()
+	if err := SyncData(source, out, &report); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(report.String(), "0 added, 0 changed, 0 removed verses") {
+		t.Errorf("Unexpected second sync report:\n%s", report.String())
+	}
+
+	// The rebuilt archive loads like the embedded one
+	archive, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("Expected archive to be written: %v", err)
+	}
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	if err := service.loadFromZipBytes(archive, out); err != nil {
+		t.Fatalf("Failed to load rebuilt archive: %v", err)
+	}
+	if len(service.scriptures["1 Nephi"]) != 3 {
+		t.Errorf("Expected 3 verses for 1 Nephi, got %d", len(service.scriptures["1 Nephi"]))
+	}
+}
+
+func TestDiffData(t *testing.T) {
+	dir := t.TempDir()
+	old, updated := filepath.Join(dir, "old.zip"), filepath.Join(dir, "new.zip")
+	if err := SyncData(writeSourceDir(t, testScriptureData), old, &bytes.Buffer{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The new version rewords John 3:16
+	encoded, err := json.Marshal(testScriptureData)
+	if err != nil {
+		t.Fatalf("Failed to marshal test data: %v", err)
+	}
+	var changed ScriptureData
+	if err := json.Unmarshal(bytes.Replace(encoded, []byte("so loved the world"), []byte("so loved the whole world"), 1), &changed); err != nil {
+		t.Fatalf("Failed to unmarshal test data: %v", err)
+	}
+	if err := SyncData(writeSourceDir(t, changed), updated, &bytes.Buffer{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var report bytes.Buffer
+	if err := DiffData(old, updated, false, &report); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, want := range []string{"0 added, 1 changed, 0 removed verses", "~ John 3:16: For God so loved the {+whole+} world"} {
+		if !strings.Contains(report.String(), want) {
+			t.Errorf("Expected report to contain %q, got:\n%s", want, report.String())
+		}
+	}
+
+	report.Reset()
+	if err := DiffData(old, updated, true, &report); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var diff CorpusDiff
+	if err := json.Unmarshal(report.Bytes(), &diff); err != nil {
+		t.Fatalf("Expected a JSON diff, got %v:\n%s", err, report.String())
+	}
+	if len(diff.Changed) != 1 || diff.Changed[0].Reference != "John 3:16" || len(diff.Added)+len(diff.Removed) != 0 {
+		t.Errorf("Expected only John 3:16 changed, got %+v", diff)
+	}
+
+	if err := DiffData(old, filepath.Join(dir, "missing.zip"), false, &bytes.Buffer{}); err == nil {
+		t.Error("Expected error for a missing archive")
+	}
+}
+
+func TestSyncData_MissingSource(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "scriptures.zip")
+	if err := SyncData(t.TempDir(), out, &bytes.Buffer{}); err == nil {
+		t.Error("Expected error for missing source files")
+	}
+	if _, err := os.Stat(out); !os.IsNotExist(err) {
+		t.Error("Expected no archive to be written when the sync fails")
+	}
+}
@@ -0,0 +1,126 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxCountTerms bounds the number of terms one count_terms call may ask for
+const maxCountTerms = 1000
+
+// TermCount is how often a term occurs in the verses counted
+type TermCount struct {
+	Term        string `json:"term"`
+	Occurrences int    `json:"occurrences"`
+	Verses      int    `json:"verses"` // Number of verses with at least one occurrence
+}
+
+// CountTerms counts the occurrences of many terms in a single pass over the corpus
+func (s *Service) CountTerms(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	edition, err := s.edition(arguments)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if edition != s {
+		return edition.CountTerms(ctx, request)
+	}
+
+	termsText, _ := arguments["terms"].(string)
+	terms := parseTerms(termsText)
+	if len(terms) == 0 {
+		return mcp.NewToolResultError("terms cannot be empty"), nil
+	}
+	var skipped []string
+	if skipCommon, _ := arguments["skip_common"].(bool); skipCommon {
+		if terms, skipped = s.skipCommonWords(terms); len(terms) == 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("every term is a common word: %s", strings.Join(skipped, ", "))), nil
+		}
+	}
+	if len(terms) > maxCountTerms {
+		return mcp.NewToolResultError(fmt.Sprintf("at most %d terms can be counted at once, got %d", maxCountTerms, len(terms))), nil
+	}
+
+	verses := s.flatVerses()
+	scopeText, _ := arguments["scope"].(string)
+	if scopeText != "" {
+		ref, err := s.parseSelector(scopeText)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		scope := []*ScriptureReference{ref}
+		scoped := verses[:0]
+		for _, verse := range verses {
+			if selectorMatches(scope, verse) {
+				scoped = append(scoped, verse)
+			}
+		}
+		verses = scoped
+	}
+
+	counts := countTerms(verses, terms)
+	where := "all loaded scripture"
+	if scopeText != "" {
+		where = scopeText
+	}
+	response := fmt.Sprintf("Term counts in %s (%d %s):\n\n", where, len(verses), pluralize(len(verses), "verse", "verses"))
+	for _, count := range counts {
+		response += fmt.Sprintf("- %s: %d %s in %d %s\n", count.Term,
+			count.Occurrences, pluralize(count.Occurrences, "occurrence", "occurrences"),
+			count.Verses, pluralize(count.Verses, "verse", "verses"))
+	}
+	if len(skipped) > 0 {
+		response += fmt.Sprintf("\nSkipped common %s: %s\n", pluralize(len(skipped), "word", "words"), strings.Join(skipped, ", "))
+	}
+
+	structured := TermCountsResult{Scope: scopeText, Verses: len(verses), Counts: counts, Skipped: skipped}
+	result := mcp.NewToolResultStructured(structured, response)
+	result.Meta = &mcp.Meta{AdditionalFields: map[string]any{"counts": counts}}
+	return result, nil
+}
+
+// parseTerms splits a comma or newline separated list of terms, dropping
+// blanks and repeats that differ only in case
+func parseTerms(list string) []string {
+	var terms []string
+	seen := make(map[string]bool)
+	for _, term := range strings.FieldsFunc(list, func(r rune) bool { return r == ',' || r == '\n' }) {
+		term = strings.TrimSpace(term)
+		if term == "" || seen[strings.ToLower(term)] {
+			continue
+		}
+		seen[strings.ToLower(term)] = true
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// countTerms counts each term's case-insensitive occurrences in the verse
+// texts, overlapping ones included, and the verses containing it. Each verse
+// is lowercased once and scanned once for every term together, so the cost
+// grows with the size of the corpus rather than with the number of terms.
+func countTerms(verses []Scripture, terms []string) []TermCount {
+	patterns := make([]string, len(terms))
+	counts := make([]TermCount, len(terms))
+	for i, term := range terms {
+		patterns[i] = strings.ToLower(term)
+		counts[i].Term = term
+	}
+	m := newMatcher(patterns)
+
+	lastVerse := make([]int, len(terms)) // Last verse counted for each term, plus one
+	for i, verse := range verses {
+		m.scan(strings.ToLower(verse.Text), func(id, end int) {
+			counts[id].Occurrences++
+			if lastVerse[id] != i+1 {
+				lastVerse[id] = i + 1
+				counts[id].Verses++
+			}
+		})
+	}
+	return counts
+}
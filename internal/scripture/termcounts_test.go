@@ -0,0 +1,184 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// countTermsPerTerm is the straightforward count: one pass over every verse
+// for each term. countTerms must agree with it.
+func countTermsPerTerm(verses []Scripture, terms []string) []TermCount {
+	counts := make([]TermCount, len(terms))
+	for i, term := range terms {
+		counts[i].Term = term
+		termLower := strings.ToLower(term)
+		for _, verse := range verses {
+			text := strings.ToLower(verse.Text)
+			found := 0
+			for at := strings.Index(text, termLower); at >= 0; at = strings.Index(text, termLower) {
+				found++
+				text = text[at+1:]
+			}
+			counts[i].Occurrences += found
+			if found > 0 {
+				counts[i].Verses++
+			}
+		}
+	}
+	return counts
+}
+
+// benchmarkTerms picks n distinct words of the corpus, spread across the alphabet
+func benchmarkTerms(service *Service, n int) []string {
+	words := make([]string, 0, len(service.index.words))
+	for word := range service.index.words {
+		words = append(words, word)
+	}
+	sort.Strings(words)
+
+	terms := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		terms = append(terms, words[i*len(words)/n])
+	}
+	return append(terms, "and it came to pass", "the Lord", "faith")
+}
+
+func TestCountTerms_MatchesPerTermCount(t *testing.T) {
+	service := NewService()
+	verses := service.flatVerses()
+	terms := benchmarkTerms(service, 50)
+
+	got := countTerms(verses, terms)
+	expected := countTermsPerTerm(verses, terms)
+	if !reflect.DeepEqual(got, expected) {
+		for i := range expected {
+			if got[i] != expected[i] {
+				t.Errorf("Expected %+v, got %+v", expected[i], got[i])
+			}
+		}
+	}
+}
+
+func TestService_CountTerms(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+	service.recordBook("Moroni", "Book of Mormon")
+	service.recordBook("Ether", "Book of Mormon")
+	service.scriptures["Ether"] = []Scripture{
+		{Book: "Ether", Chapter: 12, Verse: 6, Text: "faith is things which are hoped for and not seen", Reference: "Ether 12:6"},
+	}
+	service.scriptures["Moroni"] = []Scripture{
+		{Book: "Moroni", Chapter: 7, Verse: 42, Text: "Wherefore, if a man have faith he must needs have hope; for without faith there cannot be any hope.", Reference: "Moroni 7:42"},
+		{Book: "Moroni", Chapter: 7, Verse: 47, Text: "But charity is the pure love of Christ", Reference: "Moroni 7:47"},
+	}
+
+	var tooMany []string
+	for i := 0; i <= maxCountTerms; i++ {
+		tooMany = append(tooMany, fmt.Sprintf("term%d", i))
+	}
+
+	tests := []struct {
+		name          string
+		arguments     map[string]interface{}
+		expectError   bool
+		expected      []TermCount
+		shouldContain string
+	}{
+		{
+			name:      "Counts in the order given",
+			arguments: map[string]interface{}{"terms": "hope, Faith, charity, mercy"},
+			expected: []TermCount{
+				{Term: "hope", Occurrences: 3, Verses: 2},
+				{Term: "Faith", Occurrences: 3, Verses: 2},
+				{Term: "charity", Occurrences: 1, Verses: 1},
+				{Term: "mercy", Occurrences: 0, Verses: 0},
+			},
+			shouldContain: "- hope: 3 occurrences in 2 verses\n",
+		},
+		{
+			name:      "Newlines, phrases and repeats",
+			arguments: map[string]interface{}{"terms": "pure love\nfaith\nFAITH\n"},
+			expected: []TermCount{
+				{Term: "pure love", Occurrences: 1, Verses: 1},
+				{Term: "faith", Occurrences: 3, Verses: 2},
+			},
+			shouldContain: "- pure love: 1 occurrence in 1 verse\n",
+		},
+		{
+			name:      "Scoped to a chapter",
+			arguments: map[string]interface{}{"terms": "faith", "scope": "Moroni 7"},
+			expected: []TermCount{
+				{Term: "faith", Occurrences: 2, Verses: 1},
+			},
+			shouldContain: "Term counts in Moroni 7 (2 verses):",
+		},
+		{
+			name:        "No terms",
+			arguments:   map[string]interface{}{"terms": " , \n"},
+			expectError: true,
+		},
+		{
+			name:        "Too many terms",
+			arguments:   map[string]interface{}{"terms": strings.Join(tooMany, ",")},
+			expectError: true,
+		},
+		{
+			name:        "Unknown scope",
+			arguments:   map[string]interface{}{"terms": "faith", "scope": "Hezekiah"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+			result, err := service.CountTerms(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.IsError != tt.expectError {
+				t.Fatalf("Expected IsError = %v, got %v: %v", tt.expectError, result.IsError, result.Content)
+			}
+			if tt.expectError {
+				return
+			}
+
+			counts := result.Meta.AdditionalFields["counts"]
+			if !reflect.DeepEqual(counts, tt.expected) {
+				t.Errorf("Expected counts %+v, got %+v", tt.expected, counts)
+			}
+			text := result.Content[0].(mcp.TextContent).Text
+			if !strings.Contains(text, tt.shouldContain) {
+				t.Errorf("Expected output to contain %q, got:\n%s", tt.shouldContain, text)
+			}
+		})
+	}
+}
+
+func BenchmarkCountTerms(b *testing.B) {
+	service := NewService()
+	verses := service.flatVerses()
+	terms := benchmarkTerms(service, 300)
+
+	b.Run("single pass", func(b *testing.B) {
+		for b.Loop() {
+			countTerms(verses, terms)
+		}
+	})
+	b.Run("per term", func(b *testing.B) {
+		for b.Loop() {
+			countTermsPerTerm(verses, terms)
+		}
+	})
+}
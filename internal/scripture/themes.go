@@ -0,0 +1,183 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// themeTag is a curated theme with the passages that teach it, whether or
+// not their wording uses the theme's words
+type themeTag struct {
+	Theme    string
+	Words    []string // The theme name and words that name it in a query or a verse
+	Passages []string
+}
+
+// themeTags is the curated theme dataset behind search_by_theme
+var themeTags = []themeTag{
+	{
+		Theme: "hope",
+		Words: []string{"hope", "hopeful", "hopes", "encouragement", "optimism"},
+		Passages: []string{
+			"Ether 12:4", "Moroni 7:41-42", "2 Nephi 31:20", "Alma 32:21",
+			"Romans 15:13", "Romans 8:24-25", "Romans 8:28", "Hebrews 6:19", "1 Peter 1:3",
+			"Psalm 42:11", "Lamentations 3:22-26", "Jeremiah 29:11", "Isaiah 40:31",
+			"John 14:27", "John 16:33", "Revelation 21:4", "D&C 68:6",
+		},
+	},
+	{
+		Theme: "covenant",
+		Words: []string{"covenant", "covenants", "covenanted", "promise", "promises", "ordinance", "ordinances"},
+		Passages: []string{
+			"Genesis 17:7", "Exodus 19:5", "Jeremiah 31:33", "Abraham 2:9-11",
+			"Mosiah 5:5-7", "Mosiah 18:8-10", "Mosiah 24:13", "3 Nephi 20:25-26",
+			"Moroni 4:3", "Moroni 5:2", "D&C 20:77", "D&C 54:6", "D&C 82:10",
+			"D&C 84:33-40", "D&C 132:19",
+		},
+	},
+	{
+		Theme: "obedience",
+		Words: []string{"obedience", "obey", "obeyed", "obedient", "commandments"},
+		Passages: []string{
+			"Deuteronomy 11:26-28", "Joshua 24:15", "1 Samuel 15:22", "Ecclesiastes 12:13",
+			"Matthew 7:21", "Luke 22:42", "John 14:15", "James 1:22",
+			"1 Nephi 3:7", "1 Nephi 17:3", "Mosiah 2:41", "Alma 37:35",
+			"Abraham 3:25", "D&C 82:10", "D&C 130:20-21",
+		},
+	},
+	{
+		Theme: "prayer",
+		Words: []string{"prayer", "prayers", "pray", "prayed", "praying"},
+		Passages: []string{
+			"Matthew 6:6", "Matthew 6:9-13", "Matthew 7:7", "Philippians 4:6", "1 Thessalonians 5:17",
+			"James 1:5-6", "Enos 1:4", "Alma 34:17-27", "Alma 37:36-37", "2 Nephi 32:8-9",
+			"3 Nephi 18:15", "3 Nephi 18:19-21", "Moroni 10:4", "D&C 19:38", "D&C 88:63",
+			"Joseph Smith—History 1:14-17",
+		},
+	},
+	{
+		Theme: "adversity",
+		Words: []string{"adversity", "affliction", "afflictions", "trial", "trials", "suffering", "hardship", "hardships"},
+		Passages: []string{
+			"Job 1:21", "Psalm 23:4", "Psalm 55:22", "Isaiah 43:2",
+			"Matthew 11:28-30", "Romans 8:35-39", "2 Corinthians 4:8-9", "2 Corinthians 12:9-10", "1 Peter 4:12-13",
+			"2 Nephi 2:11", "Mosiah 24:14-15", "Alma 7:11-12", "Alma 36:3", "Helaman 5:12", "Ether 12:27",
+			"D&C 121:7-8", "D&C 122:7",
+		},
+	},
+}
+
+// ThemePassage is a passage tagged with a theme
+type ThemePassage struct {
+	Reference string      `json:"reference"`
+	Verses    []Scripture `json:"verses"`
+	Keyword   bool        `json:"keyword"` // Whether the wording uses the theme's words
+}
+
+// findTheme returns the theme a query names, by its name or one of its words
+func findTheme(query string) (themeTag, bool) {
+	query = strings.ToLower(strings.TrimSpace(query))
+	for _, tag := range themeTags {
+		for _, word := range tag.Words {
+			if query == word {
+				return tag, true
+			}
+		}
+	}
+	return themeTag{}, false
+}
+
+// themeNames returns the names of the curated themes in alphabetical order
+func themeNames() []string {
+	names := make([]string, 0, len(themeTags))
+	for _, tag := range themeTags {
+		names = append(names, tag.Theme)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SearchByTheme returns the passages tagged with a theme in the curated
+// theme dataset, including those whose wording never names the theme
+func (s *Service) SearchByTheme(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	query, _ := arguments["theme"].(string)
+	if strings.TrimSpace(query) == "" {
+		return mcp.NewToolResultError(fmt.Sprintf("theme cannot be empty; available themes: %s", strings.Join(themeNames(), ", "))), nil
+	}
+	tag, ok := findTheme(query)
+	if !ok {
+		return mcp.NewToolResultError(fmt.Sprintf("unknown theme '%s'; available themes: %s", query, strings.Join(themeNames(), ", "))), nil
+	}
+
+	var scope []*ScriptureReference
+	scopeText, _ := arguments["scope"].(string)
+	if scopeText != "" {
+		ref, err := s.parseSelector(scopeText)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		scope = append(scope, ref)
+	}
+
+	passages := s.themePassages(tag, scope)
+	structured := ThemeResult{Theme: tag.Theme, Scope: scopeText, Passages: append([]ThemePassage{}, passages...)}
+	where := ""
+	if scopeText != "" {
+		where = " in " + scopeText
+	}
+	if len(passages) == 0 {
+		return mcp.NewToolResultStructured(structured, fmt.Sprintf("No passages tagged '%s' found%s.", tag.Theme, where)), nil
+	}
+
+	response := fmt.Sprintf("Passages on %s%s (%d):\n\n", tag.Theme, where, len(passages))
+	for _, passage := range passages {
+		response += passage.Reference
+		if !passage.Keyword {
+			response += " (the wording does not name the theme)"
+		}
+		response += "\n"
+		for _, verse := range passage.Verses {
+			response += fmt.Sprintf("%s %d:%d - %s\n", verse.Book, verse.Chapter, verse.Verse, verse.Text)
+		}
+		response += "\n"
+	}
+	return mcp.NewToolResultStructured(structured, response), nil
+}
+
+// themePassages looks up the loaded verses of a theme's passages, keeping
+// those with a verse in scope when a scope is given
+func (s *Service) themePassages(tag themeTag, scope []*ScriptureReference) []ThemePassage {
+	words := make(map[string]bool, len(tag.Words))
+	for _, word := range tag.Words {
+		words[word] = true
+	}
+
+	var passages []ThemePassage
+	for _, reference := range tag.Passages {
+		ref, err := s.parseReference(reference)
+		if err != nil {
+			continue
+		}
+		verses := s.getScripturesByReference(ref)
+		if len(verses) == 0 || (scope != nil && !selectorMatches(scope, verses[0])) {
+			continue
+		}
+
+		passage := ThemePassage{Reference: reference, Verses: verses}
+		for _, verse := range verses {
+			for _, field := range strings.Fields(verse.Text) {
+				if words[diffKey(field)] {
+					passage.Keyword = true
+				}
+			}
+		}
+		passages = append(passages, passage)
+	}
+	return passages
+}
@@ -0,0 +1,89 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestThemeTags_PassagesExist(t *testing.T) {
+	service := NewService()
+
+	for _, tag := range themeTags {
+		if tag.Words[0] != tag.Theme {
+			t.Errorf("Expected the words of %s to start with its name, got %v", tag.Theme, tag.Words)
+		}
+		for _, reference := range tag.Passages {
+			ref, err := service.parseReference(reference)
+			if err != nil {
+				t.Errorf("%s: invalid reference %s: %v", tag.Theme, reference, err)
+				continue
+			}
+			if verses := service.getScripturesByReference(ref); len(verses) != ref.EndVerse-ref.Verse+1 {
+				t.Errorf("%s: expected %d verses for %s, got %d", tag.Theme, ref.EndVerse-ref.Verse+1, reference, len(verses))
+			}
+		}
+	}
+}
+
+func TestService_SearchByTheme(t *testing.T) {
+	service := NewService()
+
+	tests := []struct {
+		name        string
+		arguments   map[string]any
+		theme       string
+		passages    int    // Expected number of passages; 0 to skip the check
+		contains    string // Expected passage
+		conceptOnly string // Expected passage whose wording does not name the theme
+		isError     bool
+	}{
+		{name: "Theme name", arguments: map[string]any{"theme": "Hope"}, theme: "hope", passages: len(themeTags[0].Passages), contains: "Ether 12:4", conceptOnly: "Isaiah 40:31"},
+		{name: "Word for the theme", arguments: map[string]any{"theme": "trials"}, theme: "adversity", conceptOnly: "Psalm 23:4"},
+		{name: "Scope", arguments: map[string]any{"theme": "pray", "scope": "Alma"}, theme: "prayer", passages: 2, contains: "Alma 34:17-27"},
+		{name: "Scope without passages", arguments: map[string]any{"theme": "prayer", "scope": "Obadiah"}, theme: "prayer"},
+		{name: "Unknown theme", arguments: map[string]any{"theme": "dinosaurs"}, isError: true},
+		{name: "Empty", arguments: map[string]any{}, isError: true},
+		{name: "Bad scope", arguments: map[string]any{"theme": "hope", "scope": "Hezekiah"}, isError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = tt.arguments
+			result, err := service.SearchByTheme(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.IsError != tt.isError {
+				t.Fatalf("Expected IsError %v, got %+v", tt.isError, result)
+			}
+			if tt.isError {
+				if text := result.Content[0].(mcp.TextContent).Text; !strings.Contains(text, "available themes: adversity, covenant, hope, obedience, prayer") && tt.name != "Bad scope" {
+					t.Errorf("Expected the available themes in the error, got %q", text)
+				}
+				return
+			}
+
+			structured := result.StructuredContent.(ThemeResult)
+			if structured.Theme != tt.theme {
+				t.Errorf("Expected theme %s, got %s", tt.theme, structured.Theme)
+			}
+			if tt.passages > 0 && len(structured.Passages) != tt.passages {
+				t.Errorf("Expected %d passages, got %d", tt.passages, len(structured.Passages))
+			}
+			found := map[string]ThemePassage{}
+			for _, passage := range structured.Passages {
+				found[passage.Reference] = passage
+			}
+			if passage, ok := found[tt.contains]; tt.contains != "" && (!ok || !passage.Keyword) {
+				t.Errorf("Expected %s naming the theme, got %+v", tt.contains, passage)
+			}
+			if passage, ok := found[tt.conceptOnly]; tt.conceptOnly != "" && (!ok || passage.Keyword) {
+				t.Errorf("Expected %s without the theme's words, got %+v", tt.conceptOnly, passage)
+			}
+		})
+	}
+}
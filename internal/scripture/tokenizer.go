@@ -0,0 +1,115 @@
+package scripture
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Tokenizer splits text into lowercased words. Search, its index and
+// relevance ranking, the shingles of find_duplicate_verses and the text
+// analyses all split with the tokenizer of the loaded language, so they
+// agree on where words begin and end.
+//
+// A tokenizer used by the search index must keep substring search sound:
+// every word of a piece of text must be part of a word of any text
+// containing it.
+type Tokenizer interface {
+	Words(text string) []string
+}
+
+// languageTokenizers are the tokenizers of languages that need more than
+// splitting at punctuation and spaces; any other language, Spanish among
+// them, uses unicodeTokenizer
+var languageTokenizers = map[string]Tokenizer{
+	defaultLanguage: archaicEnglishTokenizer{},
+	"pt":            portugueseTokenizer{},
+}
+
+// tokenizerFor returns the tokenizer of a language
+func tokenizerFor(language string) Tokenizer {
+	if tokenizer, ok := languageTokenizers[language]; ok {
+		return tokenizer
+	}
+	return unicodeTokenizer{}
+}
+
+// tokenizer returns the tokenizer of the service's language
+func (s *Service) tokenizer() Tokenizer {
+	return tokenizerFor(s.languageCode())
+}
+
+// isWordRune reports whether a rune belongs to a word. Combining marks do,
+// so text with decomposed accents splits like precomposed text.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)
+}
+
+// unicodeTokenizer splits at every rune that is not a letter, digit or
+// combining mark, so punctuation, dashes and hyphens all separate words
+type unicodeTokenizer struct{}
+
+func (unicodeTokenizer) Words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !isWordRune(r)
+	})
+}
+
+// archaicEnglishTokenizer splits like unicodeTokenizer but keeps an
+// apostrophe between letters inside its word, so elisions such as "o'er"
+// and "ne'er" and possessives such as "Lord's" are one word, reading curly
+// apostrophes as straight ones
+type archaicEnglishTokenizer struct{}
+
+func (archaicEnglishTokenizer) Words(text string) []string {
+	return joinedWords(text, "'’", '\'')
+}
+
+// portugueseTokenizer splits like unicodeTokenizer but keeps a hyphen
+// between letters inside its word, so pronouns joined to their verb such
+// as "disse-lhe" and "amá-lo" and compounds such as "guarda-chuva" are one
+// word, as Portuguese spelling writes them
+type portugueseTokenizer struct{}
+
+func (portugueseTokenizer) Words(text string) []string {
+	return joinedWords(text, "-‐", '-')
+}
+
+// joinedWords splits text at every rune that is not part of a word, except
+// that a joiner between a word and a letter stays in the word, written as
+// joined
+func joinedWords(text string, joiners string, joined rune) []string {
+	runes := []rune(strings.ToLower(text))
+	var words []string
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, string(word))
+		}
+		word = word[:0]
+	}
+	for i, r := range runes {
+		switch {
+		case isWordRune(r):
+			word = append(word, r)
+		case strings.ContainsRune(joiners, r) && len(word) > 0 && i+1 < len(runes) && unicode.IsLetter(runes[i+1]):
+			word = append(word, joined)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return words
+}
+
+// letterWords returns the words of text that contain a letter. The text
+// analyses count only these, so numbers such as verse numbers quoted in a
+// verse don't change word counts or sentence lengths.
+func letterWords(tokenizer Tokenizer, text string) []string {
+	var words []string
+	for _, word := range tokenizer.Words(text) {
+		if strings.IndexFunc(word, unicode.IsLetter) >= 0 {
+			words = append(words, word)
+		}
+	}
+	return words
+}
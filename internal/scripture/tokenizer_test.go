@@ -0,0 +1,73 @@
+package scripture
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTokenizers(t *testing.T) {
+	tests := []struct {
+		name      string
+		tokenizer Tokenizer
+		text      string
+		expected  []string
+	}{
+		{"English words", archaicEnglishTokenizer{}, "And the LORD's word—came unto 1 Nephi,", []string{"and", "the", "lord's", "word", "came", "unto", "1", "nephi"}},
+		{"English punctuation", archaicEnglishTokenizer{}, "  Still, small voice! ", []string{"still", "small", "voice"}},
+		{"English elisions", archaicEnglishTokenizer{}, "O’er the hills ne'er 'tis fathers' -- day", []string{"o'er", "the", "hills", "ne'er", "tis", "fathers", "day"}},
+		{"Unicode splits at apostrophes", unicodeTokenizer{}, "the LORD's day", []string{"the", "lord", "s", "day"}},
+		{"Unicode keeps accents", unicodeTokenizer{}, "Y dijo él: ¡Hágase la luz!", []string{"y", "dijo", "él", "hágase", "la", "luz"}},
+		{"Unicode keeps combining marks", unicodeTokenizer{}, "disse-lhe: sa\u0303o", []string{"disse", "lhe", "sa\u0303o"}},
+		{"Portuguese clitics", portugueseTokenizer{}, "E disse-lhe: Amá-lo-ás, guarda‐chuva", []string{"e", "disse-lhe", "amá-lo-ás", "guarda-chuva"}},
+		{"Portuguese dashes", portugueseTokenizer{}, "pai - filho -- 3-4 fé-", []string{"pai", "filho", "3", "4", "fé"}},
+		{"No words", archaicEnglishTokenizer{}, "...", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tokenizer.Words(tt.text); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Words(%q) = %q, expected %q", tt.text, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTokenizers_SubstringSound(t *testing.T) {
+	// The search index finds a query's candidates by its words, so every
+	// word of any piece of a text must be part of one of the text's words
+	text := "And the LORD’s word came: O'er ye, ne'er fathers' -- Hágase! Disse-lhe, 3-4 -x"
+	for _, tokenizer := range []Tokenizer{archaicEnglishTokenizer{}, portugueseTokenizer{}, unicodeTokenizer{}} {
+		words := tokenizer.Words(text)
+		runes := []rune(text)
+		for i := range runes {
+			for j := i + 1; j <= len(runes); j++ {
+				for _, word := range tokenizer.Words(string(runes[i:j])) {
+					found := false
+					for _, w := range words {
+						found = found || strings.Contains(w, word)
+					}
+					if !found {
+						t.Errorf("%T: word %q of %q is in no word of the text", tokenizer, word, string(runes[i:j]))
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestTokenizerFor(t *testing.T) {
+	if _, ok := tokenizerFor(defaultLanguage).(archaicEnglishTokenizer); !ok {
+		t.Errorf("Expected English to use the archaic English tokenizer")
+	}
+	if _, ok := tokenizerFor("fr").(unicodeTokenizer); !ok {
+		t.Errorf("Expected an unknown language to use the Unicode tokenizer")
+	}
+	service := &Service{language: "es"}
+	if _, ok := service.tokenizer().(unicodeTokenizer); !ok {
+		t.Errorf("Expected the Spanish edition to use the Unicode tokenizer")
+	}
+	if _, ok := tokenizerFor("pt").(portugueseTokenizer); !ok {
+		t.Errorf("Expected Portuguese to use the Portuguese tokenizer")
+	}
+}
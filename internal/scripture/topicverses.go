@@ -0,0 +1,209 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxTopicPassages caps the limit of find_topic_verses
+const maxTopicPassages = 50
+
+// topicSearchVerses is how many of the verses most relevant to a topic the
+// search source suggests
+const topicSearchVerses = 50
+
+// Sources that suggest passages for a topic
+const (
+	passageFromTheme        = "theme"
+	passageFromTopicalGuide = "topical_guide"
+	passageFromSearch       = "search"
+)
+
+// TopicPassage is a passage suggested for a topic, with every source that
+// suggested it
+type TopicPassage struct {
+	Reference string      `json:"reference"`
+	Verses    []Scripture `json:"verses"`
+	Sources   []string    `json:"sources"` // theme, topical_guide and search, in that order
+}
+
+// topicPassages merges the passages a topic's sources suggest: the curated
+// passages of a theme the topic names, the verses whose footnotes link to a
+// Topical Guide entry on the topic, and the most relevant of the verses
+// with the most of the topic's words. A verse suggested by more than one
+// source appears once, in the first passage that holds it, with each
+// source recorded. Passages more sources agree on come first, then theme
+// passages in curated order, Topical Guide verses in reading order and
+// search hits by relevance.
+func (s *Service) topicPassages(ctx context.Context, topic string, keep func(Scripture) bool) []TopicPassage {
+	var passages []TopicPassage
+	holding := make(map[verseKey]int) // Passage holding each verse
+	suggest := func(source string, verses []Scripture) {
+		for _, verse := range verses {
+			if i, ok := holding[verseKey{book: verse.Book, chapter: verse.Chapter, verse: verse.Verse}]; ok {
+				if !slices.Contains(passages[i].Sources, source) {
+					passages[i].Sources = append(passages[i].Sources, source)
+				}
+				return
+			}
+		}
+		for _, verse := range verses {
+			holding[verseKey{book: verse.Book, chapter: verse.Chapter, verse: verse.Verse}] = len(passages)
+		}
+		passages = append(passages, TopicPassage{
+			Reference: spanReference(verses, verseSpan{Start: 0, End: len(verses) - 1}),
+			Verses:    verses,
+			Sources:   []string{source},
+		})
+	}
+
+	tag, themed := findTheme(topic)
+	if themed {
+		for _, passage := range s.themePassages(tag, nil) {
+			if keep(passage.Verses[0]) {
+				suggest(passageFromTheme, passage.Verses)
+			}
+		}
+	}
+	for _, verse := range s.topicalGuideVerses(topic) {
+		if keep(verse) {
+			suggest(passageFromTopicalGuide, []Scripture{verse})
+		}
+	}
+	for _, verse := range s.topicSearchHits(ctx, topic, keep) {
+		suggest(passageFromSearch, []Scripture{verse})
+	}
+
+	sort.SliceStable(passages, func(i, j int) bool {
+		return len(passages[i].Sources) > len(passages[j].Sources)
+	})
+	return passages
+}
+
+// topicalGuideVerses returns, in reading order, the verses with a footnote
+// linking to a Topical Guide entry on the topic. The Topical Guide is not
+// part of the data, but its footnote links mark the verses it lists.
+func (s *Service) topicalGuideVerses(topic string) []Scripture {
+	if len(s.footnotes) == 0 {
+		return nil
+	}
+	var verses []Scripture
+	for _, book := range s.orderedBooks() {
+		for _, verse := range s.scriptures[book] {
+			if s.linksTopicalGuide(verse, topic) {
+				verses = append(verses, verse)
+			}
+		}
+	}
+	return verses
+}
+
+// linksTopicalGuide reports whether a footnote of a verse links to a
+// Topical Guide entry on the topic: one named by it, such as "TG Prayer"
+// for "prayer", one under it, such as "TG Prayer, Answers to", or one
+// naming the same curated theme, such as "TG Pray" for "prayer"
+func (s *Service) linksTopicalGuide(verse Scripture, topic string) bool {
+	tag, themed := findTheme(topic)
+	for _, footnote := range s.footnotes[verseKey{book: verse.Book, chapter: verse.Chapter, verse: verse.Verse}] {
+		for _, link := range footnote.Links {
+			abbreviation, entry, _ := strings.Cut(strings.TrimSpace(link), " ")
+			if !strings.EqualFold(abbreviation, "TG") {
+				continue
+			}
+			entry = strings.TrimSpace(entry)
+			heading, _, _ := strings.Cut(entry, ",")
+			if strings.EqualFold(entry, topic) || strings.EqualFold(strings.TrimSpace(heading), topic) {
+				return true
+			}
+			if linked, ok := findTheme(heading); ok && themed && linked.Theme == tag.Theme {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// topicSearchHits returns the verses with the most of the topic's words,
+// most relevant first, so verses that use one common word of a longer
+// topic do not crowd out those using all of it
+func (s *Service) topicSearchHits(ctx context.Context, topic string, keep func(Scripture) bool) []Scripture {
+	index := s.index
+	if index == nil {
+		index = s.newSearchIndex()
+	}
+	topicWords := make(map[string]bool)
+	for _, word := range index.tokenizer.Words(topic) {
+		topicWords[word] = true
+	}
+	var hits []Scripture
+	mostWords := 0
+	for _, scored := range index.rank(ctx, topic) {
+		if !keep(scored.Scripture) {
+			continue
+		}
+		found := make(map[string]bool)
+		for _, word := range index.tokenizer.Words(scored.Text) {
+			if topicWords[word] {
+				found[word] = true
+			}
+		}
+		if len(found) > mostWords {
+			hits, mostWords = nil, len(found)
+		}
+		if len(found) == mostWords && len(hits) < topicSearchVerses {
+			hits = append(hits, scored.Scripture)
+		}
+	}
+	return hits
+}
+
+// FindTopicVerses merges the passages a theme, the Topical Guide links of
+// the footnotes and a relevance search suggest for a topic, listing each
+// passage once with the sources that agree on it, most agreed first
+func (s *Service) FindTopicVerses(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	topic, _ := arguments["topic"].(string)
+	topic = strings.TrimSpace(topic)
+	if topic == "" {
+		return mcp.NewToolResultError("topic cannot be empty"), nil
+	}
+
+	limit := 10
+	if limitVal, ok := arguments["limit"].(float64); ok {
+		limit = min(max(int(limitVal), 1), maxTopicPassages)
+	}
+
+	scopeText, _ := arguments["scope"].(string)
+	scopeText = strings.TrimSpace(scopeText)
+	keep, err := s.scopeFilter(scopeText)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	passages := s.topicPassages(ctx, topic, keep)
+	total := len(passages)
+	passages = passages[:min(limit, total)]
+	structured := TopicVersesResult{Topic: topic, Scope: scopeText, Total: total, Passages: append([]TopicPassage{}, passages...)}
+	if tag, ok := findTheme(topic); ok {
+		structured.Theme = tag.Theme
+	}
+	if len(passages) == 0 {
+		return mcp.NewToolResultStructured(structured, fmt.Sprintf("No passages found on '%s'.", topic)), nil
+	}
+
+	response := fmt.Sprintf("Passages on '%s' (%d of %d), most agreed first:\n\n", topic, len(passages), total)
+	for i, passage := range passages {
+		response += fmt.Sprintf("%d. %s [%s]\n", i+1, passage.Reference, strings.Join(passage.Sources, ", "))
+		for _, verse := range passage.Verses {
+			response += fmt.Sprintf("   %d. %s\n", verse.Verse, verse.Text)
+		}
+		response += "\n"
+	}
+	return mcp.NewToolResultStructured(structured, response), nil
+}
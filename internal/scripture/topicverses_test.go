@@ -0,0 +1,108 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const testTopicalGuideFootnotes = `{"footnotes": [
+	{"reference": "Ether 12:4", "marker": "a", "word": "hope", "links": ["TG Hope"]},
+	{"reference": "Moroni 7:42", "marker": "b", "word": "hope", "links": ["Ether 12:4", "TG Hope"]},
+	{"reference": "Alma 13:29", "marker": "a", "word": "hope", "links": ["TG Hope, Blessings of"]},
+	{"reference": "Alma 32:21", "marker": "a", "word": "faith", "links": ["TG Faith"]},
+	{"reference": "Alma 32:21", "marker": "b", "word": "hope", "links": ["TG Hopes"]}
+]}`
+
+func TestService_TopicPassages(t *testing.T) {
+	service := NewService()
+	footnotes, err := service.parseFootnotes([]byte(testTopicalGuideFootnotes))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	service.footnotes = footnotes
+
+	passages := service.topicPassages(context.Background(), "hope", func(Scripture) bool { return true })
+	sources := make(map[string]string)
+	seen := make(map[string]bool)
+	for i, passage := range passages {
+		sources[passage.Reference] = strings.Join(passage.Sources, ",")
+		for _, verse := range passage.Verses {
+			if seen[verse.Reference] {
+				t.Errorf("Expected %s in one passage only", verse.Reference)
+			}
+			seen[verse.Reference] = true
+		}
+		if i > 0 && len(passage.Sources) > len(passages[i-1].Sources) {
+			t.Errorf("Expected most agreed first, got %s before %s", passages[i-1].Reference, passage.Reference)
+		}
+	}
+
+	// The search source may also agree on any of these
+	expected := map[string]string{
+		"Ether 12:4":     "theme,topical_guide",
+		"Moroni 7:41-42": "theme,topical_guide", // Topical Guide verse inside a theme passage
+		"Alma 13:29":     "topical_guide",       // Entry under the topic
+		"Alma 32:21":     "theme,topical_guide", // Entry naming the same theme
+		"Isaiah 40:31":   "theme",               // Wording never names hope
+	}
+	for reference, want := range expected {
+		if got := sources[reference]; got != want && got != want+","+passageFromSearch {
+			t.Errorf("Expected %s from %q, got %q", reference, want, got)
+		}
+	}
+	if len(passages[0].Sources) < 2 {
+		t.Errorf("Expected a passage two sources agree on first, got %+v", passages[0])
+	}
+
+	// Without footnotes there is no Topical Guide source
+	service.footnotes = nil
+	for _, passage := range service.topicPassages(context.Background(), "hope", func(Scripture) bool { return true }) {
+		if strings.Contains(strings.Join(passage.Sources, ","), passageFromTopicalGuide) {
+			t.Errorf("Expected no Topical Guide source without footnotes, got %+v", passage)
+		}
+	}
+}
+
+func TestService_FindTopicVerses(t *testing.T) {
+	service := NewService()
+	call := func(arguments map[string]any) *mcp.CallToolResult {
+		t.Helper()
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = arguments
+		result, err := service.FindTopicVerses(context.Background(), request)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	result := call(map[string]any{"topic": "prayer", "scope": "Book of Mormon", "limit": float64(3)})
+	if result.IsError {
+		t.Fatalf("Unexpected error: %+v", result)
+	}
+	structured := result.StructuredContent.(TopicVersesResult)
+	if structured.Theme != "prayer" || len(structured.Passages) != 3 || structured.Total <= 3 {
+		t.Fatalf("Unexpected result: %+v", structured)
+	}
+	for _, passage := range structured.Passages {
+		if collection := service.bookCollections[passage.Verses[0].Book]; collection != "Book of Mormon" {
+			t.Errorf("Expected passages in the Book of Mormon, got %s", passage.Reference)
+		}
+	}
+	text := result.Content[0].(mcp.TextContent).Text
+	if !strings.Contains(text, "1. "+structured.Passages[0].Reference+" ["+strings.Join(structured.Passages[0].Sources, ", ")+"]") {
+		t.Errorf("Expected the first passage with its sources, got:\n%s", text)
+	}
+
+	for _, arguments := range []map[string]any{
+		{"topic": " "},
+		{"topic": "hope", "scope": "Hezekiah"},
+	} {
+		if result := call(arguments); !result.IsError {
+			t.Errorf("Expected an error for %v, got %+v", arguments, result)
+		}
+	}
+}
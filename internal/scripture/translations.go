@@ -0,0 +1,155 @@
+package scripture
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// defaultTranslation is the Bible translation of the embedded scripture data
+const defaultTranslation = "kjv"
+
+// translationsDir is the folder of a data directory holding the optional
+// Bible translations, one subdirectory each, such as translations/web
+const translationsDir = "translations"
+
+// translationPattern matches the short names of translation directories
+var translationPattern = regexp.MustCompile(`^[a-z0-9]{2,10}$`)
+
+// TranslationText is a verse's text in one translation
+type TranslationText struct {
+	Translation string `json:"translation"`
+	Text        string `json:"text"`
+}
+
+// TranslationVerse is a verse with its text in each compared translation
+// that has it
+type TranslationVerse struct {
+	Reference string            `json:"reference"`
+	Texts     []TranslationText `json:"texts"`
+}
+
+// loadTranslations loads the optional Bible translations: each subdirectory
+// of the translations folder of SCRIPTURES_DATA_DIR or the state data
+// directory, such as translations/web, that holds old-testament.json and
+// new-testament.json in the usual layout. The first directory with a
+// translation wins.
+func (s *Service) loadTranslations() {
+	for _, dir := range dataDirs() {
+		entries, err := os.ReadDir(filepath.Join(dir, translationsDir))
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			name := entry.Name()
+			if !entry.IsDir() || !translationPattern.MatchString(name) || name == defaultTranslation || s.translations[name] != nil {
+				continue
+			}
+			edition := s.loadEdition(filepath.Join(dir, translationsDir, name))
+			if edition == nil {
+				continue
+			}
+			edition.translation = name
+			edition.finishLoading()
+			if s.translations == nil {
+				s.translations = make(map[string]*Service)
+			}
+			s.translations[name] = edition
+		}
+	}
+}
+
+// translationCode returns the Bible translation of the service's scripture data
+func (s *Service) translationCode() string {
+	if s.translation == "" {
+		return defaultTranslation
+	}
+	return s.translation
+}
+
+// translationNames returns the names of the loaded translations, this
+// service's first and the others in alphabetical order
+func (s *Service) translationNames() []string {
+	var names []string
+	for name := range s.translations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return append([]string{s.translationCode()}, names...)
+}
+
+// translationEdition returns the service for a translation name
+func (s *Service) translationEdition(name string) (*Service, error) {
+	if name == s.translationCode() {
+		return s, nil
+	}
+	if edition, ok := s.translations[name]; ok {
+		return edition, nil
+	}
+	return nil, fmt.Errorf("translation '%s' is not loaded; available translations: %s (place its data files in a '%s/%s' folder of SCRIPTURES_DATA_DIR or the state data directory)",
+		name, strings.Join(s.translationNames(), ", "), translationsDir, name)
+}
+
+// CompareTranslations shows a verse or range side by side in the loaded
+// Bible translations
+func (s *Service) CompareTranslations(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	query, ok := arguments["query"].(string)
+	if !ok || query == "" {
+		return mcp.NewToolResultError("scripture reference cannot be empty"), nil
+	}
+	ref, err := s.parseReference(query)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid scripture reference: %v", err)), nil
+	}
+
+	if !s.hasDataset(DatasetTranslations) {
+		return datasetUnavailable(DatasetTranslations), nil
+	}
+	names := s.translationNames()
+	if list, _ := arguments["translations"].(string); strings.TrimSpace(list) != "" {
+		names = []string{s.translationCode()}
+		for _, name := range strings.Split(list, ",") {
+			name = strings.ToLower(strings.TrimSpace(name))
+			if name == "" || name == s.translationCode() {
+				continue
+			}
+			if _, err := s.translationEdition(name); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			names = append(names, name)
+		}
+	}
+
+	verses := s.getScripturesByReference(ref)
+	if len(verses) == 0 {
+		return mcp.NewToolResultError(fmt.Sprintf("no verses found for '%s'", query)), nil
+	}
+
+	structured := TranslationComparisonResult{Reference: spanReference(verses, verseSpan{Start: 0, End: len(verses) - 1}), Translations: names}
+	response := fmt.Sprintf("Translation comparison for %s (%s):\n", structured.Reference, strings.ToUpper(strings.Join(names, ", ")))
+	for _, verse := range verses {
+		compared := TranslationVerse{Reference: verse.Reference, Texts: []TranslationText{}}
+		response += fmt.Sprintf("\n%s %d:%d\n", verse.Book, verse.Chapter, verse.Verse)
+		for _, name := range names {
+			edition, _ := s.translationEdition(name)
+			single := &ScriptureReference{Book: verse.Book, Chapter: verse.Chapter, Verse: verse.Verse, EndVerse: verse.Verse}
+			found := edition.getScripturesByReference(single)
+			if len(found) == 0 {
+				response += fmt.Sprintf("  %s: (not in this translation)\n", strings.ToUpper(name))
+				continue
+			}
+			compared.Texts = append(compared.Texts, TranslationText{Translation: name, Text: found[0].Text})
+			response += fmt.Sprintf("  %s: %s\n", strings.ToUpper(name), found[0].Text)
+		}
+		structured.Verses = append(structured.Verses, compared)
+	}
+	return mcp.NewToolResultStructured(structured, response), nil
+}
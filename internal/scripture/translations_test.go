@@ -0,0 +1,105 @@
+package scripture
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// testWEB is a two-verse excerpt of the World English Bible
+const testWEB = `{"books": [{"book": "John", "chapters": [{"chapter": 3, "verses": [
+	{"verse": 16, "reference": "John 3:16", "text": "For God so loved the world, that he gave his one and only Son, that whoever believes in him should not perish, but have eternal life."},
+	{"verse": 17, "reference": "John 3:17", "text": "For God didn't send his Son into the world to judge the world, but that the world should be saved through him."}
+]}]}]}`
+
+func TestService_Translations(t *testing.T) {
+	state := t.TempDir()
+	t.Setenv("SCRIPTURES_STATE_DIR", state)
+	t.Setenv("SCRIPTURES_DATA_DIR", "")
+
+	translationDir := filepath.Join(state, "data", translationsDir, "web")
+	if err := os.MkdirAll(translationDir, 0755); err != nil {
+		t.Fatalf("Failed to create translation dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(translationDir, "new-testament.json"), []byte(testWEB), 0644); err != nil {
+		t.Fatalf("Failed to write translation: %v", err)
+	}
+
+	service := NewService()
+	if strings.Join(service.translationNames(), ",") != "kjv,web" {
+		t.Fatalf("Expected translations kjv and web, got %v", service.translationNames())
+	}
+	if len(service.editions) != 0 {
+		t.Errorf("Expected the translations folder not to be a language edition, got %v", service.languages())
+	}
+
+	call := func(handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error), arguments map[string]any) *mcp.CallToolResult {
+		t.Helper()
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = arguments
+		result, err := handler(context.Background(), request)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+	text := func(result *mcp.CallToolResult) string {
+		return result.Content[0].(mcp.TextContent).Text
+	}
+
+	if result := call(service.GetScripture, map[string]any{"query": "John 3:16", "translation": "WEB"}); result.IsError || !strings.Contains(text(result), "one and only Son") {
+		t.Errorf("Expected the WEB verse, got %+v", result)
+	}
+	if result := call(service.GetScripture, map[string]any{"query": "John 3:16", "translation": "kjv"}); result.IsError || !strings.Contains(text(result), "only begotten Son") {
+		t.Errorf("Expected the KJV verse, got %+v", result)
+	}
+	if result := call(service.SearchScriptures, map[string]any{"query": "didn't send", "translation": "web"}); result.IsError || !strings.Contains(text(result), "John 3:17") {
+		t.Errorf("Expected a match in the WEB, got %+v", result)
+	}
+	if result := call(service.GetChapter, map[string]any{"query": "John 3", "translation": "asv"}); !result.IsError || !strings.Contains(text(result), "available translations: kjv, web") {
+		t.Errorf("Expected an error for a translation that is not loaded, got %+v", result)
+	}
+	if result := call(service.GetScripture, map[string]any{"query": "John 3:16", "translation": "web", "language": "es"}); !result.IsError {
+		t.Errorf("Expected an error combining a language and a translation, got %+v", result)
+	}
+
+	result := call(service.CompareTranslations, map[string]any{"query": "John 3:16-18"})
+	if result.IsError {
+		t.Fatalf("Unexpected error: %+v", result)
+	}
+	structured := result.StructuredContent.(TranslationComparisonResult)
+	if structured.Reference != "John 3:16-18" || len(structured.Verses) != 3 || strings.Join(structured.Translations, ",") != "kjv,web" {
+		t.Fatalf("Unexpected comparison: %+v", structured)
+	}
+	if texts := structured.Verses[0].Texts; len(texts) != 2 || texts[0].Translation != "kjv" || !strings.Contains(texts[1].Text, "one and only") {
+		t.Errorf("Expected John 3:16 in both translations, got %+v", texts)
+	}
+	if len(structured.Verses[2].Texts) != 1 || !strings.Contains(text(result), "WEB: (not in this translation)") {
+		t.Errorf("Expected John 3:18 only in the KJV, got %+v", structured.Verses[2])
+	}
+
+	for _, arguments := range []map[string]any{
+		{},
+		{"query": "John 3"},
+		{"query": "John 3:99"},
+		{"query": "John 3:16", "translations": "web, asv"},
+	} {
+		if result := call(service.CompareTranslations, arguments); !result.IsError {
+			t.Errorf("Expected an error for %v", arguments)
+		}
+	}
+}
+
+func TestService_CompareTranslations_NoneLoaded(t *testing.T) {
+	service := &Service{scriptures: map[string][]Scripture{}}
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]any{"query": "John 3:16"}
+	result, err := service.CompareTranslations(context.Background(), request)
+	if err != nil || !result.IsError || !strings.Contains(result.Content[0].(mcp.TextContent).Text, "translations/web") {
+		t.Errorf("Expected an error naming the translations folder, got %+v %v", result, err)
+	}
+}
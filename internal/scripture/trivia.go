@@ -0,0 +1,373 @@
+package scripture
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"regexp"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// maxTriviaQuestions caps the number of questions per trivia call
+const maxTriviaQuestions = 20
+
+// triviaChoices is the number of choices offered per question, the answer included
+const triviaChoices = 4
+
+// Trivia question kinds
+const (
+	triviaWhoSaid     = "who_said"
+	triviaFinishVerse = "finish_verse"
+	triviaWhichBook   = "which_book"
+)
+
+// triviaKinds are the question kinds in the order they are asked
+var triviaKinds = []string{triviaWhoSaid, triviaFinishVerse, triviaWhichBook}
+
+// Trivia difficulty levels
+const (
+	triviaEasy   = "easy"
+	triviaMedium = "medium"
+	triviaHard   = "hard"
+)
+
+// minFinishVerseWords is the fewest words a verse needs for a finish-the-verse question
+const minFinishVerseWords = 12
+
+// speechPattern matches a verse that opens by naming its speaker, as in
+// "And Nephi said unto his brethren: ...", capturing any "the" before the
+// speaker, the speaker and the words spoken
+var speechPattern = regexp.MustCompile(`^(?:And |Now |But |Then )?(?:now )?(?:it came to pass that )?(the )?([A-Z][a-z]+|LORD) (?:said|saith|spake)(?: unto [^,:;]+)?[,:;] (.+)$`)
+
+// notSpeakers are capitalized words that speechPattern captures but that do
+// not name a speaker
+var notSpeakers = map[string]bool{
+	"He": true, "She": true, "They": true, "I": true, "We": true, "Ye": true, "It": true,
+	"Thou": true, "Who": true, "This": true, "That": true, "Thus": true, "Behold": true,
+	"Therefore": true, "Wherefore": true, "One": true, "Another": true, "All": true,
+	"And": true, "Now": true, "But": true, "Then": true, "Yea": true, "Which": true,
+	"Some": true, "Others": true,
+}
+
+// TriviaQuestion is a multiple-choice trivia question
+type TriviaQuestion struct {
+	Kind        string   `json:"kind"` // "who_said", "finish_verse" or "which_book"
+	Question    string   `json:"question"`
+	Choices     []string `json:"choices"`
+	Answer      string   `json:"answer"`
+	AnswerIndex int      `json:"answerIndex"` // Position of the answer in choices, from 0
+	Reference   string   `json:"reference"`   // The verse the question is drawn from
+}
+
+// triviaChoice is a possible answer with where it comes from, so that
+// distractors can be drawn from near or far
+type triviaChoice struct {
+	value      string
+	book       string
+	collection string
+}
+
+// Trivia generates multiple-choice questions from a book, chapter, range or
+// collection: who said a quotation, how a verse ends and which book a verse
+// is in. The same seed and arguments always produce the same questions.
+func (s *Service) Trivia(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+
+	count := 5
+	if countVal, ok := arguments["count"].(float64); ok {
+		count = min(max(int(countVal), 1), maxTriviaQuestions)
+	}
+
+	difficulty := triviaMedium
+	if value, ok := arguments["difficulty"].(string); ok && value != "" {
+		difficulty = strings.ToLower(strings.TrimSpace(value))
+		if difficulty != triviaEasy && difficulty != triviaMedium && difficulty != triviaHard {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid difficulty '%s'; use easy, medium or hard", value)), nil
+		}
+	}
+
+	kinds := triviaKinds
+	if list, _ := arguments["kinds"].(string); strings.TrimSpace(list) != "" {
+		kinds = nil
+		for _, kind := range strings.Split(list, ",") {
+			kind = strings.ToLower(strings.TrimSpace(kind))
+			if kind != triviaWhoSaid && kind != triviaFinishVerse && kind != triviaWhichBook {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid question kind '%s'; use %s", kind, strings.Join(triviaKinds, ", "))), nil
+			}
+			kinds = append(kinds, kind)
+		}
+	}
+
+	seed, err := parseSeed(arguments)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	scopeText, _ := arguments["scope"].(string)
+	scopeText = strings.TrimSpace(scopeText)
+	keep, err := s.scopeFilter(scopeText)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	questions := s.triviaQuestions(ctx, keep, kinds, difficulty, count, rand.New(rand.NewPCG(uint64(seed), 0)))
+	structured := TriviaResult{Scope: scopeText, Difficulty: difficulty, Seed: seed, Questions: append([]TriviaQuestion{}, questions...)}
+	where := ""
+	if scopeText != "" {
+		where = " from " + scopeText
+	}
+	if len(questions) == 0 {
+		return mcp.NewToolResultStructured(structured, fmt.Sprintf("No trivia questions could be made%s.", where)), nil
+	}
+
+	response := fmt.Sprintf("Scripture Trivia%s (%s, seed %d):\n", where, difficulty, seed)
+	answers := "\nAnswers:\n"
+	for i, question := range questions {
+		response += fmt.Sprintf("\n%d. %s\n", i+1, question.Question)
+		for j, choice := range question.Choices {
+			response += fmt.Sprintf("   %c. %s\n", 'A'+j, choice)
+		}
+		answers += fmt.Sprintf("%d. %c. %s (%s)\n", i+1, 'A'+question.AnswerIndex, question.Answer, question.Reference)
+	}
+	return mcp.NewToolResultStructured(structured, response+answers), nil
+}
+
+// parseSeed returns the seed argument, or a random seed when there is none.
+// Seeds are kept below 2^53 so clients that read JSON numbers as doubles can
+// pass them back unchanged.
+func parseSeed(arguments map[string]any) (int64, error) {
+	seedVal, ok := arguments["seed"].(float64)
+	if !ok {
+		return rand.Int64N(1 << 31), nil
+	}
+	if seedVal < 0 || seedVal >= 1<<53 || seedVal != float64(int64(seedVal)) {
+		return 0, errors.New("seed must be a whole number from 0 to 2^53")
+	}
+	return int64(seedVal), nil
+}
+
+// scopeFilter returns the filter for the verses a scope covers: all
+// of them when empty, or a collection, book, chapter or verse range
+func (s *Service) scopeFilter(scope string) (func(Scripture) bool, error) {
+	if scope == "" {
+		return func(Scripture) bool { return true }, nil
+	}
+	for _, collection := range s.collections() {
+		if strings.EqualFold(scope, collection.Name) && s.hasCollection(collection.Name) {
+			return func(verse Scripture) bool { return s.bookCollections[verse.Book] == collection.Name }, nil
+		}
+	}
+	ref, err := s.parseSelector(scope)
+	if err != nil {
+		return nil, err
+	}
+	refs := []*ScriptureReference{ref}
+	return func(verse Scripture) bool { return selectorMatches(refs, verse) }, nil
+}
+
+// triviaQuestions draws up to count questions from the verses kept, taking
+// the kinds in turn. Each verse is asked about at most once.
+func (s *Service) triviaQuestions(ctx context.Context, keep func(Scripture) bool, kinds []string, difficulty string, count int, rng *rand.Rand) []TriviaQuestion {
+	verses := s.flatVerses()
+
+	// Every speaker and verse ending in the corpus can be a distractor, but
+	// questions are only asked about the verses in scope
+	var speakers, endings, books []triviaChoice
+	var quoted, long, all []int
+	seenBooks := make(map[string]bool)
+	for i, verse := range verses {
+		collection := s.bookCollections[verse.Book]
+		if !seenBooks[verse.Book] {
+			seenBooks[verse.Book] = true
+			books = append(books, triviaChoice{value: verse.Book, book: verse.Book, collection: collection})
+		}
+		if speaker, _, ok := quotedSpeech(verse.Text); ok {
+			speakers = append(speakers, triviaChoice{value: speaker, book: verse.Book, collection: collection})
+			if keep(verse) {
+				quoted = append(quoted, i)
+			}
+		}
+		if len(strings.Fields(verse.Text)) >= minFinishVerseWords {
+			endings = append(endings, triviaChoice{value: verse.Text, book: verse.Book, collection: collection})
+			if keep(verse) {
+				long = append(long, i)
+			}
+		}
+		if keep(verse) {
+			all = append(all, i)
+		}
+	}
+
+	pools := map[string][]int{triviaWhoSaid: quoted, triviaFinishVerse: long, triviaWhichBook: all}
+	for _, kind := range kinds {
+		pool := pools[kind]
+		rng.Shuffle(len(pool), func(i, j int) { pool[i], pool[j] = pool[j], pool[i] })
+	}
+
+	var questions []TriviaQuestion
+	asked := make(map[int]bool)
+	for exhausted := 0; len(questions) < count && exhausted < len(kinds); {
+		if ctx.Err() != nil {
+			break
+		}
+		exhausted = 0
+		for _, kind := range kinds {
+			if len(questions) == count {
+				break
+			}
+			question, ok := TriviaQuestion{}, false
+			for !ok && len(pools[kind]) > 0 {
+				i := pools[kind][0]
+				pools[kind] = pools[kind][1:]
+				if asked[i] {
+					continue
+				}
+				verse := verses[i]
+				from := triviaChoice{book: verse.Book, collection: s.bookCollections[verse.Book]}
+				switch kind {
+				case triviaWhoSaid:
+					question, ok = whoSaidQuestion(verse, from, speakers, difficulty, rng)
+				case triviaFinishVerse:
+					question, ok = finishVerseQuestion(verse, from, endings, difficulty, rng)
+				case triviaWhichBook:
+					question, ok = whichBookQuestion(verse, from, books, difficulty, rng)
+				}
+				if ok {
+					asked[i] = true
+				}
+			}
+			if !ok {
+				exhausted++
+				continue
+			}
+			questions = append(questions, question)
+		}
+	}
+	return questions
+}
+
+// quotedSpeech returns the speaker and words of a verse that opens by naming
+// who is speaking, as in "And Alma said unto them: ..."
+func quotedSpeech(text string) (string, string, bool) {
+	match := speechPattern.FindStringSubmatch(text)
+	if match == nil || notSpeakers[match[2]] || len(strings.Fields(match[3])) < 4 {
+		return "", "", false
+	}
+	// "the LORD" and "the Lord" are one speaker
+	speaker := match[2]
+	if speaker == "LORD" {
+		speaker = "Lord"
+	}
+	if match[1] != "" {
+		speaker = "The " + speaker
+	}
+	return speaker, strings.TrimSpace(match[3]), true
+}
+
+// whoSaidQuestion asks who spoke the words quoted in a verse
+func whoSaidQuestion(verse Scripture, from triviaChoice, speakers []triviaChoice, difficulty string, rng *rand.Rand) (TriviaQuestion, bool) {
+	speaker, words, _ := quotedSpeech(verse.Text)
+	question := fmt.Sprintf("Who said this: \"%s\"", words)
+	return multipleChoice(triviaWhoSaid, question, speaker, verse, from, speakers, nil, difficulty, rng)
+}
+
+// finishVerseQuestion shows the beginning of a verse and asks how it ends.
+// Harder questions show less of the verse.
+func finishVerseQuestion(verse Scripture, from triviaChoice, endings []triviaChoice, difficulty string, rng *rand.Rand) (TriviaQuestion, bool) {
+	words := strings.Fields(verse.Text)
+	shown := len(words) / 2
+	switch difficulty {
+	case triviaEasy:
+		shown = len(words) * 2 / 3
+	case triviaHard:
+		shown = len(words) / 3
+	}
+
+	// Each distractor ends another verse with as many words
+	ending := len(words) - shown
+	tail := func(text string) (string, bool) {
+		fields := strings.Fields(text)
+		if len(fields) <= ending {
+			return "", false
+		}
+		return "…" + strings.Join(fields[len(fields)-ending:], " "), true
+	}
+	question := fmt.Sprintf("Finish the verse: \"%s…\"", strings.Join(words[:shown], " "))
+	answer := "…" + strings.Join(words[shown:], " ")
+	return multipleChoice(triviaFinishVerse, question, answer, verse, from, endings, tail, difficulty, rng)
+}
+
+// whichBookQuestion asks which book a verse is in
+func whichBookQuestion(verse Scripture, from triviaChoice, books []triviaChoice, difficulty string, rng *rand.Rand) (TriviaQuestion, bool) {
+	question := fmt.Sprintf("Which book contains this verse: \"%s\"", verse.Text)
+	return multipleChoice(triviaWhichBook, question, verse.Book, verse, from, books, nil, difficulty, rng)
+}
+
+// multipleChoice completes a question with distractors drawn from the
+// candidates, through label when given, and shuffles the choices. Easy
+// questions draw distractors from other collections, so they are told apart
+// at a glance; hard questions draw them from the same book, or else the same
+// collection. A question without at least one distractor is dropped.
+func multipleChoice(kind, question, answer string, verse Scripture, from triviaChoice, candidates []triviaChoice, label func(string) (string, bool), difficulty string, rng *rand.Rand) (TriviaQuestion, bool) {
+	anywhere := func(triviaChoice) bool { return true }
+	tiers := []func(triviaChoice) bool{anywhere}
+	switch difficulty {
+	case triviaEasy:
+		tiers = []func(triviaChoice) bool{
+			func(c triviaChoice) bool { return c.collection != from.collection },
+			anywhere,
+		}
+	case triviaHard:
+		tiers = []func(triviaChoice) bool{
+			func(c triviaChoice) bool { return c.book == from.book },
+			func(c triviaChoice) bool { return c.collection == from.collection },
+			anywhere,
+		}
+	}
+
+	choices := []string{answer}
+	used := map[string]bool{answer: true}
+	for _, tier := range tiers {
+		var pool []int
+		for i, candidate := range candidates {
+			if tier(candidate) {
+				pool = append(pool, i)
+			}
+		}
+		for len(choices) < triviaChoices && len(pool) > 0 {
+			k := rng.IntN(len(pool))
+			value, ok := candidates[pool[k]].value, true
+			if label != nil {
+				value, ok = label(value)
+			}
+			if ok && !used[value] {
+				used[value] = true
+				choices = append(choices, value)
+			}
+			pool[k] = pool[len(pool)-1]
+			pool = pool[:len(pool)-1]
+		}
+	}
+	if len(choices) < 2 {
+		return TriviaQuestion{}, false
+	}
+
+	rng.Shuffle(len(choices), func(i, j int) { choices[i], choices[j] = choices[j], choices[i] })
+	index := 0
+	for i, choice := range choices {
+		if choice == answer {
+			index = i
+		}
+	}
+	return TriviaQuestion{
+		Kind:        kind,
+		Question:    question,
+		Choices:     choices,
+		Answer:      answer,
+		AnswerIndex: index,
+		Reference:   verse.Reference,
+	}, true
+}
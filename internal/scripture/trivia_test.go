@@ -0,0 +1,104 @@
+package scripture
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestQuotedSpeech(t *testing.T) {
+	tests := []struct {
+		text    string
+		speaker string
+		words   string
+	}{
+		{text: "And Ammon said unto him: I am a man; and man in the beginning was created after the image of God.", speaker: "Ammon", words: "I am a man; and man in the beginning was created after the image of God."},
+		{text: "And the LORD said unto Moses, Stretch out thine hand over the sea.", speaker: "The Lord", words: "Stretch out thine hand over the sea."},
+		{text: "And he said unto them, Go ye into all the world.", speaker: ""},
+		{text: "And Nephi said unto them: Yea.", speaker: ""},
+		{text: "In the beginning God created the heaven and the earth.", speaker: ""},
+	}
+	for _, tt := range tests {
+		speaker, words, ok := quotedSpeech(tt.text)
+		if speaker != tt.speaker || words != tt.words || ok != (tt.speaker != "") {
+			t.Errorf("quotedSpeech(%q): expected %q %q, got %q %q", tt.text, tt.speaker, tt.words, speaker, words)
+		}
+	}
+}
+
+func TestService_Trivia(t *testing.T) {
+	service := NewService()
+	trivia := func(arguments map[string]any) *mcp.CallToolResult {
+		t.Helper()
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = arguments
+		result, err := service.Trivia(context.Background(), request)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return result
+	}
+
+	arguments := map[string]any{"scope": "Book of Mormon", "count": float64(6), "seed": float64(42)}
+	result := trivia(arguments)
+	if result.IsError {
+		t.Fatalf("Unexpected error: %+v", result)
+	}
+	structured := result.StructuredContent.(TriviaResult)
+	if structured.Seed != 42 || structured.Difficulty != triviaMedium || len(structured.Questions) != 6 {
+		t.Fatalf("Unexpected trivia: %+v", structured)
+	}
+	seen := make(map[string]bool)
+	for i, question := range structured.Questions {
+		if question.Kind != triviaKinds[i%len(triviaKinds)] {
+			t.Errorf("Question %d: expected kind %s, got %s", i+1, triviaKinds[i%len(triviaKinds)], question.Kind)
+		}
+		if len(question.Choices) != triviaChoices || question.Choices[question.AnswerIndex] != question.Answer {
+			t.Errorf("Question %d: expected %d choices with the answer at %d, got %+v", i+1, triviaChoices, question.AnswerIndex, question)
+		}
+		ref, err := service.parseReference(question.Reference)
+		if err != nil || service.bookCollections[ref.Book] != "Book of Mormon" || seen[question.Reference] {
+			t.Errorf("Question %d: expected a new Book of Mormon verse, got %s", i+1, question.Reference)
+		}
+		seen[question.Reference] = true
+	}
+
+	// The same seed gives the same questions
+	if again := trivia(arguments).StructuredContent.(TriviaResult); !reflect.DeepEqual(again, structured) {
+		t.Errorf("Expected the same questions for the same seed")
+	}
+
+	// Easy distractors come from other collections, hard ones from the same book
+	easy := trivia(map[string]any{"scope": "Alma", "kinds": "which_book", "difficulty": "easy", "seed": float64(1)}).StructuredContent.(TriviaResult)
+	for _, question := range easy.Questions {
+		for _, choice := range question.Choices {
+			if choice != question.Answer && service.bookCollections[choice] == "Book of Mormon" {
+				t.Errorf("Expected easy distractors outside the Book of Mormon, got %v", question.Choices)
+			}
+		}
+	}
+	hard := trivia(map[string]any{"scope": "Alma 30", "kinds": "who_said", "difficulty": "hard", "seed": float64(1)}).StructuredContent.(TriviaResult)
+	if len(hard.Questions) == 0 {
+		t.Fatal("Expected who_said questions in Alma 30")
+	}
+	for _, question := range hard.Questions {
+		if !slices.Contains(question.Choices, "Korihor") && !slices.Contains(question.Choices, "Alma") {
+			t.Errorf("Expected hard distractors from Alma, got %v", question.Choices)
+		}
+	}
+
+	for _, arguments := range []map[string]any{
+		{"difficulty": "impossible"},
+		{"kinds": "who_said, riddles"},
+		{"seed": float64(-1)},
+		{"seed": 1.5},
+		{"scope": "Hezekiah"},
+	} {
+		if result := trivia(arguments); !result.IsError {
+			t.Errorf("Expected an error for %v", arguments)
+		}
+	}
+}
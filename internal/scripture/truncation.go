@@ -0,0 +1,40 @@
+package scripture
+
+import "fmt"
+
+// Truncation describes results left out of a response, so callers know to
+// page instead of assuming the result set is complete
+type Truncation struct {
+	Shown      int    `json:"shown"`
+	Total      int    `json:"total"`
+	Remaining  int    `json:"remaining"`
+	NextOffset int    `json:"nextOffset"`
+	NextCursor string `json:"nextCursor,omitempty"` // Set by tools that page with cursors
+}
+
+// newTruncation returns the truncation notice for a page of results, or nil
+// if the page reaches the last result
+func newTruncation(offset, shown, total int) *Truncation {
+	next := offset + shown
+	if next >= total {
+		return nil
+	}
+	return &Truncation{Shown: shown, Total: total, Remaining: total - next, NextOffset: next}
+}
+
+// String renders the notice appended to text output
+func (t *Truncation) String() string {
+	notice := fmt.Sprintf("%d more %s; call again with offset=%d.", t.Remaining, pluralize(t.Remaining, "result", "results"), t.NextOffset)
+	if t.NextCursor != "" {
+		notice += fmt.Sprintf(" Next page cursor: %s", t.NextCursor)
+	}
+	return notice
+}
+
+// pluralize picks the singular or plural form for a count
+func pluralize(count int, singular, plural string) string {
+	if count == 1 {
+		return singular
+	}
+	return plural
+}
@@ -0,0 +1,174 @@
+package scripture
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestNewTruncation(t *testing.T) {
+	tests := []struct {
+		name     string
+		offset   int
+		shown    int
+		total    int
+		expected string
+	}{
+		{name: "First page", offset: 0, shown: 10, total: 47, expected: "37 more results; call again with offset=10."},
+		{name: "One left", offset: 10, shown: 10, total: 21, expected: "1 more result; call again with offset=20."},
+		{name: "Last page", offset: 40, shown: 7, total: 47},
+		{name: "Everything shown", offset: 0, shown: 3, total: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			truncation := newTruncation(tt.offset, tt.shown, tt.total)
+			if tt.expected == "" {
+				if truncation != nil {
+					t.Errorf("Expected no truncation, got %q", truncation.String())
+				}
+				return
+			}
+			if truncation == nil {
+				t.Fatalf("Expected truncation %q, got none", tt.expected)
+			}
+			if truncation.String() != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, truncation.String())
+			}
+		})
+	}
+}
+
+func TestService_SearchScriptures_Truncation(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+
+	// Add test data
+	service.recordBook("1 Nephi", "Book of Mormon")
+	service.recordBook("Alma", "Book of Mormon")
+	service.scriptures["1 Nephi"] = []Scripture{
+		{Book: "1 Nephi", Chapter: 3, Verse: 7, Text: "the Lord hath commanded", Reference: "1 Nephi 3:7"},
+		{Book: "1 Nephi", Chapter: 3, Verse: 8, Text: "blessed of the Lord", Reference: "1 Nephi 3:8"},
+	}
+	service.scriptures["Alma"] = []Scripture{
+		{Book: "Alma", Chapter: 32, Verse: 21, Text: "faith is not to have a perfect knowledge", Reference: "Alma 32:21"},
+		{Book: "Alma", Chapter: 37, Verse: 37, Text: "Counsel with the Lord in all thy doings", Reference: "Alma 37:37"},
+	}
+
+	tests := []struct {
+		name             string
+		arguments        map[string]interface{}
+		expectError      bool
+		shouldContain    []string
+		shouldNotContain []string
+	}{
+		{
+			name:          "First page",
+			arguments:     map[string]interface{}{"query": "Lord", "limit": float64(2)},
+			shouldContain: []string{"1. 1 Nephi 3:7", "2. 1 Nephi 3:8", "1 more result; call again with offset=2."},
+		},
+		{
+			name:             "Last page",
+			arguments:        map[string]interface{}{"query": "Lord", "limit": float64(2), "offset": float64(2)},
+			shouldContain:    []string{"3. Alma 37:37"},
+			shouldNotContain: []string{"more result"},
+		},
+		{
+			name:          "Past the end",
+			arguments:     map[string]interface{}{"query": "Lord", "offset": float64(10)},
+			shouldContain: []string{"offset 10 is past the last of 3 matches"},
+		},
+		{
+			name:        "Negative offset",
+			arguments:   map[string]interface{}{"query": "Lord", "offset": float64(-1)},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+			result, err := service.SearchScriptures(context.Background(), request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.IsError != tt.expectError {
+				t.Fatalf("Expected IsError = %v, got %v", tt.expectError, result.IsError)
+			}
+			text := result.Content[0].(mcp.TextContent).Text
+			for _, expected := range tt.shouldContain {
+				if !strings.Contains(text, expected) {
+					t.Errorf("Expected output to contain %q, got:\n%s", expected, text)
+				}
+			}
+			for _, unexpected := range tt.shouldNotContain {
+				if strings.Contains(text, unexpected) {
+					t.Errorf("Expected output not to contain %q, got:\n%s", unexpected, text)
+				}
+			}
+		})
+	}
+}
+
+func TestService_SearchAll_Truncation(t *testing.T) {
+	service := &Service{
+		scriptures: make(map[string][]Scripture),
+	}
+
+	// Add test data
+	service.recordBook("1 Nephi", "Book of Mormon")
+	service.scriptures["1 Nephi"] = []Scripture{
+		{Book: "1 Nephi", Chapter: 3, Verse: 7, Text: "the Lord hath commanded", Reference: "1 Nephi 3:7"},
+		{Book: "1 Nephi", Chapter: 3, Verse: 8, Text: "blessed of the Lord", Reference: "1 Nephi 3:8"},
+		{Book: "1 Nephi", Chapter: 17, Verse: 50, Text: "If God had commanded me", Reference: "1 Nephi 17:50"},
+	}
+
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: map[string]interface{}{"query": "Lord", "limit": float64(1)},
+		},
+	}
+	result, err := service.SearchAll(context.Background(), request)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	text := result.Content[0].(mcp.TextContent).Text
+	if !strings.Contains(text, "1 more result; call search_scriptures") {
+		t.Errorf("Expected truncation notice, got:\n%s", text)
+	}
+	truncated, ok := result.Meta.AdditionalFields["truncated"].(map[string]int)
+	if !ok || truncated["Book of Mormon"] != 1 {
+		t.Errorf("Expected 1 truncated Book of Mormon result in _meta, got %v", result.Meta.AdditionalFields["truncated"])
+	}
+}
+
+func TestService_splitByCollection(t *testing.T) {
+	service := &Service{scriptures: make(map[string][]Scripture)}
+	service.recordBook("1 Nephi", "Book of Mormon")
+	service.recordBook("John", "New Testament")
+	matches := []Scripture{
+		{Book: "1 Nephi", Reference: "1 Nephi 3:7"},
+		{Book: "John", Reference: "John 3:16"},
+		{Book: "1 Nephi", Reference: "1 Nephi 3:8"},
+		{Book: "1 Nephi", Reference: "1 Nephi 17:50"},
+	}
+
+	shown, counts := service.splitByCollection(matches, 2)
+	if len(shown["Book of Mormon"]) != 2 || shown["Book of Mormon"][1].Reference != "1 Nephi 3:8" || counts["Book of Mormon"] != 3 {
+		t.Errorf("Expected the first 2 of 3 Book of Mormon matches, got %v of %d", shown["Book of Mormon"], counts["Book of Mormon"])
+	}
+	if len(shown["New Testament"]) != 1 || counts["New Testament"] != 1 {
+		t.Errorf("Expected the New Testament match, got %v of %d", shown["New Testament"], counts["New Testament"])
+	}
+	if len(shown["Old Testament"]) != 0 || counts["Old Testament"] != 0 {
+		t.Errorf("Expected no Old Testament matches, got %v", shown["Old Testament"])
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// logConfig is how operational logs are written, from the SCRIPTURES_LOG_*
+// environment variables. Logs never go to stdout, which carries the stdio
+// protocol stream.
+type logConfig struct {
+	level slog.Level
+	json  bool   // JSON lines instead of key=value text
+	file  string // Append to this file instead of stderr
+}
+
+// parseLogConfig reads SCRIPTURES_LOG_LEVEL (debug, info, warn or error;
+// default info), SCRIPTURES_LOG_FORMAT (text or json; default text) and
+// SCRIPTURES_LOG_FILE (default stderr)
+func parseLogConfig(getenv func(string) string) (logConfig, error) {
+	config := logConfig{level: slog.LevelInfo, file: strings.TrimSpace(getenv("SCRIPTURES_LOG_FILE"))}
+	if level := strings.TrimSpace(getenv("SCRIPTURES_LOG_LEVEL")); level != "" {
+		if err := config.level.UnmarshalText([]byte(level)); err != nil {
+			return logConfig{}, fmt.Errorf("invalid SCRIPTURES_LOG_LEVEL '%s': use debug, info, warn or error", level)
+		}
+	}
+	switch format := strings.ToLower(strings.TrimSpace(getenv("SCRIPTURES_LOG_FORMAT"))); format {
+	case "", "text":
+	case "json":
+		config.json = true
+	default:
+		return logConfig{}, fmt.Errorf("invalid SCRIPTURES_LOG_FORMAT '%s': use text or json", format)
+	}
+	return config, nil
+}
+
+// newLogger returns a logger writing to w in the configured format and level
+func (c logConfig) newLogger(w io.Writer) *slog.Logger {
+	options := &slog.HandlerOptions{Level: c.level}
+	if c.json {
+		return slog.New(slog.NewJSONHandler(w, options))
+	}
+	return slog.New(slog.NewTextHandler(w, options))
+}
+
+// setupLogging makes the configured logger the default for slog and the log
+// package. The returned function closes the log file, if any.
+func setupLogging() (func(), error) {
+	config, err := parseLogConfig(os.Getenv)
+	if err != nil {
+		return nil, err
+	}
+	var out io.Writer = os.Stderr
+	closeLog := func() {}
+	if config.file != "" {
+		file, err := os.OpenFile(config.file, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("open log file: %w", err)
+		}
+		out = file
+		closeLog = func() { file.Close() }
+	}
+	slog.SetDefault(config.newLogger(out))
+	return closeLog, nil
+}
+
+// fatal logs an error that stops the server and exits
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
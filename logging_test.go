@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cpuchip/scriptures-mcp/internal/scripture"
+)
+
+func TestParseLogConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		env         map[string]string
+		expected    logConfig
+		expectError string
+	}{
+		{name: "Defaults", env: nil, expected: logConfig{level: slog.LevelInfo}},
+		{name: "Debug JSON", env: map[string]string{"SCRIPTURES_LOG_LEVEL": "debug", "SCRIPTURES_LOG_FORMAT": "JSON"}, expected: logConfig{level: slog.LevelDebug, json: true}},
+		{name: "Warn to file", env: map[string]string{"SCRIPTURES_LOG_LEVEL": "WARN", "SCRIPTURES_LOG_FILE": "/var/log/scriptures.log"}, expected: logConfig{level: slog.LevelWarn, file: "/var/log/scriptures.log"}},
+		{name: "Text", env: map[string]string{"SCRIPTURES_LOG_FORMAT": "text"}, expected: logConfig{level: slog.LevelInfo}},
+		{name: "Unknown level", env: map[string]string{"SCRIPTURES_LOG_LEVEL": "loud"}, expectError: "SCRIPTURES_LOG_LEVEL"},
+		{name: "Unknown format", env: map[string]string{"SCRIPTURES_LOG_FORMAT": "xml"}, expectError: "SCRIPTURES_LOG_FORMAT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := parseLogConfig(func(key string) string { return tt.env[key] })
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if config != tt.expected {
+				t.Errorf("Expected %+v, got %+v", tt.expected, config)
+			}
+		})
+	}
+}
+
+func TestLogConfig_NewLogger(t *testing.T) {
+	var out bytes.Buffer
+	logger := logConfig{level: slog.LevelWarn, json: true}.newLogger(&out)
+	logger.Info("Hidden below the level")
+	logger.Warn("Could not read data file", "path", "data/bom.json")
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("Expected only the warning to be logged, got:\n%s", out.String())
+	}
+	var record map[string]any
+	if err := json.Unmarshal([]byte(lines[0]), &record); err != nil {
+		t.Fatalf("Expected a JSON record, got %q: %v", lines[0], err)
+	}
+	if record["level"] != "WARN" || record["msg"] != "Could not read data file" || record["path"] != "data/bom.json" {
+		t.Errorf("Unexpected record: %v", record)
+	}
+}
+
+func TestDataLoading_KeepsStdoutClean(t *testing.T) {
+	state := t.TempDir()
+	t.Setenv("SCRIPTURES_STATE_DIR", state)
+	t.Setenv("SCRIPTURES_DATA_DIR", "")
+	t.Setenv("SCRIPTURES_INDEX_CACHE", "off")
+	logFile := filepath.Join(t.TempDir(), "server.log")
+	t.Setenv("SCRIPTURES_LOG_FILE", logFile)
+
+	// Broken optional datasets make loading warn
+	dataDir := filepath.Join(state, "data")
+	if err := os.MkdirAll(dataDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"footnotes.json", "chapter-questions.json", "bible-dictionary.json"} {
+		if err := os.WriteFile(filepath.Join(dataDir, name), []byte("{not json"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	defaultLogger := slog.Default()
+	t.Cleanup(func() { slog.SetDefault(defaultLogger) })
+	closeLog, err := setupLogging()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer closeLog()
+
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = writer
+	scripture.NewService()
+	os.Stdout = stdout
+	writer.Close()
+	written, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(written) != 0 {
+		t.Errorf("Expected nothing on stdout while loading, got:\n%s", written)
+	}
+
+	logged, err := os.ReadFile(logFile)
+	if err != nil || !strings.Contains(string(logged), "level=WARN") {
+		t.Errorf("Expected the loading warnings in the log, got %q %v", logged, err)
+	}
+}
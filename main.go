@@ -1,7 +1,13 @@
 package main
 
 import (
-	"log"
+	"context"
+	"errors"
+	"flag"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
@@ -9,19 +15,108 @@ import (
 )
 
 func main() {
+	closeLog, err := setupLogging()
+	if err != nil {
+		fatal("Logging configuration failed", err)
+	}
+	defer closeLog()
+
+	if len(os.Args) > 1 && os.Args[1] == "sync-data" {
+		runSyncData(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "diff-data" {
+		runDiffData(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "slim-data" {
+		runSlimData(os.Args[2:])
+		return
+	}
+
+	doctor := flag.Bool("doctor", false, "Run data and query self-tests, print a pass/fail report and exit")
+	exportVault := flag.String("export-vault", "", "Write every chapter as a markdown note into this directory and exit")
+	dumpFixture := flag.String("dump-fixture", "", "Write the selected books, chapters or verses (e.g. 'John 3; Enos') as a test fixture to stdout and exit")
+	httpAddr := flag.String("http", "", "Serve MCP over Streamable HTTP at this address (e.g. :8080) instead of stdio")
+	stateDir := flag.String("state-dir", "", "Keep per-user data in this directory instead of the OS default (overrides SCRIPTURES_STATE_DIR)")
+	flag.Parse()
+
+	if *stateDir != "" {
+		os.Setenv("SCRIPTURES_STATE_DIR", *stateDir)
+	}
+
+	if *dumpFixture != "" {
+		scriptureService := scripture.NewService()
+		if err := scriptureService.DumpFixture(os.Stdout, strings.Split(*dumpFixture, ";")); err != nil {
+			fatal("Fixture dump failed", err)
+		}
+		return
+	}
+
+	if *exportVault != "" {
+		scriptureService := scripture.NewService()
+		written, err := scriptureService.ExportMarkdownVault(*exportVault)
+		if err != nil {
+			fatal("Vault export failed", err)
+		}
+		slog.Info("Wrote vault notes", "notes", written, "dir", *exportVault)
+		return
+	}
+
+	if *doctor {
+		scriptureService := scripture.NewService()
+		if !scripture.WriteDoctorReport(os.Stdout, scriptureService.Doctor()) {
+			os.Exit(1)
+		}
+		return
+	}
+
+	// In HTTP mode, listen before loading the data so health probes pass
+	// while the server warms up
+	var frontend *httpFrontend
+	var served <-chan error
+	if *httpAddr != "" {
+		frontend = &httpFrontend{}
+		var err error
+		if served, err = startHTTP(*httpAddr, frontend.handler()); err != nil {
+			fatal("HTTP server failed to start", err)
+		}
+	}
+
+	// Send operational logs to MCP clients as well, keeping startup warnings
+	// until a client asks for them
+	clientLogs := newClientLogs()
+	slog.SetDefault(slog.New(clientLogs.handler(slog.Default().Handler())))
+
+	// Trace tool calls with correlation IDs and the optional audit log
+	tracer, closeAudit, err := newCallTracer(os.Getenv)
+	if err != nil {
+		fatal("Call tracing configuration failed", err)
+	}
+	defer closeAudit()
+
 	// Create a new MCP server
 	mcpServer := server.NewMCPServer(
 		"LDS Scriptures MCP Server",
 		"1.0.0",
 		server.WithToolCapabilities(true),
+		server.WithResourceCapabilities(false, false),
+		server.WithPromptCapabilities(false),
+		server.WithLogging(),
+		server.WithHooks(clientLogs.hooks()),
+		server.WithToolHandlerMiddleware(tracer.middleware),
+		server.WithToolHandlerMiddleware(recoverToolPanics),
 	)
+	clientLogs.attach(mcpServer)
 	
 	// Initialize scripture service
 	scriptureService := scripture.NewService()
+	registry := &toolRegistry{server: mcpServer, readLock: scriptureService.ReadLocked, hasDataset: scriptureService.HasDataset}
 	
 	// Create and register search_scriptures tool
 	searchTool := mcp.NewTool("search_scriptures",
 		mcp.WithDescription("Search for scriptures by keyword or phrase across all standard works"),
+		mcp.WithOutputSchema[scripture.SearchResult](),
 		mcp.WithString("query",
 			mcp.Required(),
 			mcp.Description("The keyword or phrase to search for in scripture text"),
@@ -29,31 +124,621 @@ func main() {
 		mcp.WithNumber("limit",
 			mcp.Description("Maximum number of results to return (default: 10)"),
 		),
+		mcp.WithNumber("offset",
+			mcp.Description("Number of matches to skip, for paging through results (default: 0)"),
+		),
+		mcp.WithString("cursor",
+			mcp.Description("Cursor from a previous response's next page notice, to continue the same search (instead of offset)"),
+		),
+		mcp.WithBoolean("prefer_simple",
+			mcp.Description("Order results from easiest to hardest to read, e.g. for young readers or ESL learners (default: false)"),
+		),
+		mcp.WithString("sort",
+			mcp.Description("Result order: canonical (matches of the exact text, in book order) or relevance (verses with any of the query's words, ranked by BM25 score) (default: canonical)"),
+			mcp.Enum("canonical", "relevance"),
+		),
+		mcp.WithString("mode",
+			mcp.Description("How the query is matched: phrase (verbatim), all_words (every word anywhere in the verse) or any_word (at least one word) (default: phrase)"),
+			mcp.Enum("phrase", "all_words", "any_word"),
+		),
+		mcp.WithBoolean("highlight",
+			mcp.Description("Wrap the matched words in each result in markers, **bold** by default (default: false)"),
+		),
+		mcp.WithString("highlight_open",
+			mcp.Description("Marker placed before each match when highlighting (default: **)"),
+		),
+		mcp.WithString("highlight_close",
+			mcp.Description("Marker placed after each match when highlighting (default: same as highlight_open)"),
+		),
+		mcp.WithBoolean("explain",
+			mcp.Description("Also report the query plan: how the matches were found and why (default: false)"),
+		),
+		mcp.WithString("language",
+			mcp.Description("Language edition to use, e.g. \"es\" or \"pt\", when one is loaded from the data directory (default: en)"),
+		),
+		mcp.WithString("translation",
+			mcp.Description("Bible translation to use, e.g. \"web\" or \"asv\", when one is loaded from the data directory (default: kjv)"),
+		),
+	)
+	registry.add(groupSearch, searchTool, scriptureService.RequireCitations(scripture.RepairQuery(scriptureService.SearchScriptures)))
+	
+	// Create and register search_help tool
+	searchHelpTool := mcp.NewTool("search_help",
+		mcp.WithDescription("Describe the query syntax supported by search_scriptures and search_all, with examples"),
+		mcp.WithOutputSchema[scripture.SearchHelpResult](),
 	)
-	mcpServer.AddTool(searchTool, scriptureService.SearchScriptures)
+	registry.add(groupSearch, searchHelpTool, scriptureService.SearchHelp)
+	
+	// Create and register search_all tool
+	searchAllTool := mcp.NewTool("search_all",
+		mcp.WithDescription("Search every loaded collection at once, grouping results per collection"),
+		mcp.WithOutputSchema[scripture.SearchAllResult](),
+		mcp.WithString("query",
+			mcp.Required(),
+			mcp.Description("The keyword or phrase to search for in scripture text"),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description("Maximum number of results to return for each collection (default: 5)"),
+		),
+	)
+	registry.add(groupSearch, searchAllTool, scriptureService.RequireCitations(scripture.RepairQuery(scriptureService.SearchAll)))
 	
 	// Create and register get_scripture tool
 	getScriptureTool := mcp.NewTool("get_scripture",
-		mcp.WithDescription("Retrieve specific scripture verses by reference"),
+		mcp.WithDescription("Retrieve specific scripture verses by reference. Request several passages at once with a semicolon-separated query or the references array; the verses are grouped per reference."),
+		mcp.WithOutputSchema[scripture.PassageResult](),
 		mcp.WithString("query",
-			mcp.Required(),
-			mcp.Description("Scripture reference like '1 Nephi 3:7' or 'John 3:16-17'"),
+			mcp.Description("Scripture reference like '1 Nephi 3:7' or 'John 3:16-17', or several separated by semicolons like 'John 3:16; Moroni 10:4-5; D&C 4:2'"),
+		),
+		mcp.WithArray("references",
+			mcp.Description("Scripture references to retrieve together, like ['John 3:16', 'Moroni 10:4-5']"),
+			mcp.WithStringItems(),
+		),
+		mcp.WithNumber("context_verses",
+			mcp.Description("Number of surrounding verses (0-10) to include before and after each passage, marked as context (default: 0)"),
+		),
+		mcp.WithString("format",
+			mcp.Description("Output format: 'prose' (default), 'poetry' to break poetic books like Psalms and Isaiah into lines, 'speech' for reading aloud or 'accessible' for screen readers and braille displays"),
+			mcp.Enum(scripture.OutputFormats...),
+		),
+		mcp.WithString("language",
+			mcp.Description("Language edition to use, e.g. \"es\" or \"pt\", when one is loaded from the data directory (default: en)"),
+		),
+		mcp.WithString("translation",
+			mcp.Description("Bible translation to use, e.g. \"web\" or \"asv\", when one is loaded from the data directory (default: kjv)"),
 		),
 	)
-	mcpServer.AddTool(getScriptureTool, scriptureService.GetScripture)
+	registry.add(groupReading, getScriptureTool, scriptureService.RequireCitations(scripture.RepairQuery(scriptureService.GetScripture)))
 	
 	// Create and register get_chapter tool
 	getChapterTool := mcp.NewTool("get_chapter",
 		mcp.WithDescription("Retrieve complete chapters from scriptures"),
+		mcp.WithOutputSchema[scripture.ChapterResult](),
 		mcp.WithString("query",
 			mcp.Required(),
 			mcp.Description("Chapter reference like '1 Nephi 3' or 'Matthew 5'"),
 		),
+		mcp.WithString("format",
+			mcp.Description("Output format: 'prose' (default), 'poetry' to break poetic books like Psalms and Isaiah into lines, 'speech' for reading aloud or 'accessible' for screen readers and braille displays"),
+			mcp.Enum(scripture.OutputFormats...),
+		),
+		mcp.WithString("language",
+			mcp.Description("Language edition to use, e.g. \"es\" or \"pt\", when one is loaded from the data directory (default: en)"),
+		),
+		mcp.WithString("translation",
+			mcp.Description("Bible translation to use, e.g. \"web\" or \"asv\", when one is loaded from the data directory (default: kjv)"),
+		),
+	)
+	registry.add(groupReading, getChapterTool, scriptureService.RequireCitations(scripture.RepairQuery(scriptureService.GetChapter)))
+	
+	// Create and register get_book tool
+	getBookTool := mcp.NewTool("get_book",
+		mcp.WithDescription("Retrieve a whole book in one call, such as Enos, Jarom or Jude, paging through books with more chapters than max_chapters"),
+		mcp.WithOutputSchema[scripture.BookResult](),
+		mcp.WithString("book",
+			mcp.Required(),
+			mcp.Description("Book name or abbreviation (e.g., \"Enos\", \"Jude\", \"3 Jn\")"),
+		),
+		mcp.WithNumber("start_chapter",
+			mcp.Description("First chapter to return, for the next page of a longer book (default: the first chapter)"),
+		),
+		mcp.WithNumber("max_chapters",
+			mcp.Description("Most chapters to return in one call, 1 to 10 (default: 5)"),
+		),
+		mcp.WithString("format",
+			mcp.Description("Output format: 'prose' (default), 'poetry' to break poetic books like Psalms and Isaiah into lines, 'speech' for reading aloud or 'accessible' for screen readers and braille displays"),
+			mcp.Enum(scripture.OutputFormats...),
+		),
 	)
-	mcpServer.AddTool(getChapterTool, scriptureService.GetChapter)
+	registry.add(groupReading, getBookTool, scriptureService.RequireCitations(scriptureService.GetBook))
 	
-	// Start the stdio server
-	if err := server.ServeStdio(mcpServer); err != nil {
-		log.Fatalf("Server failed to start: %v", err)
+	// Create and register get_book_info tool
+	bookInfoTool := mcp.NewTool("get_book_info",
+		mcp.WithDescription("Get a book's metadata without its text: collection, position in canonical order, chapter count, verse count per chapter and total words, e.g. to validate a reference before fetching it"),
+		mcp.WithOutputSchema[scripture.BookInfoResult](),
+		mcp.WithString("book",
+			mcp.Required(),
+			mcp.Description("Book name or abbreviation (e.g., \"Alma\", \"D&C\", \"Gen\")"),
+		),
+		mcp.WithNumber("chapter",
+			mcp.Description("Only list this chapter's verse and word counts"),
+		),
+	)
+	registry.add(groupReading, bookInfoTool, scriptureService.GetBookInfo)
+	
+	// Create and register get_chapter_summary tool
+	chapterSummaryTool := mcp.NewTool("get_chapter_summary",
+		mcp.WithDescription("Get a chapter's heading (its summary) without the verses, along with the book's title and heading for a first chapter"),
+		mcp.WithOutputSchema[scripture.ChapterSummaryResult](),
+		mcp.WithString("query",
+			mcp.Required(),
+			mcp.Description("Chapter reference like '1 Nephi 3' or 'D&C 4'"),
+		),
+	)
+	registry.add(groupReading, chapterSummaryTool, scripture.RepairQuery(scriptureService.GetChapterSummary))
+	
+	// Create and register outline_chapter tool
+	outlineTool := mcp.NewTool("outline_chapter",
+		mcp.WithDescription("Split a chapter into labeled sections with the verse range of each section"),
+		mcp.WithOutputSchema[scripture.OutlineResult](),
+		mcp.WithString("query",
+			mcp.Required(),
+			mcp.Description("Chapter reference like 'Alma 5' or 'Matthew 5'"),
+		),
+	)
+	registry.add(groupReading, outlineTool, scripture.RepairQuery(scriptureService.OutlineChapter))
+	
+	// Create and register get_parallel_passages tool
+	parallelTool := mcp.NewTool("get_parallel_passages",
+		mcp.WithDescription("Retrieve a passage aligned verse-by-verse with its parallel (e.g. the Isaiah chapters quoted in 2 Nephi) and a word-level diff"),
+		mcp.WithOutputSchema[scripture.ParallelResult](),
+		mcp.WithString("query",
+			mcp.Required(),
+			mcp.Description("Verse, range or chapter reference from either side, like '2 Nephi 12:16' or 'Isaiah 2'"),
+		),
+	)
+	registry.add(groupReading, parallelTool, scripture.RepairQuery(scriptureService.GetParallelPassages))
+	
+	// Create and register compare_translations tool
+	translationsTool := mcp.NewTool("compare_translations",
+		mcp.WithDescription("Show a verse or range side by side in the KJV and the other Bible translations loaded from the data directory (e.g. WEB, ASV)"),
+		mcp.WithOutputSchema[scripture.TranslationComparisonResult](),
+		mcp.WithString("query",
+			mcp.Required(),
+			mcp.Description("Verse or verse range (e.g., \"John 3:16\", \"Psalm 23:1-3\")"),
+		),
+		mcp.WithString("translations",
+			mcp.Description("Comma separated translations to compare with the KJV (default: every loaded translation)"),
+		),
+	)
+	registry.addNeeding(groupReading, scripture.DatasetTranslations, translationsTool, scripture.RepairQuery(scriptureService.CompareTranslations))
+	
+	// Create and register parallel_text tool
+	parallelTextTool := mcp.NewTool("parallel_text",
+		mcp.WithDescription("Show a chapter or verse range in two loaded language editions verse by verse, interleaved or aligned in a table, for language learners and missionaries"),
+		mcp.WithOutputSchema[scripture.ParallelTextResult](),
+		mcp.WithString("query",
+			mcp.Required(),
+			mcp.Description("Chapter or verse range, in either edition's book names (e.g., \"1 Nephi 3\", \"1 Nefi 3:7\")"),
+		),
+		mcp.WithString("languages",
+			mcp.Description("Two comma-separated language codes, the first shown first (default: the English edition and the first other loaded)"),
+		),
+		mcp.WithString("layout",
+			mcp.Description("interleaved (each verse in one language then the other) or aligned (a table with a column per language) (default: interleaved)"),
+			mcp.Enum("interleaved", "aligned"),
+		),
+	)
+	registry.addNeeding(groupReading, scripture.DatasetLanguageEditions, parallelTextTool, scripture.RepairQuery(scriptureService.ParallelText))
+	
+	// Create and register get_cross_references tool
+	crossReferencesTool := mcp.NewTool("get_cross_references",
+		mcp.WithDescription("Get the footnotes and cross-references of a verse, range or chapter with the text of the linked verses. Parallel passages are always known; footnotes need the optional footnotes dataset."),
+		mcp.WithOutputSchema[scripture.CrossReferencesResult](),
+		mcp.WithString("query",
+			mcp.Required(),
+			mcp.Description("Verse, range or chapter reference like '1 Nephi 3:7', 'Moroni 10:3-5' or '2 Nephi 12'"),
+		),
+	)
+	registry.add(groupStudy, crossReferencesTool, scripture.RepairQuery(scriptureService.GetCrossReferences))
+	
+	// Create and register bible_dictionary tool
+	dictionaryTool := mcp.NewTool("bible_dictionary",
+		mcp.WithDescription("Look up a Bible Dictionary entry (e.g. \"Ephesus\", \"Faith\") by name, tolerating misspellings, with the passages it cites as references for get_scripture. Needs the optional Bible Dictionary dataset."),
+		mcp.WithOutputSchema[scripture.DictionaryResult](),
+		mcp.WithString("entry",
+			mcp.Required(),
+			mcp.Description("Entry name, e.g. \"Ephesus\" or \"Faith\""),
+		),
+	)
+	registry.addNeeding(groupStudy, scripture.DatasetBibleDictionary, dictionaryTool, scriptureService.BibleDictionary)
+	
+	// Create and register compare_style tool
+	compareStyleTool := mcp.NewTool("compare_style",
+		mcp.WithDescription("Compare the stylometric fingerprints (function-word frequencies and sentence length distributions) of two scopes, such as Alma and Helaman, with Burrows' Delta and the most distinctive function words"),
+		mcp.WithOutputSchema[scripture.StyleComparisonResult](),
+		mcp.WithString("scope_a",
+			mcp.Required(),
+			mcp.Description("Books, chapters or verse ranges separated by semicolons (e.g., \"Alma\" or \"Romans; 1 Corinthians; Galatians\")"),
+		),
+		mcp.WithString("scope_b",
+			mcp.Required(),
+			mcp.Description("Scope to compare against, in the same form (e.g., \"Helaman\" or \"Hebrews\")"),
+		),
+	)
+	registry.add(groupStudy, compareStyleTool, scriptureService.CompareStyle)
+	
+	// Create and register lint_citations tool
+	lintTool := mcp.NewTool("lint_citations",
+		mcp.WithDescription("Check the scripture quotations in a document, such as a talk or lesson handout, against the verses they cite and report misquotes and typos with a word diff"),
+		mcp.WithOutputSchema[scripture.CitationLintResult](),
+		mcp.WithString("text",
+			mcp.Required(),
+			mcp.Description("Document text. Quotations are recognized in double quotes next to their reference, e.g. \"For God so loved the world\" (John 3:16)"),
+		),
+	)
+	registry.add(groupStudy, lintTool, scriptureService.LintCitations)
+	
+	// Create and register reference_math tool
+	refMathTool := mcp.NewTool("reference_math",
+		mcp.WithDescription("Compute relationships between references: whether one contains another, their overlap, the distance in verses between them, or split a passage into equal parts"),
+		mcp.WithOutputSchema[scripture.ReferenceMathResult](),
+		mcp.WithString("operation",
+			mcp.Required(),
+			mcp.Description("Operation to perform"),
+			mcp.Enum("contains", "overlap", "distance", "split"),
+		),
+		mcp.WithString("a",
+			mcp.Required(),
+			mcp.Description("Book, chapter or verse range (e.g., \"Alma 32:17-27\", \"Alma 32\", \"Enos\")"),
+		),
+		mcp.WithString("b",
+			mcp.Description("Second reference for contains (is b within a?), overlap and distance (from a to b)"),
+		),
+		mcp.WithNumber("parts",
+			mcp.Description("Number of parts for split"),
+		),
+	)
+	registry.add(groupStudy, refMathTool, scriptureService.ReferenceMath)
+	
+	// Create and register count_terms tool
+	countTermsTool := mcp.NewTool("count_terms",
+		mcp.WithDescription("Count how often each of many words or phrases occurs in the scripture text, in one pass over the corpus"),
+		mcp.WithOutputSchema[scripture.TermCountsResult](),
+		mcp.WithString("terms",
+			mcp.Required(),
+			mcp.Description("Comma or newline separated words or phrases to count, up to 1000 (e.g., \"faith, hope, charity\")"),
+		),
+		mcp.WithString("scope",
+			mcp.Description("Only count in this book, chapter or verse range (e.g., \"Alma\", \"Moroni 7\")"),
+		),
+		mcp.WithBoolean("skip_common",
+			mcp.Description("Skip terms that are common words of the language, such as \"the\" and \"and\" (default: false)"),
+		),
+		mcp.WithString("language",
+			mcp.Description("Language edition to use, e.g. \"es\" or \"pt\", when one is loaded from the data directory (default: en)"),
+		),
+	)
+	registry.add(groupStudy, countTermsTool, scriptureService.CountTerms)
+	
+	// Create and register get_chapter_questions tool
+	questionsTool := mcp.NewTool("get_chapter_questions",
+		mcp.WithDescription("Get study questions for a chapter: discussion questions from the optional question bank and fill-in-the-blank questions generated from the text, with answers"),
+		mcp.WithOutputSchema[scripture.ChapterQuestionsResult](),
+		mcp.WithString("query",
+			mcp.Required(),
+			mcp.Description("Chapter or verse reference (e.g., \"Alma 32\")"),
+		),
+		mcp.WithNumber("fill_in",
+			mcp.Description("Number of fill-in-the-blank questions to generate, 0 to 20 (default: 5)"),
+		),
+	)
+	registry.add(groupStudy, questionsTool, scripture.RepairQuery(scriptureService.GetChapterQuestions))
+	
+	// Create and register trivia tool
+	triviaTool := mcp.NewTool("trivia",
+		mcp.WithDescription("Generate multiple-choice scripture trivia (who said it, finish the verse, which book) with the answers. The same seed and arguments always give the same questions."),
+		mcp.WithOutputSchema[scripture.TriviaResult](),
+		mcp.WithString("scope",
+			mcp.Description("Only ask about this collection, book, chapter or verse range (e.g., \"Book of Mormon\", \"John\")"),
+		),
+		mcp.WithNumber("count",
+			mcp.Description("Number of questions, 1 to 20 (default: 5)"),
+		),
+		mcp.WithString("difficulty",
+			mcp.Description("How close the wrong choices are to the answer (default: medium)"),
+			mcp.Enum("easy", "medium", "hard"),
+		),
+		mcp.WithString("kinds",
+			mcp.Description("Comma-separated question kinds: who_said, finish_verse, which_book (default: all)"),
+		),
+		mcp.WithNumber("seed",
+			mcp.Description("Seed for reproducible questions; the seed used is returned (default: random)"),
+		),
+	)
+	registry.add(groupStudy, triviaTool, scriptureService.Trivia)
+	
+	// Create and register activity_board tool
+	boardTool := mcp.NewTool("activity_board",
+		mcp.WithDescription("Generate printable bingo-style activity boards for a block of scripture: grids of its most used phrases or of its verse references, a different board per player, with the items to call out in random order. The same seed and arguments always give the same boards."),
+		mcp.WithOutputSchema[scripture.ActivityBoardResult](),
+		mcp.WithString("scope",
+			mcp.Required(),
+			mcp.Description("The block to draw from: a collection, book, chapter or verse range (e.g., \"Alma 32\", \"Moroni\", \"Book of Mormon\")"),
+		),
+		mcp.WithString("kind",
+			mcp.Description("What the squares hold: phrases the block's verses share, or verse references with the verse text as the clue (default: phrases)"),
+			mcp.Enum("phrases", "references"),
+		),
+		mcp.WithNumber("size",
+			mcp.Description("Squares per side, 3 to 5 (default: 5, or the largest board a small block fills)"),
+		),
+		mcp.WithNumber("boards",
+			mcp.Description("Number of different boards, 1 to 40 (default: 1)"),
+		),
+		mcp.WithBoolean("free_space",
+			mcp.Description("Make the center square of an odd-sized board free (default: true)"),
+		),
+		mcp.WithNumber("seed",
+			mcp.Description("Seed for reproducible boards; the seed used is returned (default: random)"),
+		),
+	)
+	registry.add(groupStudy, boardTool, scriptureService.ActivityBoard)
+	
+	// Create and register passages_for_reading_level tool
+	readingLevelTool := mcp.NewTool("passages_for_reading_level",
+		mcp.WithDescription("Find passages on a topic for a reading level, preferring simpler verses and shorter passages, e.g. for Primary classes and ESL study groups. Uses a theme's curated passages, Topical Guide footnote links and the verses most relevant to the topic's words."),
+		mcp.WithOutputSchema[scripture.ReadingLevelResult](),
+		mcp.WithString("topic",
+			mcp.Required(),
+			mcp.Description("Theme or words to find passages on (e.g., \"prayer\", \"love one another\")"),
+		),
+		mcp.WithString("level",
+			mcp.Description("Target reading level: easy (readability score up to 15, 2 verses), moderate (25, 3 verses) or advanced (40, 5 verses) (default: easy)"),
+			mcp.Enum("easy", "moderate", "advanced"),
+		),
+		mcp.WithNumber("max_verses",
+			mcp.Description("Longest passage in verses, 1 to 10 (default: set by the level)"),
+		),
+		mcp.WithString("scope",
+			mcp.Description("Only return passages in this collection, book, chapter or verse range (e.g., \"Book of Mormon\", \"Matthew\")"),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description("Maximum number of passages to return, 1 to 20 (default: 5)"),
+		),
+	)
+	registry.add(groupStudy, readingLevelTool, scriptureService.PassagesForReadingLevel)
+	
+	// Create and register find_topic_verses tool
+	topicVersesTool := mcp.NewTool("find_topic_verses",
+		mcp.WithDescription("Find passages on a topic from a theme's curated passages, the footnotes' Topical Guide links and a relevance search, listing each passage once with the sources that suggested it, those most sources agree on first"),
+		mcp.WithOutputSchema[scripture.TopicVersesResult](),
+		mcp.WithString("topic",
+			mcp.Required(),
+			mcp.Description("Theme, Topical Guide entry or words to find passages on (e.g., \"hope\", \"Prayer\", \"love one another\")"),
+		),
+		mcp.WithString("scope",
+			mcp.Description("Only return passages in this collection, book, chapter or verse range (e.g., \"Book of Mormon\", \"Matthew\")"),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description("Maximum number of passages to return, 1 to 50 (default: 10)"),
+		),
+	)
+	registry.add(groupStudy, topicVersesTool, scriptureService.FindTopicVerses)
+	
+	// Create and register daily_digest tool
+	digestTool := mcp.NewTool("daily_digest",
+		mcp.WithDescription("Compose the study bundle for a date in one call: a verse of the day and the week's reading from the optional study schedule (such as Come, Follow Me)"),
+		mcp.WithOutputSchema[scripture.DigestResult](),
+		mcp.WithString("date",
+			mcp.Description("Date as YYYY-MM-DD (default: today)"),
+		),
+	)
+	registry.add(groupReading, digestTool, scriptureService.DailyDigest)
+	
+	// Create and register verse_of_the_day tool
+	verseOfTheDayTool := mcp.NewTool("verse_of_the_day",
+		mcp.WithDescription("Get the verse of the day for a date: the same passage for every call with the same date and filters, for daily devotional features. Optionally limited to a collection or chosen from passages on a list of topics."),
+		mcp.WithOutputSchema[scripture.VerseOfTheDayResult](),
+		mcp.WithString("date",
+			mcp.Description("Date as YYYY-MM-DD (default: today)"),
+		),
+		mcp.WithString("collection",
+			mcp.Description("Only choose from this collection (e.g., \"Book of Mormon\")"),
+		),
+		mcp.WithString("topics",
+			mcp.Description("Comma-separated topics to choose passages on (e.g., \"faith, prayer\") (default: the curated themes)"),
+		),
+	)
+	registry.add(groupReading, verseOfTheDayTool, scriptureService.RequireCitations(scriptureService.GetVerseOfTheDay))
+	
+	// Create and register search_by_theme tool
+	themeTool := mcp.NewTool("search_by_theme",
+		mcp.WithDescription("Find passages on a theme (hope, covenant, obedience, prayer or adversity) from a curated theme dataset, including verses whose wording never names the theme"),
+		mcp.WithOutputSchema[scripture.ThemeResult](),
+		mcp.WithString("theme",
+			mcp.Required(),
+			mcp.Description("Theme name or a word for it (e.g., \"hope\", \"covenants\", \"obey\", \"pray\", \"trials\")"),
+		),
+		mcp.WithString("scope",
+			mcp.Description("Only return passages in this book, chapter or verse range (e.g., \"Alma\", \"Romans 8\")"),
+		),
+	)
+	registry.add(groupSearch, themeTool, scriptureService.SearchByTheme)
+	
+	// Create and register find_duplicate_verses tool
+	duplicatesTool := mcp.NewTool("find_duplicate_verses",
+		mcp.WithDescription("Find clusters of verbatim or near-verbatim duplicate verses across the corpus, such as repeated formulas and synoptic parallels, with similarity scores"),
+		mcp.WithOutputSchema[scripture.DuplicatesResult](),
+		mcp.WithNumber("min_similarity",
+			mcp.Description("Minimum word-shingle Jaccard similarity between 0 and 1 (default: 0.8)"),
+		),
+		mcp.WithString("scope",
+			mcp.Description("Only report clusters with a verse in this book, chapter or verse range (e.g., \"Isaiah\", \"2 Nephi 12\")"),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description("Maximum number of clusters to return (default: 10)"),
+		),
+		mcp.WithNumber("offset",
+			mcp.Description("Number of clusters to skip, for paging (default: 0)"),
+		),
+	)
+	registry.add(groupSearch, duplicatesTool, scriptureService.FindDuplicateVerses)
+	
+	// Create and register find_similar_verses tool
+	similarTool := mcp.NewTool("find_similar_verses",
+		mcp.WithDescription("Find the verses most textually similar to a verse or range, ranked by shared rare terms (TF-IDF cosine similarity), such as the Isaiah chapters quoted in 2 Nephi"),
+		mcp.WithOutputSchema[scripture.SimilarVersesResult](),
+		mcp.WithString("query",
+			mcp.Required(),
+			mcp.Description("Verse or verse range (e.g., \"Isaiah 2:2\", \"Matthew 5:3-5\")"),
+		),
+		mcp.WithString("scope",
+			mcp.Description("Only return verses in this book, chapter or verse range (e.g., \"2 Nephi\", \"Luke 6\")"),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description("Maximum number of verses to return (default: 10, max: 50)"),
+		),
+	)
+	registry.add(groupSearch, similarTool, scripture.RepairQuery(scriptureService.FindSimilarVerses))
+	
+	// Create and register find_chapter_by_opening tool
+	openingTool := mcp.NewTool("find_chapter_by_opening",
+		mcp.WithDescription("Find a chapter from how it begins (e.g., \"I, Nephi, having been born of goodly parents\") or from distinctive keywords, for passages remembered by their words rather than their reference"),
+		mcp.WithOutputSchema[scripture.ChapterFinderResult](),
+		mcp.WithString("query",
+			mcp.Required(),
+			mcp.Description("The remembered opening words or keywords of the chapter"),
+		),
+		mcp.WithString("scope",
+			mcp.Description("Only return chapters in this book, chapter or verse range (e.g., \"Alma\", \"Psalms\")"),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description("Maximum number of chapters to return, 1 to 20 (default: 5)"),
+		),
+	)
+	registry.add(groupSearch, openingTool, scripture.RepairQuery(scriptureService.FindChapterByOpening))
+	
+	// Create and register get_scripture_story tool
+	storyTool := mcp.NewTool("get_scripture_story",
+		mcp.WithDescription("Retrieve age-appropriate children's retellings of a chapter from the optional scripture stories dataset"),
+		mcp.WithOutputSchema[scripture.StoriesResult](),
+		mcp.WithString("query",
+			mcp.Required(),
+			mcp.Description("Chapter or verse reference (e.g., \"1 Nephi 3\")"),
+		),
+	)
+	registry.addNeeding(groupReading, scripture.DatasetStories, storyTool, scripture.RepairQuery(scriptureService.GetScriptureStory))
+	
+	// Create and register export_anki_deck tool
+	ankiTool := mcp.NewTool("export_anki_deck",
+		mcp.WithDescription("Export scripture references as an Anki-importable TSV deck of reference/text cards"),
+		mcp.WithOutputSchema[scripture.AnkiDeckResult](),
+		mcp.WithString("references",
+			mcp.Required(),
+			mcp.Description("Semicolon or newline separated references like 'John 3:16; Moroni 10:4-5'"),
+		),
+	)
+	registry.add(groupExport, ankiTool, scriptureService.ExportAnkiDeck)
+	
+	// Create and register server_status tool
+	statusTool := mcp.NewTool("server_status",
+		mcp.WithDescription("Report loaded collections and whether the scripture data files passed checksum verification"),
+		mcp.WithOutputSchema[scripture.StatusResult](),
+	)
+	registry.add(groupAdmin, statusTool, scriptureService.ServerStatus)
+	
+	// Create and register reload_data tool
+	reloadTool := mcp.NewTool("reload_data",
+		mcp.WithDescription("Reload the scripture data and optional datasets from SCRIPTURES_DATA_DIR and the state directory without restarting the server"),
+		mcp.WithOutputSchema[scripture.ReloadResult](),
+	)
+	registry.addUnlocked(groupAdmin, reloadTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		result, err := scriptureService.ReloadData(ctx, request)
+		if err == nil && !result.IsError {
+			// The chapters and optional datasets may have changed with the data
+			mcpServer.SetResources(scriptureService.ChapterResources()...)
+			registry.refresh()
+		}
+		return result, err
+	})
+	
+	// Register chapters and verses as resources
+	mcpServer.AddResources(scriptureService.ChapterResources()...)
+	mcpServer.AddResourceTemplates(scriptureService.ResourceTemplates()...)
+	
+	// Register study workflows as prompts
+	mcpServer.AddPrompts(scriptureService.Prompts()...)
+	
+	// Register the tools not disabled by configuration
+	disabled, err := registry.loadConfig()
+	if err != nil {
+		fatal("Tool configuration failed", err)
+	}
+	registry.apply(disabled)
+	if os.Getenv("SCRIPTURES_TOOLS_CONFIG") != "" {
+		go registry.reloadOnHangup()
+	}
+	
+	// Suggest book and collection names as clients type arguments
+	completions := &completingServer{MCPServer: mcpServer, complete: scriptureService.CompleteArgument}
+	
+	// Serve over HTTP when asked, so the server can be shared by remote clients
+	if frontend != nil {
+		frontend.attach(completions, scriptureService.Ready)
+		if err := <-served; err != nil {
+			fatal("HTTP server failed", err)
+		}
+		return
+	}
+	
+	// Start the stdio server (newline-delimited messages and JSON-RPC batches)
+	if err := serveStdio(context.Background(), completions, os.Stdin, os.Stdout); err != nil {
+		fatal("Server failed to start", err)
+	}
+}
+
+// runSyncData implements the sync-data command: fetch upstream JSON, rebuild
+// the embedded archive and print a verse-level diff report
+func runSyncData(args []string) {
+	fs := flag.NewFlagSet("sync-data", flag.ExitOnError)
+	source := fs.String("source", scripture.DefaultSyncSource, "URL prefix or local directory containing the upstream JSON files")
+	out := fs.String("out", filepath.Join("internal", "scripture", "data", "scriptures.zip"), "Archive to rebuild")
+	fs.Parse(args)
+
+	if err := scripture.SyncData(*source, *out, os.Stdout); err != nil {
+		fatal("Data sync failed", err)
+	}
+}
+
+// runDiffData implements the diff-data command: print the verse-level
+// differences between two data archives, such as the embedded archive and
+// one synced to another path
+func runDiffData(args []string) {
+	fs := flag.NewFlagSet("diff-data", flag.ExitOnError)
+	old := fs.String("old", filepath.Join("internal", "scripture", "data", "scriptures.zip"), "Archive to compare from")
+	updated := fs.String("new", "", "Archive to compare with (required)")
+	asJSON := fs.Bool("json", false, "Print the differences as JSON instead of a report")
+	fs.Parse(args)
+
+	if *updated == "" {
+		fatal("Data diff failed", errors.New("--new must name the archive to compare with"))
+	}
+	if err := scripture.DiffData(*old, *updated, *asJSON, os.Stdout); err != nil {
+		fatal("Data diff failed", err)
+	}
+}
+
+// runSlimData implements the slim-data command: write an archive holding only
+// the selected collections, embedded by builds with the scriptures_slim tag
+func runSlimData(args []string) {
+	fs := flag.NewFlagSet("slim-data", flag.ExitOnError)
+	collections := fs.String("collections", "", "Comma-separated collections to keep (e.g. 'Book of Mormon,Doctrine and Covenants')")
+	source := fs.String("source", filepath.Join("internal", "scripture", "data", "scriptures.zip"), "Full archive to slim down")
+	out := fs.String("out", filepath.Join("internal", "scripture", "data", "scriptures-slim.zip"), "Slim archive to write")
+	fs.Parse(args)
+
+	if err := scripture.SlimData(*source, *out, strings.Split(*collections, ","), os.Stdout); err != nil {
+		fatal("Data slimming failed", err)
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// recoverToolPanics is tool handler middleware that turns a panic in a
+// handler into a JSON-RPC internal error for that one call, logging the
+// panic with its stack trace, so a bad request cannot kill the server in the
+// middle of a conversation
+func recoverToolPanics(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (result *mcp.CallToolResult, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				slog.Error("Tool handler panicked", "call_id", callID(ctx), "tool", request.Params.Name, "panic", fmt.Sprint(r), "stack", string(debug.Stack()))
+				result, err = nil, fmt.Errorf("internal error in %s; the failure was logged", request.Params.Name)
+			}
+		}()
+		return next(ctx, request)
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func TestRecoverToolPanics(t *testing.T) {
+	mcpServer := server.NewMCPServer("test", "1.0.0",
+		server.WithToolCapabilities(true),
+		server.WithToolHandlerMiddleware(recoverToolPanics),
+	)
+	mcpServer.AddTool(mcp.NewTool("explode"), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var verses []string
+		return mcp.NewToolResultText(verses[3]), nil
+	})
+
+	var logs bytes.Buffer
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(logConfig{level: slog.LevelInfo}.newLogger(&logs))
+
+	// The panicking call gets an internal error and the server keeps serving
+	input := `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"explode"}}` + "\n" +
+		`{"jsonrpc":"2.0","id":2,"method":"ping"}` + "\n"
+	var out bytes.Buffer
+	if err := serveStdio(context.Background(), mcpServer, strings.NewReader(input), &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The tool call answers when it finishes, possibly after the ping
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	slices.Sort(lines)
+	expected := []string{
+		`{"jsonrpc":"2.0","id":1,"error":{"code":-32603,"message":"internal error in explode; the failure was logged"}}`,
+		`{"jsonrpc":"2.0","id":2,"result":{}}`,
+	}
+	if len(lines) != len(expected) {
+		t.Fatalf("Expected %d lines, got %d:\n%s", len(expected), len(lines), out.String())
+	}
+	for i := range lines {
+		if lines[i] != expected[i] {
+			t.Errorf("Line %d: expected\n%s\ngot\n%s", i, expected[i], lines[i])
+		}
+	}
+
+	if !strings.Contains(logs.String(), "tool=explode") || !strings.Contains(logs.String(), "recovery_test.go") {
+		t.Errorf("Expected the panic to be logged with its stack trace, got:\n%s", logs.String())
+	}
+}
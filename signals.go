@@ -0,0 +1,11 @@
+//go:build !js
+
+package main
+
+import (
+	"os"
+	"syscall"
+)
+
+// hangupSignals are the signals that reload the tool configuration
+var hangupSignals = []os.Signal{syscall.SIGHUP}
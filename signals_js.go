@@ -0,0 +1,6 @@
+package main
+
+import "os"
+
+// hangupSignals is empty in the browser, which has no SIGHUP
+var hangupSignals []os.Signal
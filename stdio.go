@@ -0,0 +1,300 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// stdioSession is the single client session of the stdio transport
+type stdioSession struct {
+	notifications chan mcp.JSONRPCNotification
+	initialized   atomic.Bool
+	logLevel      atomic.Value // mcp.LoggingLevel chosen with logging/setLevel
+}
+
+func (s *stdioSession) SessionID() string { return "stdio" }
+
+func (s *stdioSession) NotificationChannel() chan<- mcp.JSONRPCNotification {
+	return s.notifications
+}
+
+func (s *stdioSession) Initialize() { s.initialized.Store(true) }
+
+func (s *stdioSession) Initialized() bool { return s.initialized.Load() }
+
+func (s *stdioSession) SetLogLevel(level mcp.LoggingLevel) { s.logLevel.Store(level) }
+
+// GetLogLevel returns the client's log level, error until it chooses one
+func (s *stdioSession) GetLogLevel() mcp.LoggingLevel {
+	if level, ok := s.logLevel.Load().(mcp.LoggingLevel); ok {
+		return level
+	}
+	return mcp.LoggingLevelError
+}
+
+// stdioTransport serves MCP over JSON-RPC on stdin and stdout. Unlike
+// server.ServeStdio it also accepts JSON-RPC 2.0 batches: an array of
+// requests is answered with one array of responses, in request order.
+//
+// Like server.ServeStdio, tool calls run on a pool of workers while other
+// messages are handled as they arrive, so a slow tool call does not hold up
+// pings, cancellations or other requests. Responses to tool calls are
+// written when they finish, and so may come out of request order.
+//
+// Messages may be newline-delimited or framed LSP-style with a Content-Length
+// header. The framing is detected per message; each response uses the
+// framing of its request and notifications that of the last message received.
+type stdioTransport struct {
+	server  stdioServer
+	out     io.Writer
+	workers chan struct{}  // One token per tool call running
+	calls   sync.WaitGroup // Tool calls still running
+	mu      sync.Mutex     // Serializes writes of responses and notifications
+	framed  bool           // Last message used Content-Length framing; guarded by mu
+	failed  error          // First failed write; guarded by mu
+}
+
+// stdioServer is the MCP server the stdio transport serves: a
+// server.MCPServer, or a completingServer wrapping one
+type stdioServer interface {
+	RegisterSession(ctx context.Context, session server.ClientSession) error
+	UnregisterSession(ctx context.Context, sessionID string)
+	WithContext(ctx context.Context, session server.ClientSession) context.Context
+	HandleMessage(ctx context.Context, message json.RawMessage) mcp.JSONRPCMessage
+}
+
+// contentLengthHeader starts a Content-Length framed message
+const contentLengthHeader = "content-length:"
+
+// stdioWorkers is the number of tool calls the stdio transport runs at
+// once, as with server.ServeStdio's default worker pool
+const stdioWorkers = 5
+
+// maxFramedMessage caps the Content-Length of a framed message, so a bad
+// header cannot make the server allocate an arbitrary amount of memory
+const maxFramedMessage = 8 << 20
+
+// serveStdio reads messages from in until EOF, writing responses to out
+func serveStdio(ctx context.Context, mcpServer stdioServer, in io.Reader, out io.Writer) error {
+	session := &stdioSession{notifications: make(chan mcp.JSONRPCNotification, 100)}
+	if err := mcpServer.RegisterSession(ctx, session); err != nil {
+		return fmt.Errorf("register session: %w", err)
+	}
+	defer mcpServer.UnregisterSession(ctx, session.SessionID())
+	ctx = mcpServer.WithContext(ctx, session)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	transport := &stdioTransport{server: mcpServer, out: out, workers: make(chan struct{}, stdioWorkers)}
+	defer transport.calls.Wait()
+	go func() {
+		for {
+			select {
+			case notification := <-session.notifications:
+				transport.write(notification)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	reader := bufio.NewReader(in)
+	for {
+		message, framed, err := transport.read(reader)
+		if len(message) > 0 {
+			transport.dispatch(ctx, message, framed)
+		}
+		if writeErr := transport.writeFailure(); writeErr != nil {
+			return fmt.Errorf("write response: %w", writeErr)
+		}
+		if err == io.EOF {
+			// Let the running tool calls answer before returning
+			transport.calls.Wait()
+			if writeErr := transport.writeFailure(); writeErr != nil {
+				return fmt.Errorf("write response: %w", writeErr)
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// read returns the next message and whether it was framed, skipping blank
+// lines. A line starting with a Content-Length header begins a framed
+// message: the headers run to the first blank line and are followed by
+// exactly that many bytes of body.
+func (t *stdioTransport) read(reader *bufio.Reader) ([]byte, bool, error) {
+	for {
+		line, err := reader.ReadBytes('\n')
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 && err == nil {
+			continue
+		}
+		if !bytes.HasPrefix(bytes.ToLower(line), []byte(contentLengthHeader)) {
+			t.setFramed(false)
+			return line, false, err
+		}
+
+		length, convErr := strconv.Atoi(strings.TrimSpace(string(line[len(contentLengthHeader):])))
+		if convErr != nil || length < 0 {
+			return nil, false, fmt.Errorf("invalid header %q", line)
+		}
+		if length > maxFramedMessage {
+			return nil, false, fmt.Errorf("message of %d bytes exceeds the %d byte limit", length, maxFramedMessage)
+		}
+		// Skip any other headers, such as Content-Type
+		for err == nil {
+			var header []byte
+			header, err = reader.ReadBytes('\n')
+			if len(bytes.TrimSpace(header)) == 0 {
+				break
+			}
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("read headers: %w", err)
+		}
+
+		body := make([]byte, length)
+		if _, err := io.ReadFull(reader, body); err != nil {
+			return nil, false, fmt.Errorf("read %d byte message: %w", length, err)
+		}
+		t.setFramed(true)
+		return body, true, nil
+	}
+}
+
+// setFramed records the framing of the last message received
+func (t *stdioTransport) setFramed(framed bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.framed = framed
+}
+
+// dispatch handles one message or batch, writing any response in the
+// message's framing. A tool call, or a batch holding one, is handed to a
+// worker; anything else is handled before the next message is read.
+func (t *stdioTransport) dispatch(ctx context.Context, message []byte, framed bool) {
+	message = bytes.TrimSpace(message)
+	if len(message) == 0 {
+		t.respond(mcp.NewJSONRPCError(mcp.NewRequestId(nil), mcp.PARSE_ERROR, "Parse error", nil), framed)
+		return
+	}
+	if message[0] != '[' {
+		if !json.Valid(message) {
+			t.respond(mcp.NewJSONRPCError(mcp.NewRequestId(nil), mcp.PARSE_ERROR, "Parse error", nil), framed)
+			return
+		}
+		handle := func() {
+			if response := t.server.HandleMessage(ctx, message); response != nil {
+				t.respond(response, framed)
+			}
+		}
+		if isToolCall(message) {
+			t.runWorker(handle)
+		} else {
+			handle()
+		}
+		return
+	}
+
+	var batch []json.RawMessage
+	if err := json.Unmarshal(message, &batch); err != nil {
+		t.respond(mcp.NewJSONRPCError(mcp.NewRequestId(nil), mcp.PARSE_ERROR, "Parse error", nil), framed)
+		return
+	}
+	if len(batch) == 0 {
+		t.respond(mcp.NewJSONRPCError(mcp.NewRequestId(nil), mcp.INVALID_REQUEST, "Empty batch", nil), framed)
+		return
+	}
+	if !slices.ContainsFunc(batch, isToolCall) {
+		t.handleBatch(ctx, batch, framed)
+		return
+	}
+	t.runWorker(func() { t.handleBatch(ctx, batch, framed) })
+}
+
+// handleBatch handles the requests of a batch concurrently and writes their
+// responses as one array, in request order. Notifications get no response;
+// a batch of only notifications gets nothing at all.
+func (t *stdioTransport) handleBatch(ctx context.Context, batch []json.RawMessage, framed bool) {
+	responses := make([]mcp.JSONRPCMessage, len(batch))
+	var wg sync.WaitGroup
+	for i, request := range batch {
+		wg.Go(func() {
+			responses[i] = t.server.HandleMessage(ctx, request)
+		})
+	}
+	wg.Wait()
+
+	responses = slices.DeleteFunc(responses, func(response mcp.JSONRPCMessage) bool { return response == nil })
+	if len(responses) > 0 {
+		t.respond(responses, framed)
+	}
+}
+
+// runWorker runs a tool call once one of the workers is free, without
+// holding up the read loop
+func (t *stdioTransport) runWorker(call func()) {
+	t.calls.Go(func() {
+		t.workers <- struct{}{}
+		defer func() { <-t.workers }()
+		call()
+	})
+}
+
+// isToolCall reports whether a message is a tools/call request
+func isToolCall(message json.RawMessage) bool {
+	var request struct {
+		Method string `json:"method"`
+	}
+	return json.Unmarshal(message, &request) == nil && request.Method == string(mcp.MethodToolsCall)
+}
+
+// write marshals a notification in the framing of the last message received
+func (t *stdioTransport) write(message any) {
+	t.mu.Lock()
+	framed := t.framed
+	t.mu.Unlock()
+	t.respond(message, framed)
+}
+
+// respond marshals a message in the given framing, recording the first
+// failed write for the read loop to report
+func (t *stdioTransport) respond(message any, framed bool) {
+	data, err := json.Marshal(message)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err == nil {
+		if framed {
+			_, err = fmt.Fprintf(t.out, "Content-Length: %d\r\n\r\n%s", len(data), data)
+		} else {
+			_, err = fmt.Fprintf(t.out, "%s\n", data)
+		}
+	}
+	if err != nil && t.failed == nil {
+		t.failed = err
+	}
+}
+
+// writeFailure returns the first failed write, if any
+func (t *stdioTransport) writeFailure() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.failed
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func TestServeStdio(t *testing.T) {
+	mcpServer := server.NewMCPServer("test", "1.0.0", server.WithToolCapabilities(true))
+	mcpServer.AddTool(mcp.NewTool("echo", mcp.WithString("text")), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		text, _ := request.GetArguments()["text"].(string)
+		return mcp.NewToolResultText(text), nil
+	})
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []string // One line of output per entry
+	}{
+		{
+			name:     "Single request",
+			input:    `{"jsonrpc":"2.0","id":1,"method":"ping"}` + "\n",
+			expected: []string{`{"jsonrpc":"2.0","id":1,"result":{}}`},
+		},
+		{
+			name: "Batch answered in order",
+			input: `[{"jsonrpc":"2.0","id":"b","method":"tools/call","params":{"name":"echo","arguments":{"text":"second"}}},` +
+				`{"jsonrpc":"2.0","method":"notifications/initialized"},` +
+				`{"jsonrpc":"2.0","id":"a","method":"ping"}]` + "\n",
+			expected: []string{`[{"jsonrpc":"2.0","id":"b","result":{"content":[{"type":"text","text":"second"}]}},{"jsonrpc":"2.0","id":"a","result":{}}]`},
+		},
+		{
+			name:     "Batch of notifications",
+			input:    `[{"jsonrpc":"2.0","method":"notifications/initialized"}]` + "\n",
+			expected: nil,
+		},
+		{
+			name:     "Empty batch",
+			input:    "[]\n",
+			expected: []string{`{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"Empty batch"}}`},
+		},
+		{
+			name:     "Invalid JSON",
+			input:    "{not json\n",
+			expected: []string{`{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"Parse error"}}`},
+		},
+		{
+			name:     "Blank lines and no final newline",
+			input:    "\n\n" + `{"jsonrpc":"2.0","id":2,"method":"ping"}`,
+			expected: []string{`{"jsonrpc":"2.0","id":2,"result":{}}`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := serveStdio(context.Background(), mcpServer, strings.NewReader(tt.input), &out); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+			if out.Len() == 0 {
+				lines = nil
+			}
+			if len(lines) != len(tt.expected) {
+				t.Fatalf("Expected %d lines, got %d:\n%s", len(tt.expected), len(lines), out.String())
+			}
+			for i := range lines {
+				if !json.Valid([]byte(lines[i])) {
+					t.Errorf("Line %d is not valid JSON: %s", i, lines[i])
+				}
+				if lines[i] != tt.expected[i] {
+					t.Errorf("Line %d: expected\n%s\ngot\n%s", i, tt.expected[i], lines[i])
+				}
+			}
+		})
+	}
+}
+
+func TestServeStdio_SlowToolCall(t *testing.T) {
+	release := make(chan struct{})
+	mcpServer := server.NewMCPServer("test", "1.0.0", server.WithToolCapabilities(true))
+	mcpServer.AddTool(mcp.NewTool("slow"), func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		<-release
+		return mcp.NewToolResultText("done"), nil
+	})
+
+	// The ping is answered while the tool call is still running
+	in, input := io.Pipe()
+	var out syncBuffer
+	done := make(chan error, 1)
+	go func() { done <- serveStdio(context.Background(), mcpServer, in, &out) }()
+	fmt.Fprintln(input, `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"slow"}}`)
+	fmt.Fprintln(input, `{"jsonrpc":"2.0","id":2,"method":"ping"}`)
+	pong := `{"jsonrpc":"2.0","id":2,"result":{}}` + "\n"
+	deadline := time.Now().Add(5 * time.Second)
+	for out.String() != pong {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected the ping answered before the tool call, got %q", out.String())
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	close(release)
+	input.Close()
+	if err := <-done; err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := pong + `{"jsonrpc":"2.0","id":1,"result":{"content":[{"type":"text","text":"done"}]}}` + "\n"
+	if out.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, out.String())
+	}
+}
+
+// syncBuffer is a bytes.Buffer safe to read while the transport writes to it
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestServeStdio_ContentLength(t *testing.T) {
+	mcpServer := server.NewMCPServer("test", "1.0.0")
+
+	ping := `{"jsonrpc":"2.0","id":1,"method":"ping"}`
+	pong := `{"jsonrpc":"2.0","id":1,"result":{}}`
+	tests := []struct {
+		name        string
+		input       string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "Framed request gets framed response",
+			input:    fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(ping), ping),
+			expected: fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(pong), pong),
+		},
+		{
+			name:     "Extra headers and lowercase name",
+			input:    fmt.Sprintf("content-length: %d\r\nContent-Type: application/vscode-jsonrpc; charset=utf-8\r\n\r\n%s", len(ping), ping),
+			expected: fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(pong), pong),
+		},
+		{
+			name:     "Framings can be mixed",
+			input:    fmt.Sprintf("Content-Length: %d\r\n\r\n%s%s\n", len(ping), ping, ping),
+			expected: fmt.Sprintf("Content-Length: %d\r\n\r\n%s%s\n", len(pong), pong, pong),
+		},
+		{
+			name:        "Invalid length",
+			input:       "Content-Length: lots\r\n\r\n{}",
+			expectError: true,
+		},
+		{
+			name:        "Length over the limit",
+			input:       "Content-Length: 99999999999\r\n\r\n{}",
+			expectError: true,
+		},
+		{
+			name:        "Truncated body",
+			input:       "Content-Length: 100\r\n\r\n" + ping,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			err := serveStdio(context.Background(), mcpServer, strings.NewReader(tt.input), &out)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("Expected error = %v, got %v", tt.expectError, err)
+			}
+			if !tt.expectError && out.String() != tt.expected {
+				t.Errorf("Expected output %q, got %q", tt.expected, out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Tool groups operators can disable as a whole
+const (
+	groupSearch  = "search"
+	groupReading = "reading"
+	groupStudy   = "study"
+	groupExport  = "export"
+	groupAdmin   = "admin"
+)
+
+// toolRegistry holds every tool with its group and registers the enabled ones
+// on the MCP server. Tools are disabled by name or group through
+// SCRIPTURES_DISABLED_TOOLS and the optional SCRIPTURES_TOOLS_CONFIG file,
+// which is re-read on SIGHUP. Tools needing an optional dataset that is not
+// loaded are left out too.
+type toolRegistry struct {
+	server     *server.MCPServer
+	readLock   server.ToolHandlerMiddleware // Holds the scripture data steady during a call; optional
+	hasDataset func(dataset string) bool    // Reports whether an optional dataset is loaded; optional
+	tools      []groupedTool
+
+	// mu guards the registered tool list, which SIGHUP and reload_data
+	// calls may change at the same time
+	mu       sync.Mutex
+	enabled  []string        // Names of the registered tools; nil until the first apply
+	disabled map[string]bool // Tools and groups disabled by the last apply
+}
+
+// groupedTool is a tool, the group it belongs to and the optional dataset
+// it needs, if any
+type groupedTool struct {
+	group   string
+	dataset string
+	tool    server.ServerTool
+}
+
+// add records a tool that reads the scripture data; nothing is registered
+// until apply
+func (r *toolRegistry) add(group string, tool mcp.Tool, handler server.ToolHandlerFunc) {
+	if r.readLock != nil {
+		handler = r.readLock(handler)
+	}
+	r.addUnlocked(group, tool, handler)
+}
+
+// addNeeding records a tool that reads the scripture data and needs an
+// optional dataset, so it is only registered while the dataset is loaded
+func (r *toolRegistry) addNeeding(group, dataset string, tool mcp.Tool, handler server.ToolHandlerFunc) {
+	r.add(group, tool, handler)
+	r.tools[len(r.tools)-1].dataset = dataset
+}
+
+// addUnlocked records a tool that takes the data lock itself, such as reload_data
+func (r *toolRegistry) addUnlocked(group string, tool mcp.Tool, handler server.ToolHandlerFunc) {
+	r.tools = append(r.tools, groupedTool{group: group, tool: server.ServerTool{Tool: tool, Handler: handler}})
+}
+
+// parseDisabled parses a comma or newline separated list of tool and group
+// names. Unknown names are an error, so a typo cannot leave a tool enabled.
+func (r *toolRegistry) parseDisabled(list string) (map[string]bool, error) {
+	disabled := make(map[string]bool)
+	for _, name := range strings.FieldsFunc(list, func(r rune) bool { return r == ',' || r == '\n' }) {
+		name = strings.TrimSpace(name)
+		if name == "" || strings.HasPrefix(name, "#") {
+			continue
+		}
+		known := slices.ContainsFunc(r.tools, func(t groupedTool) bool {
+			return t.group == name || t.tool.Tool.Name == name
+		})
+		if !known {
+			return nil, fmt.Errorf("unknown tool or group '%s'", name)
+		}
+		disabled[name] = true
+	}
+	return disabled, nil
+}
+
+// loadConfig reads the disabled tools and groups from the environment and
+// the SCRIPTURES_TOOLS_CONFIG file
+func (r *toolRegistry) loadConfig() (map[string]bool, error) {
+	list := os.Getenv("SCRIPTURES_DISABLED_TOOLS")
+	if path := os.Getenv("SCRIPTURES_TOOLS_CONFIG"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		list += "\n" + string(data)
+	}
+	return r.parseDisabled(list)
+}
+
+// apply registers exactly the tools that are not disabled by name or group
+// and whose dataset is loaded, reporting whether the tool list changed.
+// Changing it sends a notifications/tools/list_changed to connected clients.
+func (r *toolRegistry) apply(disabled map[string]bool) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.applyLocked(disabled)
+}
+
+// applyLocked is apply with r.mu held
+func (r *toolRegistry) applyLocked(disabled map[string]bool) bool {
+	r.disabled = disabled
+	var tools []server.ServerTool
+	var names []string
+	for _, t := range r.tools {
+		if disabled[t.group] || disabled[t.tool.Tool.Name] {
+			continue
+		}
+		if t.dataset != "" && r.hasDataset != nil && !r.hasDataset(t.dataset) {
+			continue
+		}
+		tools = append(tools, t.tool)
+		names = append(names, t.tool.Tool.Name)
+	}
+
+	if r.enabled != nil && slices.Equal(names, r.enabled) {
+		return false
+	}
+
+	// Only the tools that changed are deleted or added, so calls to the
+	// others find them registered throughout
+	var removed []string
+	for _, name := range r.enabled {
+		if !slices.Contains(names, name) {
+			removed = append(removed, name)
+		}
+	}
+	var added []server.ServerTool
+	for _, tool := range tools {
+		if !slices.Contains(r.enabled, tool.Tool.Name) {
+			added = append(added, tool)
+		}
+	}
+	if len(removed) > 0 {
+		r.server.DeleteTools(removed...)
+	}
+	if len(added) > 0 || r.enabled == nil {
+		r.server.AddTools(added...)
+	}
+	r.enabled = append(make([]string, 0, len(names)), names...)
+	return true
+}
+
+// refresh re-applies the last configuration, for when the loaded datasets
+// may have changed
+func (r *toolRegistry) refresh() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.applyLocked(r.disabled)
+}
+
+// enabledCount returns the number of registered tools
+func (r *toolRegistry) enabledCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.enabled)
+}
+
+// reloadOnHangup re-applies the tool configuration each time the process
+// receives SIGHUP. A configuration with errors leaves the current tools in place.
+// Platforms without SIGHUP never reload.
+func (r *toolRegistry) reloadOnHangup() {
+	if len(hangupSignals) == 0 {
+		return
+	}
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, hangupSignals...)
+	for range hangup {
+		disabled, err := r.loadConfig()
+		if err != nil {
+			slog.Warn("Keeping current tools, configuration failed", "error", err)
+			continue
+		}
+		if r.apply(disabled) {
+			slog.Info("Tool list changed", "enabled", r.enabledCount(), "tools", len(r.tools))
+		}
+	}
+}
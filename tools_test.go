@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func newTestRegistry() *toolRegistry {
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("ok"), nil
+	}
+	registry := &toolRegistry{server: server.NewMCPServer("test", "1.0.0", server.WithToolCapabilities(true))}
+	registry.add(groupSearch, mcp.NewTool("search_scriptures"), handler)
+	registry.add(groupSearch, mcp.NewTool("search_all"), handler)
+	registry.add(groupReading, mcp.NewTool("get_scripture"), handler)
+	registry.add(groupExport, mcp.NewTool("export_anki_deck"), handler)
+	return registry
+}
+
+// listedTools returns the tool names the server reports for tools/list
+func listedTools(t *testing.T, mcpServer *server.MCPServer) []string {
+	t.Helper()
+	response := mcpServer.HandleMessage(context.Background(), json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`))
+	result, ok := response.(mcp.JSONRPCResponse).Result.(mcp.ListToolsResult)
+	if !ok {
+		t.Fatalf("Unexpected tools/list response: %v", response)
+	}
+	var names []string
+	for _, tool := range result.Tools {
+		names = append(names, tool.Name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func TestToolRegistry_Apply(t *testing.T) {
+	tests := []struct {
+		name        string
+		disabled    string
+		expected    []string
+		expectError bool
+	}{
+		{name: "Everything enabled", disabled: "", expected: []string{"export_anki_deck", "get_scripture", "search_all", "search_scriptures"}},
+		{name: "Disable a group", disabled: "search", expected: []string{"export_anki_deck", "get_scripture"}},
+		{name: "Disable a tool", disabled: "search_all", expected: []string{"export_anki_deck", "get_scripture", "search_scriptures"}},
+		{name: "Comments and newlines", disabled: "# read-only\nexport,\n reading ", expected: []string{"search_all", "search_scriptures"}},
+		{name: "Unknown name", disabled: "notes", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := newTestRegistry()
+			disabled, err := registry.parseDisabled(tt.disabled)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("Expected error = %v, got %v", tt.expectError, err)
+			}
+			if tt.expectError {
+				return
+			}
+			if !registry.apply(disabled) {
+				t.Error("Expected the first apply to register tools")
+			}
+			if got := listedTools(t, registry.server); !slices.Equal(got, tt.expected) {
+				t.Errorf("Expected tools %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestToolRegistry_Reload(t *testing.T) {
+	registry := newTestRegistry()
+	path := filepath.Join(t.TempDir(), "tools.conf")
+	t.Setenv("SCRIPTURES_DISABLED_TOOLS", "export")
+	t.Setenv("SCRIPTURES_TOOLS_CONFIG", path)
+
+	if err := os.WriteFile(path, []byte(""), 0644); err != nil {
+		t.Fatal(err)
+	}
+	disabled, err := registry.loadConfig()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	registry.apply(disabled)
+
+	// Reapplying the same configuration changes nothing
+	if registry.apply(disabled) {
+		t.Error("Expected no change for the same configuration")
+	}
+
+	// The file adds to the environment
+	if err := os.WriteFile(path, []byte("search\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	disabled, err = registry.loadConfig()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !registry.apply(disabled) {
+		t.Error("Expected the tool list to change")
+	}
+	if got := listedTools(t, registry.server); !slices.Equal(got, []string{"get_scripture"}) {
+		t.Errorf("Expected only get_scripture, got %v", got)
+	}
+}
+
+func TestToolRegistry_ReadLock(t *testing.T) {
+	var locked []string
+	registry := &toolRegistry{readLock: func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			locked = append(locked, request.Params.Name)
+			return next(ctx, request)
+		}
+	}}
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("ok"), nil
+	}
+	registry.add(groupReading, mcp.NewTool("get_scripture"), handler)
+	registry.addUnlocked(groupAdmin, mcp.NewTool("reload_data"), handler)
+
+	for _, grouped := range registry.tools {
+		request := mcp.CallToolRequest{}
+		request.Params.Name = grouped.tool.Tool.Name
+		grouped.tool.Handler(context.Background(), request)
+	}
+	if !slices.Equal(locked, []string{"get_scripture"}) {
+		t.Errorf("Expected only get_scripture to take the read lock, got %v", locked)
+	}
+}
+
+func TestToolRegistry_Datasets(t *testing.T) {
+	loaded := map[string]bool{}
+	registry := newTestRegistry()
+	registry.hasDataset = func(dataset string) bool { return loaded[dataset] }
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("ok"), nil
+	}
+	registry.addNeeding(groupStudy, "bible_dictionary", mcp.NewTool("bible_dictionary"), handler)
+
+	disabled, err := registry.parseDisabled("search,export")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	registry.apply(disabled)
+	if got := listedTools(t, registry.server); !slices.Equal(got, []string{"get_scripture"}) {
+		t.Errorf("Expected the tool without its dataset left out, got %v", got)
+	}
+
+	// Loading the dataset lists the tool, keeping the configuration
+	loaded["bible_dictionary"] = true
+	if !registry.refresh() {
+		t.Error("Expected the tool list to change")
+	}
+	if got := listedTools(t, registry.server); !slices.Equal(got, []string{"bible_dictionary", "get_scripture"}) {
+		t.Errorf("Expected bible_dictionary listed, got %v", got)
+	}
+}
+
+func TestToolRegistry_ConcurrentRefresh(t *testing.T) {
+	registry := newTestRegistry()
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Go(func() {
+			if i%2 == 0 {
+				registry.apply(map[string]bool{groupExport: i%4 == 0})
+			} else {
+				registry.refresh()
+			}
+		})
+	}
+	wg.Wait()
+	if registry.enabledCount() == 0 {
+		t.Error("Expected registered tools")
+	}
+}
+
+func TestToolRegistry_ApplyKeepsUnchangedTools(t *testing.T) {
+	registry := newTestRegistry()
+	registry.apply(nil)
+
+	// Calls to a tool that stays enabled never miss it while others change
+	var calls sync.WaitGroup
+	for range 4 {
+		calls.Go(func() {
+			for range 5000 {
+				response := registry.server.HandleMessage(context.Background(), json.RawMessage(`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"get_scripture"}}`))
+				if _, ok := response.(mcp.JSONRPCResponse); !ok {
+					t.Errorf("Expected get_scripture to stay registered, got %v", response)
+					return
+				}
+			}
+		})
+	}
+	done := make(chan struct{})
+	go func() {
+		calls.Wait()
+		close(done)
+	}()
+	for i := 0; ; i++ {
+		select {
+		case <-done:
+			return
+		default:
+		}
+		registry.apply(map[string]bool{groupSearch: i%2 == 0, groupExport: i%3 == 0})
+	}
+}